@@ -0,0 +1,20829 @@
+// Code generated by protoc-gen-gogo.
+// source: github.com/TheThingsNetwork/ttn/api/manager/manager.proto
+// DO NOT EDIT!
+
+/*
+	Package manager is a generated protocol buffer package.
+
+	It is generated from these files:
+		github.com/TheThingsNetwork/ttn/api/manager/manager.proto
+
+	It has these top-level messages:
+		ApplicationIdentifier
+		DeviceIdentifier
+		DownlinkMessage
+		DownlinkQueue
+		QueuedDownlinkIdentifier
+		HistoryRequest
+		HistoryUplink
+		HistoryEvent
+		HistoryEntry
+		History
+		DeviceGroup
+		DeviceGroupList
+		DeviceGroupIdentifier
+		GroupDownlinkRequest
+		GroupDownlinkIdentifier
+		GroupDownlinkReport
+		ImportDevicesRequest
+		ImportDevicesError
+		ImportDevicesResult
+		ExportDevicesRequest
+		ExportDevicesData
+		PortFunctions
+		PayloadFunctions
+		Revision
+		RevisionList
+		RevisionIdentifier
+		PortFunctionsList
+		PortFunctionsIdentifier
+		SetPortFunctionsRequest
+		FunctionSetList
+		FunctionSetIdentifier
+		SetFunctionSetRequest
+		DevicePayloadFormat
+		Fragmentation
+		DeviceClass
+		DeviceStatus
+		Webhook
+		WebhookList
+		WebhookIdentifier
+		HandlerStatusRequest
+		IntegrationStatus
+		FunctionStatus
+		HandlerStatus
+		QuotaLimits
+		QuotaUsage
+		Quotas
+		BinaryFormat
+		NetworkServerDeviceIdentifier
+		NetworkServerDeviceClass
+		RXSettings
+		NetworkServerDeviceRXSettings
+		Channel
+		NetworkServerDeviceChannels
+		NetworkServerDeviceStatus
+*/
+package manager
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import bytes "bytes"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import strings "strings"
+import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ApplicationIdentifier struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (m *ApplicationIdentifier) Reset()                    { *m = ApplicationIdentifier{} }
+func (*ApplicationIdentifier) ProtoMessage()               {}
+func (*ApplicationIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{0} }
+
+func (m *ApplicationIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+type DeviceIdentifier struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+}
+
+func (m *DeviceIdentifier) Reset()                    { *m = DeviceIdentifier{} }
+func (*DeviceIdentifier) ProtoMessage()               {}
+func (*DeviceIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{1} }
+
+func (m *DeviceIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *DeviceIdentifier) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+// DownlinkMessage is a downlink message of an application
+type DownlinkMessage struct {
+	// ID is set when the message is added to the downlink queue
+	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FPort     uint32 `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	Confirmed bool   `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Schedule is replace (default), first or last
+	Schedule   string `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	PayloadRaw []byte `protobuf:"bytes,5,opt,name=payload_raw,json=payloadRaw,proto3" json:"payload_raw,omitempty"`
+	// PayloadFields is the JSON object with the fields that are encoded into the payload
+	PayloadFields string `protobuf:"bytes,6,opt,name=payload_fields,json=payloadFields,proto3" json:"payload_fields,omitempty"`
+	// TTL is the duration after which the message expires, for example "1h"
+	TTL string `protobuf:"bytes,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// ExpiresAt is the time at which the message expires in Unix nanoseconds, or 0 if it does not expire
+	ExpiresAt int64 `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// MaxRetries is the maximum number of retransmissions of a confirmed message, if it is set
+	MaxRetries *google_protobuf1.Int32Value `protobuf:"bytes,9,opt,name=max_retries,json=maxRetries" json:"max_retries,omitempty"`
+	// GroupDownlinkID is set if the message was sent to a device group
+	GroupDownlinkID string `protobuf:"bytes,10,opt,name=group_downlink_id,json=groupDownlinkId,proto3" json:"group_downlink_id,omitempty"`
+}
+
+func (m *DownlinkMessage) Reset()                    { *m = DownlinkMessage{} }
+func (*DownlinkMessage) ProtoMessage()               {}
+func (*DownlinkMessage) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{2} }
+
+func (m *DownlinkMessage) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *DownlinkMessage) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DownlinkMessage) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DownlinkMessage) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *DownlinkMessage) GetPayloadRaw() []byte {
+	if m != nil {
+		return m.PayloadRaw
+	}
+	return nil
+}
+
+func (m *DownlinkMessage) GetPayloadFields() string {
+	if m != nil {
+		return m.PayloadFields
+	}
+	return ""
+}
+
+func (m *DownlinkMessage) GetTTL() string {
+	if m != nil {
+		return m.TTL
+	}
+	return ""
+}
+
+func (m *DownlinkMessage) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *DownlinkMessage) GetMaxRetries() *google_protobuf1.Int32Value {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return nil
+}
+
+func (m *DownlinkMessage) GetGroupDownlinkID() string {
+	if m != nil {
+		return m.GroupDownlinkID
+	}
+	return ""
+}
+
+// DownlinkQueue is the downlink state of a device: the downlink that is currently being sent, the number of times
+// it was sent without ACK, and the queue
+type DownlinkQueue struct {
+	Current  *DownlinkMessage   `protobuf:"bytes,1,opt,name=current" json:"current,omitempty"`
+	Attempts uint32             `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Queue    []*DownlinkMessage `protobuf:"bytes,3,rep,name=queue" json:"queue,omitempty"`
+}
+
+func (m *DownlinkQueue) Reset()                    { *m = DownlinkQueue{} }
+func (*DownlinkQueue) ProtoMessage()               {}
+func (*DownlinkQueue) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{3} }
+
+func (m *DownlinkQueue) GetCurrent() *DownlinkMessage {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *DownlinkQueue) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DownlinkQueue) GetQueue() []*DownlinkMessage {
+	if m != nil {
+		return m.Queue
+	}
+	return nil
+}
+
+type QueuedDownlinkIdentifier struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	ID    string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueuedDownlinkIdentifier) Reset()                    { *m = QueuedDownlinkIdentifier{} }
+func (*QueuedDownlinkIdentifier) ProtoMessage()               {}
+func (*QueuedDownlinkIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{4} }
+
+func (m *QueuedDownlinkIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *QueuedDownlinkIdentifier) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+func (m *QueuedDownlinkIdentifier) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+// HistoryRequest selects entries from the history of a device. Zero values mean no restriction.
+type HistoryRequest struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Since is the time in Unix nanoseconds of the oldest entry to return
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	// Until is the time in Unix nanoseconds of the newest entry to return
+	Until int64 `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	// Limit is the maximum number of entries to return; the most recent entries are returned if there are more
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *HistoryRequest) Reset()                    { *m = HistoryRequest{} }
+func (*HistoryRequest) ProtoMessage()               {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{5} }
+
+func (m *HistoryRequest) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+func (m *HistoryRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type HistoryUplink struct {
+	FPort      uint32 `protobuf:"varint,1,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FCnt       uint32 `protobuf:"varint,2,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	Confirmed  bool   `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	IsRetry    bool   `protobuf:"varint,4,opt,name=is_retry,json=isRetry,proto3" json:"is_retry,omitempty"`
+	PayloadRaw []byte `protobuf:"bytes,5,opt,name=payload_raw,json=payloadRaw,proto3" json:"payload_raw,omitempty"`
+	// PayloadFields is the JSON object with the fields that were decoded from the payload
+	PayloadFields string `protobuf:"bytes,6,opt,name=payload_fields,json=payloadFields,proto3" json:"payload_fields,omitempty"`
+	// Metadata is the JSON object with the metadata of the message, as published to the application
+	Metadata string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *HistoryUplink) Reset()                    { *m = HistoryUplink{} }
+func (*HistoryUplink) ProtoMessage()               {}
+func (*HistoryUplink) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{6} }
+
+func (m *HistoryUplink) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *HistoryUplink) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *HistoryUplink) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *HistoryUplink) GetIsRetry() bool {
+	if m != nil {
+		return m.IsRetry
+	}
+	return false
+}
+
+func (m *HistoryUplink) GetPayloadRaw() []byte {
+	if m != nil {
+		return m.PayloadRaw
+	}
+	return nil
+}
+
+func (m *HistoryUplink) GetPayloadFields() string {
+	if m != nil {
+		return m.PayloadFields
+	}
+	return ""
+}
+
+func (m *HistoryUplink) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+type HistoryEvent struct {
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Data is the JSON object with the data of the event, as published to the application
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *HistoryEvent) Reset()                    { *m = HistoryEvent{} }
+func (*HistoryEvent) ProtoMessage()               {}
+func (*HistoryEvent) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{7} }
+
+func (m *HistoryEvent) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *HistoryEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// HistoryEntry is an uplink message or event of a device
+type HistoryEntry struct {
+	// Time is the time of the entry in Unix nanoseconds
+	Time   int64          `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Uplink *HistoryUplink `protobuf:"bytes,2,opt,name=uplink" json:"uplink,omitempty"`
+	Event  *HistoryEvent  `protobuf:"bytes,3,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *HistoryEntry) Reset()                    { *m = HistoryEntry{} }
+func (*HistoryEntry) ProtoMessage()               {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{8} }
+
+func (m *HistoryEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetUplink() *HistoryUplink {
+	if m != nil {
+		return m.Uplink
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetEvent() *HistoryEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// History is a list of entries of the history of a device, oldest first
+type History struct {
+	Entries []*HistoryEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *History) Reset()                    { *m = History{} }
+func (*History) ProtoMessage()               {}
+func (*History) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{9} }
+
+func (m *History) GetEntries() []*HistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type DeviceGroup struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// ID of the group, unique within the application
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Devices are the IDs of the devices that are explicitly added to the group
+	Devices []string `protobuf:"bytes,3,rep,name=devices" json:"devices,omitempty"`
+	// Selector selects the devices that have all of these attributes
+	Selector map[string]string `protobuf:"bytes,4,rep,name=selector" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Members are the IDs of the devices that are currently in the group; only set by GetGroup
+	Members []string `protobuf:"bytes,5,rep,name=members" json:"members,omitempty"`
+}
+
+func (m *DeviceGroup) Reset()                    { *m = DeviceGroup{} }
+func (*DeviceGroup) ProtoMessage()               {}
+func (*DeviceGroup) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{10} }
+
+func (m *DeviceGroup) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *DeviceGroup) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *DeviceGroup) GetDevices() []string {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *DeviceGroup) GetSelector() map[string]string {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (m *DeviceGroup) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type DeviceGroupList struct {
+	Groups []*DeviceGroup `protobuf:"bytes,1,rep,name=groups" json:"groups,omitempty"`
+}
+
+func (m *DeviceGroupList) Reset()                    { *m = DeviceGroupList{} }
+func (*DeviceGroupList) ProtoMessage()               {}
+func (*DeviceGroupList) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{11} }
+
+func (m *DeviceGroupList) GetGroups() []*DeviceGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type DeviceGroupIdentifier struct {
+	AppID   string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	GroupID string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *DeviceGroupIdentifier) Reset()                    { *m = DeviceGroupIdentifier{} }
+func (*DeviceGroupIdentifier) ProtoMessage()               {}
+func (*DeviceGroupIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{12} }
+
+func (m *DeviceGroupIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *DeviceGroupIdentifier) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+// GroupDownlinkRequest is a downlink message for all devices of a group
+type GroupDownlinkRequest struct {
+	AppID   string           `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	GroupID string           `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Message *DownlinkMessage `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *GroupDownlinkRequest) Reset()                    { *m = GroupDownlinkRequest{} }
+func (*GroupDownlinkRequest) ProtoMessage()               {}
+func (*GroupDownlinkRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{13} }
+
+func (m *GroupDownlinkRequest) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkRequest) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkRequest) GetMessage() *DownlinkMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type GroupDownlinkIdentifier struct {
+	AppID   string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	GroupID string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ID      string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GroupDownlinkIdentifier) Reset()                    { *m = GroupDownlinkIdentifier{} }
+func (*GroupDownlinkIdentifier) ProtoMessage()               {}
+func (*GroupDownlinkIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{14} }
+
+func (m *GroupDownlinkIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkIdentifier) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkIdentifier) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+// GroupDownlinkReport is the state of a downlink message that was sent to all devices of a group
+type GroupDownlinkReport struct {
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppID   string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	GroupID string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// CreatedAt is the time in Unix nanoseconds at which the downlink was enqueued
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Devices is the number of devices in the group when the downlink was enqueued
+	Devices uint32 `protobuf:"varint,5,opt,name=devices,proto3" json:"devices,omitempty"`
+	// Scheduled is the number of devices the downlink was enqueued for
+	Scheduled uint32 `protobuf:"varint,6,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	// Failed is the number of devices the downlink could not be enqueued for
+	Failed uint32 `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Sent is the number of devices the downlink was sent to
+	Sent uint32 `protobuf:"varint,8,opt,name=sent,proto3" json:"sent,omitempty"`
+	// Acked is the number of devices that acknowledged the (confirmed) downlink
+	Acked uint32 `protobuf:"varint,9,opt,name=acked,proto3" json:"acked,omitempty"`
+}
+
+func (m *GroupDownlinkReport) Reset()                    { *m = GroupDownlinkReport{} }
+func (*GroupDownlinkReport) ProtoMessage()               {}
+func (*GroupDownlinkReport) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{15} }
+
+func (m *GroupDownlinkReport) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkReport) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkReport) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GroupDownlinkReport) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *GroupDownlinkReport) GetDevices() uint32 {
+	if m != nil {
+		return m.Devices
+	}
+	return 0
+}
+
+func (m *GroupDownlinkReport) GetScheduled() uint32 {
+	if m != nil {
+		return m.Scheduled
+	}
+	return 0
+}
+
+func (m *GroupDownlinkReport) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *GroupDownlinkReport) GetSent() uint32 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *GroupDownlinkReport) GetAcked() uint32 {
+	if m != nil {
+		return m.Acked
+	}
+	return 0
+}
+
+// ImportDevicesRequest is a part of a file with devices to import. The AppID, Format and DryRun of the first
+// request of the stream are used for the whole import.
+type ImportDevicesRequest struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Format is csv or jsonl (default)
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// DryRun only validates the devices
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Data is the next part of the file
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImportDevicesRequest) Reset()                    { *m = ImportDevicesRequest{} }
+func (*ImportDevicesRequest) ProtoMessage()               {}
+func (*ImportDevicesRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{16} }
+
+func (m *ImportDevicesRequest) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *ImportDevicesRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportDevicesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportDevicesRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// ImportDevicesError is the error for a row of an import. Rows are numbered from 1, not counting the CSV header.
+type ImportDevicesError struct {
+	Row   uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ImportDevicesError) Reset()                    { *m = ImportDevicesError{} }
+func (*ImportDevicesError) ProtoMessage()               {}
+func (*ImportDevicesError) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{17} }
+
+func (m *ImportDevicesError) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportDevicesError) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+func (m *ImportDevicesError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportDevicesResult struct {
+	DryRun  bool                  `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Rows    uint32                `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Created uint32                `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated uint32                `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed  uint32                `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors  []*ImportDevicesError `protobuf:"bytes,6,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *ImportDevicesResult) Reset()                    { *m = ImportDevicesResult{} }
+func (*ImportDevicesResult) ProtoMessage()               {}
+func (*ImportDevicesResult) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{18} }
+
+func (m *ImportDevicesResult) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportDevicesResult) GetRows() uint32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *ImportDevicesResult) GetCreated() uint32 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *ImportDevicesResult) GetUpdated() uint32 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *ImportDevicesResult) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportDevicesResult) GetErrors() []*ImportDevicesError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ExportDevicesRequest struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Format is csv or jsonl (default)
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (m *ExportDevicesRequest) Reset()                    { *m = ExportDevicesRequest{} }
+func (*ExportDevicesRequest) ProtoMessage()               {}
+func (*ExportDevicesRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{19} }
+
+func (m *ExportDevicesRequest) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *ExportDevicesRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type ExportDevicesData struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ExportDevicesData) Reset()                    { *m = ExportDevicesData{} }
+func (*ExportDevicesData) ProtoMessage()               {}
+func (*ExportDevicesData) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{20} }
+
+func (m *ExportDevicesData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// PortFunctions are the payload functions for an FPort or of a function set
+type PortFunctions struct {
+	Decoder   string `protobuf:"bytes,1,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Converter string `protobuf:"bytes,2,opt,name=converter,proto3" json:"converter,omitempty"`
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	Encoder   string `protobuf:"bytes,4,opt,name=encoder,proto3" json:"encoder,omitempty"`
+}
+
+func (m *PortFunctions) Reset()                    { *m = PortFunctions{} }
+func (*PortFunctions) ProtoMessage()               {}
+func (*PortFunctions) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{21} }
+
+func (m *PortFunctions) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
+	}
+	return ""
+}
+
+func (m *PortFunctions) GetConverter() string {
+	if m != nil {
+		return m.Converter
+	}
+	return ""
+}
+
+func (m *PortFunctions) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *PortFunctions) GetEncoder() string {
+	if m != nil {
+		return m.Encoder
+	}
+	return ""
+}
+
+// PayloadFunctions are all payload functions of an application
+type PayloadFunctions struct {
+	PayloadFormat string `protobuf:"bytes,1,opt,name=payload_format,json=payloadFormat,proto3" json:"payload_format,omitempty"`
+	Decoder       string `protobuf:"bytes,2,opt,name=decoder,proto3" json:"decoder,omitempty"`
+	Converter     string `protobuf:"bytes,3,opt,name=converter,proto3" json:"converter,omitempty"`
+	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	Encoder       string `protobuf:"bytes,5,opt,name=encoder,proto3" json:"encoder,omitempty"`
+	// BinaryFormat is the JSON field layout of the binary payload format
+	BinaryFormat string `protobuf:"bytes,6,opt,name=binary_format,json=binaryFormat,proto3" json:"binary_format,omitempty"`
+	// Ports are the functions for specific FPorts
+	Ports map[uint32]*PortFunctions `protobuf:"bytes,7,rep,name=ports" json:"ports,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// Sets are the named function sets that devices can use
+	Sets map[string]*PortFunctions `protobuf:"bytes,8,rep,name=sets" json:"sets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *PayloadFunctions) Reset()                    { *m = PayloadFunctions{} }
+func (*PayloadFunctions) ProtoMessage()               {}
+func (*PayloadFunctions) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{22} }
+
+func (m *PayloadFunctions) GetPayloadFormat() string {
+	if m != nil {
+		return m.PayloadFormat
+	}
+	return ""
+}
+
+func (m *PayloadFunctions) GetDecoder() string {
+	if m != nil {
+		return m.Decoder
+	}
+	return ""
+}
+
+func (m *PayloadFunctions) GetConverter() string {
+	if m != nil {
+		return m.Converter
+	}
+	return ""
+}
+
+func (m *PayloadFunctions) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *PayloadFunctions) GetEncoder() string {
+	if m != nil {
+		return m.Encoder
+	}
+	return ""
+}
+
+func (m *PayloadFunctions) GetBinaryFormat() string {
+	if m != nil {
+		return m.BinaryFormat
+	}
+	return ""
+}
+
+func (m *PayloadFunctions) GetPorts() map[uint32]*PortFunctions {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *PayloadFunctions) GetSets() map[string]*PortFunctions {
+	if m != nil {
+		return m.Sets
+	}
+	return nil
+}
+
+// Revision is a recorded change of the payload functions of an application
+type Revision struct {
+	Number uint32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Author string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// CreatedAt is the time of the revision in Unix nanoseconds
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// RollbackOf is the number of the revision that this revision restored
+	RollbackOf uint32            `protobuf:"varint,4,opt,name=rollback_of,json=rollbackOf,proto3" json:"rollback_of,omitempty"`
+	Functions  *PayloadFunctions `protobuf:"bytes,5,opt,name=functions" json:"functions,omitempty"`
+	// Diff is the change since the previous revision
+	Diff string `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (m *Revision) Reset()                    { *m = Revision{} }
+func (*Revision) ProtoMessage()               {}
+func (*Revision) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{23} }
+
+func (m *Revision) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Revision) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Revision) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Revision) GetRollbackOf() uint32 {
+	if m != nil {
+		return m.RollbackOf
+	}
+	return 0
+}
+
+func (m *Revision) GetFunctions() *PayloadFunctions {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *Revision) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
+}
+
+// RevisionList is the list of revisions of the payload functions of an application, oldest first
+type RevisionList struct {
+	Revisions []*Revision `protobuf:"bytes,1,rep,name=revisions" json:"revisions,omitempty"`
+}
+
+func (m *RevisionList) Reset()                    { *m = RevisionList{} }
+func (*RevisionList) ProtoMessage()               {}
+func (*RevisionList) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{24} }
+
+func (m *RevisionList) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type RevisionIdentifier struct {
+	AppID  string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *RevisionIdentifier) Reset()                    { *m = RevisionIdentifier{} }
+func (*RevisionIdentifier) ProtoMessage()               {}
+func (*RevisionIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{25} }
+
+func (m *RevisionIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *RevisionIdentifier) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type PortFunctionsList struct {
+	Ports map[uint32]*PortFunctions `protobuf:"bytes,1,rep,name=ports" json:"ports,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *PortFunctionsList) Reset()                    { *m = PortFunctionsList{} }
+func (*PortFunctionsList) ProtoMessage()               {}
+func (*PortFunctionsList) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{26} }
+
+func (m *PortFunctionsList) GetPorts() map[uint32]*PortFunctions {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type PortFunctionsIdentifier struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FPort uint32 `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+}
+
+func (m *PortFunctionsIdentifier) Reset()                    { *m = PortFunctionsIdentifier{} }
+func (*PortFunctionsIdentifier) ProtoMessage()               {}
+func (*PortFunctionsIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{27} }
+
+func (m *PortFunctionsIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *PortFunctionsIdentifier) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+type SetPortFunctionsRequest struct {
+	AppID     string         `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	FPort     uint32         `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	Functions *PortFunctions `protobuf:"bytes,3,opt,name=functions" json:"functions,omitempty"`
+}
+
+func (m *SetPortFunctionsRequest) Reset()                    { *m = SetPortFunctionsRequest{} }
+func (*SetPortFunctionsRequest) ProtoMessage()               {}
+func (*SetPortFunctionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{28} }
+
+func (m *SetPortFunctionsRequest) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *SetPortFunctionsRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *SetPortFunctionsRequest) GetFunctions() *PortFunctions {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+type FunctionSetList struct {
+	Sets map[string]*PortFunctions `protobuf:"bytes,1,rep,name=sets" json:"sets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *FunctionSetList) Reset()                    { *m = FunctionSetList{} }
+func (*FunctionSetList) ProtoMessage()               {}
+func (*FunctionSetList) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{29} }
+
+func (m *FunctionSetList) GetSets() map[string]*PortFunctions {
+	if m != nil {
+		return m.Sets
+	}
+	return nil
+}
+
+type FunctionSetIdentifier struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *FunctionSetIdentifier) Reset()                    { *m = FunctionSetIdentifier{} }
+func (*FunctionSetIdentifier) ProtoMessage()               {}
+func (*FunctionSetIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{30} }
+
+func (m *FunctionSetIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *FunctionSetIdentifier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type SetFunctionSetRequest struct {
+	AppID     string         `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Name      string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Functions *PortFunctions `protobuf:"bytes,3,opt,name=functions" json:"functions,omitempty"`
+}
+
+func (m *SetFunctionSetRequest) Reset()                    { *m = SetFunctionSetRequest{} }
+func (*SetFunctionSetRequest) ProtoMessage()               {}
+func (*SetFunctionSetRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{31} }
+
+func (m *SetFunctionSetRequest) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *SetFunctionSetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetFunctionSetRequest) GetFunctions() *PortFunctions {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+// DevicePayloadFormat is the payload format of a device, which overrides the payload format of the application
+type DevicePayloadFormat struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// PayloadFormat is empty if the payload format of the application is used
+	PayloadFormat string `protobuf:"bytes,3,opt,name=payload_format,json=payloadFormat,proto3" json:"payload_format,omitempty"`
+	// FunctionSet is the name of the function set of the application that is used by the custom payload format
+	FunctionSet string `protobuf:"bytes,4,opt,name=function_set,json=functionSet,proto3" json:"function_set,omitempty"`
+}
+
+func (m *DevicePayloadFormat) Reset()                    { *m = DevicePayloadFormat{} }
+func (*DevicePayloadFormat) ProtoMessage()               {}
+func (*DevicePayloadFormat) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{32} }
+
+func (m *DevicePayloadFormat) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *DevicePayloadFormat) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+func (m *DevicePayloadFormat) GetPayloadFormat() string {
+	if m != nil {
+		return m.PayloadFormat
+	}
+	return ""
+}
+
+func (m *DevicePayloadFormat) GetFunctionSet() string {
+	if m != nil {
+		return m.FunctionSet
+	}
+	return ""
+}
+
+// Fragmentation configures the reassembly of payloads that devices of an application split over multiple uplink
+// messages
+type Fragmentation struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Port is the FPort of the uplink messages that contain fragments
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Header is the format of the fragment header
+	Header string `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	// Fragments is the number of fragments of a payload, for headers that do not contain it
+	Fragments uint32 `protobuf:"varint,4,opt,name=fragments,proto3" json:"fragments,omitempty"`
+	// Timeout is the time in which all fragments of a payload should be received, for example "5m"
+	Timeout string `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *Fragmentation) Reset()                    { *m = Fragmentation{} }
+func (*Fragmentation) ProtoMessage()               {}
+func (*Fragmentation) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{33} }
+
+func (m *Fragmentation) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *Fragmentation) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *Fragmentation) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *Fragmentation) GetFragments() uint32 {
+	if m != nil {
+		return m.Fragments
+	}
+	return 0
+}
+
+func (m *Fragmentation) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
+type DeviceClass struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Class is A, B or C; empty is the same as A
+	Class string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+}
+
+func (m *DeviceClass) Reset()                    { *m = DeviceClass{} }
+func (*DeviceClass) ProtoMessage()               {}
+func (*DeviceClass) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{34} }
+
+func (m *DeviceClass) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *DeviceClass) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+func (m *DeviceClass) GetClass() string {
+	if m != nil {
+		return m.Class
+	}
+	return ""
+}
+
+// DeviceStatus is the status that a device of an application reported in response to a DevStatusReq
+type DeviceStatus struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DevID string `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// Battery is 0 for an external power source, 1 (minimum) to 254 (maximum) for the battery level, or 255 if
+	// the device could not measure the battery level
+	Battery uint32 `protobuf:"varint,3,opt,name=battery,proto3" json:"battery,omitempty"`
+	// Margin is the demodulation margin (in dB) of the last DevStatusReq that the device received
+	Margin int32 `protobuf:"varint,4,opt,name=margin,proto3" json:"margin,omitempty"`
+	// Time is the time at which the device reported the status in Unix nanoseconds
+	Time int64 `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *DeviceStatus) Reset()                    { *m = DeviceStatus{} }
+func (*DeviceStatus) ProtoMessage()               {}
+func (*DeviceStatus) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{35} }
+
+func (m *DeviceStatus) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *DeviceStatus) GetDevID() string {
+	if m != nil {
+		return m.DevID
+	}
+	return ""
+}
+
+func (m *DeviceStatus) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *DeviceStatus) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// Webhook is an HTTP endpoint that receives the uplink messages and events of an application
+type Webhook struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// ID of the webhook, unique within the application
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// UplinkURL is the URL that uplink messages are posted to. Uplink messages are not posted if empty.
+	UplinkURL string `protobuf:"bytes,3,opt,name=uplink_url,json=uplinkUrl,proto3" json:"uplink_url,omitempty"`
+	// EventURL is the URL that device and application events are posted to. Events are not posted if empty.
+	EventURL string `protobuf:"bytes,4,opt,name=event_url,json=eventUrl,proto3" json:"event_url,omitempty"`
+	// Headers are added to each request, for example for authentication. Their values are not returned.
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Webhook) Reset()                    { *m = Webhook{} }
+func (*Webhook) ProtoMessage()               {}
+func (*Webhook) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{36} }
+
+func (m *Webhook) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *Webhook) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Webhook) GetUplinkURL() string {
+	if m != nil {
+		return m.UplinkURL
+	}
+	return ""
+}
+
+func (m *Webhook) GetEventURL() string {
+	if m != nil {
+		return m.EventURL
+	}
+	return ""
+}
+
+func (m *Webhook) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type WebhookList struct {
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks" json:"webhooks,omitempty"`
+}
+
+func (m *WebhookList) Reset()                    { *m = WebhookList{} }
+func (*WebhookList) ProtoMessage()               {}
+func (*WebhookList) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{37} }
+
+func (m *WebhookList) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type WebhookIdentifier struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ID    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *WebhookIdentifier) Reset()                    { *m = WebhookIdentifier{} }
+func (*WebhookIdentifier) ProtoMessage()               {}
+func (*WebhookIdentifier) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{38} }
+
+func (m *WebhookIdentifier) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *WebhookIdentifier) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+// HandlerStatusRequest requests the status of the Handler that is not part of the status of the HandlerManager
+type HandlerStatusRequest struct {
+}
+
+func (m *HandlerStatusRequest) Reset()                    { *m = HandlerStatusRequest{} }
+func (*HandlerStatusRequest) ProtoMessage()               {}
+func (*HandlerStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{39} }
+
+// IntegrationStatus is the health and spool depth of an integration of the Handler
+type IntegrationStatus struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Spool is the number of uplink messages that are waiting for the integration
+	Spool uint32 `protobuf:"varint,3,opt,name=spool,proto3" json:"spool,omitempty"`
+}
+
+func (m *IntegrationStatus) Reset()                    { *m = IntegrationStatus{} }
+func (*IntegrationStatus) ProtoMessage()               {}
+func (*IntegrationStatus) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{40} }
+
+func (m *IntegrationStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IntegrationStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *IntegrationStatus) GetSpool() uint32 {
+	if m != nil {
+		return m.Spool
+	}
+	return 0
+}
+
+// FunctionStatus is the execution time (in milliseconds) of the payload functions of an application in a direction
+type FunctionStatus struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Direction is uplink or downlink
+	Direction string  `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Count     int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Mean      float64 `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	P50       float64 `protobuf:"fixed64,5,opt,name=p50,proto3" json:"p50,omitempty"`
+	P95       float64 `protobuf:"fixed64,6,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99       float64 `protobuf:"fixed64,7,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max       float64 `protobuf:"fixed64,8,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *FunctionStatus) Reset()                    { *m = FunctionStatus{} }
+func (*FunctionStatus) ProtoMessage()               {}
+func (*FunctionStatus) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{41} }
+
+func (m *FunctionStatus) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *FunctionStatus) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *FunctionStatus) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FunctionStatus) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *FunctionStatus) GetP50() float64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *FunctionStatus) GetP95() float64 {
+	if m != nil {
+		return m.P95
+	}
+	return 0
+}
+
+func (m *FunctionStatus) GetP99() float64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+func (m *FunctionStatus) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type HandlerStatus struct {
+	Integrations     []*IntegrationStatus `protobuf:"bytes,1,rep,name=integrations" json:"integrations,omitempty"`
+	PayloadFunctions []*FunctionStatus    `protobuf:"bytes,2,rep,name=payload_functions,json=payloadFunctions" json:"payload_functions,omitempty"`
+}
+
+func (m *HandlerStatus) Reset()                    { *m = HandlerStatus{} }
+func (*HandlerStatus) ProtoMessage()               {}
+func (*HandlerStatus) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{42} }
+
+func (m *HandlerStatus) GetIntegrations() []*IntegrationStatus {
+	if m != nil {
+		return m.Integrations
+	}
+	return nil
+}
+
+func (m *HandlerStatus) GetPayloadFunctions() []*FunctionStatus {
+	if m != nil {
+		return m.PayloadFunctions
+	}
+	return nil
+}
+
+// QuotaLimits are the traffic limits of an application or device. Zero values are unlimited.
+type QuotaLimits struct {
+	UplinksPerHour  int64 `protobuf:"varint,1,opt,name=uplinks_per_hour,json=uplinksPerHour,proto3" json:"uplinks_per_hour,omitempty"`
+	DownlinksPerDay int64 `protobuf:"varint,2,opt,name=downlinks_per_day,json=downlinksPerDay,proto3" json:"downlinks_per_day,omitempty"`
+	// DownlinkAirtimePerDay is the maximum total airtime of downlink messages per day, in milliseconds
+	DownlinkAirtimePerDay int64 `protobuf:"varint,3,opt,name=downlink_airtime_per_day,json=downlinkAirtimePerDay,proto3" json:"downlink_airtime_per_day,omitempty"`
+}
+
+func (m *QuotaLimits) Reset()                    { *m = QuotaLimits{} }
+func (*QuotaLimits) ProtoMessage()               {}
+func (*QuotaLimits) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{43} }
+
+func (m *QuotaLimits) GetUplinksPerHour() int64 {
+	if m != nil {
+		return m.UplinksPerHour
+	}
+	return 0
+}
+
+func (m *QuotaLimits) GetDownlinksPerDay() int64 {
+	if m != nil {
+		return m.DownlinksPerDay
+	}
+	return 0
+}
+
+func (m *QuotaLimits) GetDownlinkAirtimePerDay() int64 {
+	if m != nil {
+		return m.DownlinkAirtimePerDay
+	}
+	return 0
+}
+
+// QuotaUsage is the traffic usage of an application in the current period of each quota
+type QuotaUsage struct {
+	UplinksPerHour  int64 `protobuf:"varint,1,opt,name=uplinks_per_hour,json=uplinksPerHour,proto3" json:"uplinks_per_hour,omitempty"`
+	DownlinksPerDay int64 `protobuf:"varint,2,opt,name=downlinks_per_day,json=downlinksPerDay,proto3" json:"downlinks_per_day,omitempty"`
+	// DownlinkAirtimePerDay is in milliseconds
+	DownlinkAirtimePerDay int64 `protobuf:"varint,3,opt,name=downlink_airtime_per_day,json=downlinkAirtimePerDay,proto3" json:"downlink_airtime_per_day,omitempty"`
+}
+
+func (m *QuotaUsage) Reset()                    { *m = QuotaUsage{} }
+func (*QuotaUsage) ProtoMessage()               {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{44} }
+
+func (m *QuotaUsage) GetUplinksPerHour() int64 {
+	if m != nil {
+		return m.UplinksPerHour
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetDownlinksPerDay() int64 {
+	if m != nil {
+		return m.DownlinksPerDay
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetDownlinkAirtimePerDay() int64 {
+	if m != nil {
+		return m.DownlinkAirtimePerDay
+	}
+	return 0
+}
+
+// Quotas are the traffic limits of an application and of each of its devices
+type Quotas struct {
+	AppID       string       `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Application *QuotaLimits `protobuf:"bytes,2,opt,name=application" json:"application,omitempty"`
+	Device      *QuotaLimits `protobuf:"bytes,3,opt,name=device" json:"device,omitempty"`
+	// Usage is the current usage of the application. It is ignored when setting quotas.
+	Usage *QuotaUsage `protobuf:"bytes,4,opt,name=usage" json:"usage,omitempty"`
+}
+
+func (m *Quotas) Reset()                    { *m = Quotas{} }
+func (*Quotas) ProtoMessage()               {}
+func (*Quotas) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{45} }
+
+func (m *Quotas) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *Quotas) GetApplication() *QuotaLimits {
+	if m != nil {
+		return m.Application
+	}
+	return nil
+}
+
+func (m *Quotas) GetDevice() *QuotaLimits {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *Quotas) GetUsage() *QuotaUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// BinaryFormat is the field layout that an application uses for the binary payload format
+type BinaryFormat struct {
+	AppID string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Format is the JSON field layout of uplink and downlink messages per FPort. An empty format removes it.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (m *BinaryFormat) Reset()                    { *m = BinaryFormat{} }
+func (*BinaryFormat) ProtoMessage()               {}
+func (*BinaryFormat) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{46} }
+
+func (m *BinaryFormat) GetAppID() string {
+	if m != nil {
+		return m.AppID
+	}
+	return ""
+}
+
+func (m *BinaryFormat) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type NetworkServerDeviceIdentifier struct {
+	AppEUI []byte `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3" json:"app_eui,omitempty"`
+	DevEUI []byte `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3" json:"dev_eui,omitempty"`
+}
+
+func (m *NetworkServerDeviceIdentifier) Reset()      { *m = NetworkServerDeviceIdentifier{} }
+func (*NetworkServerDeviceIdentifier) ProtoMessage() {}
+func (*NetworkServerDeviceIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptorManager, []int{47}
+}
+
+func (m *NetworkServerDeviceIdentifier) GetAppEUI() []byte {
+	if m != nil {
+		return m.AppEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceIdentifier) GetDevEUI() []byte {
+	if m != nil {
+		return m.DevEUI
+	}
+	return nil
+}
+
+type NetworkServerDeviceClass struct {
+	AppEUI []byte `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3" json:"app_eui,omitempty"`
+	DevEUI []byte `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3" json:"dev_eui,omitempty"`
+	// Class is A, B or C; empty is the same as A
+	Class string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+}
+
+func (m *NetworkServerDeviceClass) Reset()      { *m = NetworkServerDeviceClass{} }
+func (*NetworkServerDeviceClass) ProtoMessage() {}
+func (*NetworkServerDeviceClass) Descriptor() ([]byte, []int) {
+	return fileDescriptorManager, []int{48}
+}
+
+func (m *NetworkServerDeviceClass) GetAppEUI() []byte {
+	if m != nil {
+		return m.AppEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceClass) GetDevEUI() []byte {
+	if m != nil {
+		return m.DevEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceClass) GetClass() string {
+	if m != nil {
+		return m.Class
+	}
+	return ""
+}
+
+// RXSettings are the receive window settings of a device. The data rates are indexes in the data rates of the
+// frequency plan; the RX1 delay is in seconds.
+type RXSettings struct {
+	RX1DROffset  uint32 `protobuf:"varint,1,opt,name=rx1_dr_offset,json=rx1DrOffset,proto3" json:"rx1_dr_offset,omitempty"`
+	RX2DataRate  uint32 `protobuf:"varint,2,opt,name=rx2_data_rate,json=rx2DataRate,proto3" json:"rx2_data_rate,omitempty"`
+	RX2Frequency uint64 `protobuf:"varint,3,opt,name=rx2_frequency,json=rx2Frequency,proto3" json:"rx2_frequency,omitempty"`
+	RX1Delay     uint32 `protobuf:"varint,4,opt,name=rx1_delay,json=rx1Delay,proto3" json:"rx1_delay,omitempty"`
+}
+
+func (m *RXSettings) Reset()                    { *m = RXSettings{} }
+func (*RXSettings) ProtoMessage()               {}
+func (*RXSettings) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{49} }
+
+func (m *RXSettings) GetRX1DROffset() uint32 {
+	if m != nil {
+		return m.RX1DROffset
+	}
+	return 0
+}
+
+func (m *RXSettings) GetRX2DataRate() uint32 {
+	if m != nil {
+		return m.RX2DataRate
+	}
+	return 0
+}
+
+func (m *RXSettings) GetRX2Frequency() uint64 {
+	if m != nil {
+		return m.RX2Frequency
+	}
+	return 0
+}
+
+func (m *RXSettings) GetRX1Delay() uint32 {
+	if m != nil {
+		return m.RX1Delay
+	}
+	return 0
+}
+
+type NetworkServerDeviceRXSettings struct {
+	AppEUI []byte `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3" json:"app_eui,omitempty"`
+	DevEUI []byte `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3" json:"dev_eui,omitempty"`
+	// Current are the settings that the device uses, which can not be set
+	Current *RXSettings `protobuf:"bytes,3,opt,name=current" json:"current,omitempty"`
+	// Desired are the settings that the NetworkServer configures with a RXParamSetupReq and RXTimingSetupReq. If
+	// empty, the NetworkServer does not change the settings of the device.
+	Desired *RXSettings `protobuf:"bytes,4,opt,name=desired" json:"desired,omitempty"`
+}
+
+func (m *NetworkServerDeviceRXSettings) Reset()      { *m = NetworkServerDeviceRXSettings{} }
+func (*NetworkServerDeviceRXSettings) ProtoMessage() {}
+func (*NetworkServerDeviceRXSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptorManager, []int{50}
+}
+
+func (m *NetworkServerDeviceRXSettings) GetAppEUI() []byte {
+	if m != nil {
+		return m.AppEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceRXSettings) GetDevEUI() []byte {
+	if m != nil {
+		return m.DevEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceRXSettings) GetCurrent() *RXSettings {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceRXSettings) GetDesired() *RXSettings {
+	if m != nil {
+		return m.Desired
+	}
+	return nil
+}
+
+// Channel is an uplink channel of a device, next to the default channels of its band
+type Channel struct {
+	Index     uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Frequency uint64 `protobuf:"varint,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// DownlinkFrequency is the RX1 frequency of the channel, if it differs from the uplink frequency
+	DownlinkFrequency uint64 `protobuf:"varint,3,opt,name=downlink_frequency,json=downlinkFrequency,proto3" json:"downlink_frequency,omitempty"`
+	MinDataRate       uint32 `protobuf:"varint,4,opt,name=min_data_rate,json=minDataRate,proto3" json:"min_data_rate,omitempty"`
+	MaxDataRate       uint32 `protobuf:"varint,5,opt,name=max_data_rate,json=maxDataRate,proto3" json:"max_data_rate,omitempty"`
+}
+
+func (m *Channel) Reset()                    { *m = Channel{} }
+func (*Channel) ProtoMessage()               {}
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptorManager, []int{51} }
+
+func (m *Channel) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Channel) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *Channel) GetDownlinkFrequency() uint64 {
+	if m != nil {
+		return m.DownlinkFrequency
+	}
+	return 0
+}
+
+func (m *Channel) GetMinDataRate() uint32 {
+	if m != nil {
+		return m.MinDataRate
+	}
+	return 0
+}
+
+func (m *Channel) GetMaxDataRate() uint32 {
+	if m != nil {
+		return m.MaxDataRate
+	}
+	return 0
+}
+
+type NetworkServerDeviceChannels struct {
+	AppEUI []byte `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3" json:"app_eui,omitempty"`
+	DevEUI []byte `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3" json:"dev_eui,omitempty"`
+	// Band is the band of the device, which can not be set
+	Band string `protobuf:"bytes,3,opt,name=band,proto3" json:"band,omitempty"`
+	// Override are the channels that the NetworkServer configures instead of the extra channels of the band. If
+	// empty, the device uses the channels of the band.
+	Override []*Channel `protobuf:"bytes,4,rep,name=override" json:"override,omitempty"`
+	// Confirmed are the channels that the device acknowledged, which can not be set
+	Confirmed []*Channel `protobuf:"bytes,5,rep,name=confirmed" json:"confirmed,omitempty"`
+}
+
+func (m *NetworkServerDeviceChannels) Reset()      { *m = NetworkServerDeviceChannels{} }
+func (*NetworkServerDeviceChannels) ProtoMessage() {}
+func (*NetworkServerDeviceChannels) Descriptor() ([]byte, []int) {
+	return fileDescriptorManager, []int{52}
+}
+
+func (m *NetworkServerDeviceChannels) GetAppEUI() []byte {
+	if m != nil {
+		return m.AppEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceChannels) GetDevEUI() []byte {
+	if m != nil {
+		return m.DevEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceChannels) GetBand() string {
+	if m != nil {
+		return m.Band
+	}
+	return ""
+}
+
+func (m *NetworkServerDeviceChannels) GetOverride() []*Channel {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceChannels) GetConfirmed() []*Channel {
+	if m != nil {
+		return m.Confirmed
+	}
+	return nil
+}
+
+// NetworkServerDeviceStatus is the status interval and the last status of a device in the NetworkServer
+type NetworkServerDeviceStatus struct {
+	AppEUI []byte `protobuf:"bytes,1,opt,name=app_eui,json=appEui,proto3" json:"app_eui,omitempty"`
+	DevEUI []byte `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3" json:"dev_eui,omitempty"`
+	// Interval at which the NetworkServer sends a DevStatusReq, for example "24h". Empty for the default interval,
+	// negative to not request the status.
+	Interval string `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Battery and Margin are the last status that the device reported, which can not be set
+	Battery uint32 `protobuf:"varint,4,opt,name=battery,proto3" json:"battery,omitempty"`
+	Margin  int32  `protobuf:"varint,5,opt,name=margin,proto3" json:"margin,omitempty"`
+	// UpdatedAt is the time of the last status in Unix nanoseconds, or 0 if the device did not report its status
+	UpdatedAt int64 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *NetworkServerDeviceStatus) Reset()      { *m = NetworkServerDeviceStatus{} }
+func (*NetworkServerDeviceStatus) ProtoMessage() {}
+func (*NetworkServerDeviceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptorManager, []int{53}
+}
+
+func (m *NetworkServerDeviceStatus) GetAppEUI() []byte {
+	if m != nil {
+		return m.AppEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceStatus) GetDevEUI() []byte {
+	if m != nil {
+		return m.DevEUI
+	}
+	return nil
+}
+
+func (m *NetworkServerDeviceStatus) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *NetworkServerDeviceStatus) GetBattery() uint32 {
+	if m != nil {
+		return m.Battery
+	}
+	return 0
+}
+
+func (m *NetworkServerDeviceStatus) GetMargin() int32 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *NetworkServerDeviceStatus) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ApplicationIdentifier)(nil), "manager.ApplicationIdentifier")
+	proto.RegisterType((*DeviceIdentifier)(nil), "manager.DeviceIdentifier")
+	proto.RegisterType((*DownlinkMessage)(nil), "manager.DownlinkMessage")
+	proto.RegisterType((*DownlinkQueue)(nil), "manager.DownlinkQueue")
+	proto.RegisterType((*QueuedDownlinkIdentifier)(nil), "manager.QueuedDownlinkIdentifier")
+	proto.RegisterType((*HistoryRequest)(nil), "manager.HistoryRequest")
+	proto.RegisterType((*HistoryUplink)(nil), "manager.HistoryUplink")
+	proto.RegisterType((*HistoryEvent)(nil), "manager.HistoryEvent")
+	proto.RegisterType((*HistoryEntry)(nil), "manager.HistoryEntry")
+	proto.RegisterType((*History)(nil), "manager.History")
+	proto.RegisterType((*DeviceGroup)(nil), "manager.DeviceGroup")
+	proto.RegisterType((*DeviceGroupList)(nil), "manager.DeviceGroupList")
+	proto.RegisterType((*DeviceGroupIdentifier)(nil), "manager.DeviceGroupIdentifier")
+	proto.RegisterType((*GroupDownlinkRequest)(nil), "manager.GroupDownlinkRequest")
+	proto.RegisterType((*GroupDownlinkIdentifier)(nil), "manager.GroupDownlinkIdentifier")
+	proto.RegisterType((*GroupDownlinkReport)(nil), "manager.GroupDownlinkReport")
+	proto.RegisterType((*ImportDevicesRequest)(nil), "manager.ImportDevicesRequest")
+	proto.RegisterType((*ImportDevicesError)(nil), "manager.ImportDevicesError")
+	proto.RegisterType((*ImportDevicesResult)(nil), "manager.ImportDevicesResult")
+	proto.RegisterType((*ExportDevicesRequest)(nil), "manager.ExportDevicesRequest")
+	proto.RegisterType((*ExportDevicesData)(nil), "manager.ExportDevicesData")
+	proto.RegisterType((*PortFunctions)(nil), "manager.PortFunctions")
+	proto.RegisterType((*PayloadFunctions)(nil), "manager.PayloadFunctions")
+	proto.RegisterType((*Revision)(nil), "manager.Revision")
+	proto.RegisterType((*RevisionList)(nil), "manager.RevisionList")
+	proto.RegisterType((*RevisionIdentifier)(nil), "manager.RevisionIdentifier")
+	proto.RegisterType((*PortFunctionsList)(nil), "manager.PortFunctionsList")
+	proto.RegisterType((*PortFunctionsIdentifier)(nil), "manager.PortFunctionsIdentifier")
+	proto.RegisterType((*SetPortFunctionsRequest)(nil), "manager.SetPortFunctionsRequest")
+	proto.RegisterType((*FunctionSetList)(nil), "manager.FunctionSetList")
+	proto.RegisterType((*FunctionSetIdentifier)(nil), "manager.FunctionSetIdentifier")
+	proto.RegisterType((*SetFunctionSetRequest)(nil), "manager.SetFunctionSetRequest")
+	proto.RegisterType((*DevicePayloadFormat)(nil), "manager.DevicePayloadFormat")
+	proto.RegisterType((*Fragmentation)(nil), "manager.Fragmentation")
+	proto.RegisterType((*DeviceClass)(nil), "manager.DeviceClass")
+	proto.RegisterType((*DeviceStatus)(nil), "manager.DeviceStatus")
+	proto.RegisterType((*Webhook)(nil), "manager.Webhook")
+	proto.RegisterType((*WebhookList)(nil), "manager.WebhookList")
+	proto.RegisterType((*WebhookIdentifier)(nil), "manager.WebhookIdentifier")
+	proto.RegisterType((*HandlerStatusRequest)(nil), "manager.HandlerStatusRequest")
+	proto.RegisterType((*IntegrationStatus)(nil), "manager.IntegrationStatus")
+	proto.RegisterType((*FunctionStatus)(nil), "manager.FunctionStatus")
+	proto.RegisterType((*HandlerStatus)(nil), "manager.HandlerStatus")
+	proto.RegisterType((*QuotaLimits)(nil), "manager.QuotaLimits")
+	proto.RegisterType((*QuotaUsage)(nil), "manager.QuotaUsage")
+	proto.RegisterType((*Quotas)(nil), "manager.Quotas")
+	proto.RegisterType((*BinaryFormat)(nil), "manager.BinaryFormat")
+	proto.RegisterType((*NetworkServerDeviceIdentifier)(nil), "manager.NetworkServerDeviceIdentifier")
+	proto.RegisterType((*NetworkServerDeviceClass)(nil), "manager.NetworkServerDeviceClass")
+	proto.RegisterType((*RXSettings)(nil), "manager.RXSettings")
+	proto.RegisterType((*NetworkServerDeviceRXSettings)(nil), "manager.NetworkServerDeviceRXSettings")
+	proto.RegisterType((*Channel)(nil), "manager.Channel")
+	proto.RegisterType((*NetworkServerDeviceChannels)(nil), "manager.NetworkServerDeviceChannels")
+	proto.RegisterType((*NetworkServerDeviceStatus)(nil), "manager.NetworkServerDeviceStatus")
+}
+func (this *ApplicationIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ApplicationIdentifier)
+	if !ok {
+		that2, ok := that.(ApplicationIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ApplicationIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ApplicationIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ApplicationIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	return nil
+}
+func (this *ApplicationIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ApplicationIdentifier)
+	if !ok {
+		that2, ok := that.(ApplicationIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	return true
+}
+func (this *DeviceIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceIdentifier)
+	if !ok {
+		that2, ok := that.(DeviceIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	return nil
+}
+func (this *DeviceIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceIdentifier)
+	if !ok {
+		that2, ok := that.(DeviceIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	return true
+}
+func (this *DownlinkMessage) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DownlinkMessage)
+	if !ok {
+		that2, ok := that.(DownlinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DownlinkMessage")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DownlinkMessage but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DownlinkMessage but is not nil && this == nil")
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if this.FPort != that1.FPort {
+		return fmt.Errorf("FPort this(%v) Not Equal that(%v)", this.FPort, that1.FPort)
+	}
+	if this.Confirmed != that1.Confirmed {
+		return fmt.Errorf("Confirmed this(%v) Not Equal that(%v)", this.Confirmed, that1.Confirmed)
+	}
+	if this.Schedule != that1.Schedule {
+		return fmt.Errorf("Schedule this(%v) Not Equal that(%v)", this.Schedule, that1.Schedule)
+	}
+	if !bytes.Equal(this.PayloadRaw, that1.PayloadRaw) {
+		return fmt.Errorf("PayloadRaw this(%v) Not Equal that(%v)", this.PayloadRaw, that1.PayloadRaw)
+	}
+	if this.PayloadFields != that1.PayloadFields {
+		return fmt.Errorf("PayloadFields this(%v) Not Equal that(%v)", this.PayloadFields, that1.PayloadFields)
+	}
+	if this.TTL != that1.TTL {
+		return fmt.Errorf("TTL this(%v) Not Equal that(%v)", this.TTL, that1.TTL)
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return fmt.Errorf("ExpiresAt this(%v) Not Equal that(%v)", this.ExpiresAt, that1.ExpiresAt)
+	}
+	if !this.MaxRetries.Equal(that1.MaxRetries) {
+		return fmt.Errorf("MaxRetries this(%v) Not Equal that(%v)", this.MaxRetries, that1.MaxRetries)
+	}
+	if this.GroupDownlinkID != that1.GroupDownlinkID {
+		return fmt.Errorf("GroupDownlinkID this(%v) Not Equal that(%v)", this.GroupDownlinkID, that1.GroupDownlinkID)
+	}
+	return nil
+}
+func (this *DownlinkMessage) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DownlinkMessage)
+	if !ok {
+		that2, ok := that.(DownlinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if this.Confirmed != that1.Confirmed {
+		return false
+	}
+	if this.Schedule != that1.Schedule {
+		return false
+	}
+	if !bytes.Equal(this.PayloadRaw, that1.PayloadRaw) {
+		return false
+	}
+	if this.PayloadFields != that1.PayloadFields {
+		return false
+	}
+	if this.TTL != that1.TTL {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if !this.MaxRetries.Equal(that1.MaxRetries) {
+		return false
+	}
+	if this.GroupDownlinkID != that1.GroupDownlinkID {
+		return false
+	}
+	return true
+}
+func (this *DownlinkQueue) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DownlinkQueue)
+	if !ok {
+		that2, ok := that.(DownlinkQueue)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DownlinkQueue")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DownlinkQueue but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DownlinkQueue but is not nil && this == nil")
+	}
+	if !this.Current.Equal(that1.Current) {
+		return fmt.Errorf("Current this(%v) Not Equal that(%v)", this.Current, that1.Current)
+	}
+	if this.Attempts != that1.Attempts {
+		return fmt.Errorf("Attempts this(%v) Not Equal that(%v)", this.Attempts, that1.Attempts)
+	}
+	if len(this.Queue) != len(that1.Queue) {
+		return fmt.Errorf("Queue this(%v) Not Equal that(%v)", len(this.Queue), len(that1.Queue))
+	}
+	for i := range this.Queue {
+		if !this.Queue[i].Equal(that1.Queue[i]) {
+			return fmt.Errorf("Queue this[%v](%v) Not Equal that[%v](%v)", i, this.Queue[i], i, that1.Queue[i])
+		}
+	}
+	return nil
+}
+func (this *DownlinkQueue) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DownlinkQueue)
+	if !ok {
+		that2, ok := that.(DownlinkQueue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !this.Current.Equal(that1.Current) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if len(this.Queue) != len(that1.Queue) {
+		return false
+	}
+	for i := range this.Queue {
+		if !this.Queue[i].Equal(that1.Queue[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QueuedDownlinkIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueuedDownlinkIdentifier)
+	if !ok {
+		that2, ok := that.(QueuedDownlinkIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueuedDownlinkIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueuedDownlinkIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueuedDownlinkIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	return nil
+}
+func (this *QueuedDownlinkIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QueuedDownlinkIdentifier)
+	if !ok {
+		that2, ok := that.(QueuedDownlinkIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	return true
+}
+func (this *HistoryRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HistoryRequest)
+	if !ok {
+		that2, ok := that.(HistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HistoryRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HistoryRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HistoryRequest but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	if this.Since != that1.Since {
+		return fmt.Errorf("Since this(%v) Not Equal that(%v)", this.Since, that1.Since)
+	}
+	if this.Until != that1.Until {
+		return fmt.Errorf("Until this(%v) Not Equal that(%v)", this.Until, that1.Until)
+	}
+	if this.Limit != that1.Limit {
+		return fmt.Errorf("Limit this(%v) Not Equal that(%v)", this.Limit, that1.Limit)
+	}
+	return nil
+}
+func (this *HistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HistoryRequest)
+	if !ok {
+		that2, ok := that.(HistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	if this.Since != that1.Since {
+		return false
+	}
+	if this.Until != that1.Until {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *HistoryUplink) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HistoryUplink)
+	if !ok {
+		that2, ok := that.(HistoryUplink)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HistoryUplink")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HistoryUplink but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HistoryUplink but is not nil && this == nil")
+	}
+	if this.FPort != that1.FPort {
+		return fmt.Errorf("FPort this(%v) Not Equal that(%v)", this.FPort, that1.FPort)
+	}
+	if this.FCnt != that1.FCnt {
+		return fmt.Errorf("FCnt this(%v) Not Equal that(%v)", this.FCnt, that1.FCnt)
+	}
+	if this.Confirmed != that1.Confirmed {
+		return fmt.Errorf("Confirmed this(%v) Not Equal that(%v)", this.Confirmed, that1.Confirmed)
+	}
+	if this.IsRetry != that1.IsRetry {
+		return fmt.Errorf("IsRetry this(%v) Not Equal that(%v)", this.IsRetry, that1.IsRetry)
+	}
+	if !bytes.Equal(this.PayloadRaw, that1.PayloadRaw) {
+		return fmt.Errorf("PayloadRaw this(%v) Not Equal that(%v)", this.PayloadRaw, that1.PayloadRaw)
+	}
+	if this.PayloadFields != that1.PayloadFields {
+		return fmt.Errorf("PayloadFields this(%v) Not Equal that(%v)", this.PayloadFields, that1.PayloadFields)
+	}
+	if this.Metadata != that1.Metadata {
+		return fmt.Errorf("Metadata this(%v) Not Equal that(%v)", this.Metadata, that1.Metadata)
+	}
+	return nil
+}
+func (this *HistoryUplink) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HistoryUplink)
+	if !ok {
+		that2, ok := that.(HistoryUplink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if this.Confirmed != that1.Confirmed {
+		return false
+	}
+	if this.IsRetry != that1.IsRetry {
+		return false
+	}
+	if !bytes.Equal(this.PayloadRaw, that1.PayloadRaw) {
+		return false
+	}
+	if this.PayloadFields != that1.PayloadFields {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	return true
+}
+func (this *HistoryEvent) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HistoryEvent)
+	if !ok {
+		that2, ok := that.(HistoryEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HistoryEvent")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HistoryEvent but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HistoryEvent but is not nil && this == nil")
+	}
+	if this.Event != that1.Event {
+		return fmt.Errorf("Event this(%v) Not Equal that(%v)", this.Event, that1.Event)
+	}
+	if this.Data != that1.Data {
+		return fmt.Errorf("Data this(%v) Not Equal that(%v)", this.Data, that1.Data)
+	}
+	return nil
+}
+func (this *HistoryEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HistoryEvent)
+	if !ok {
+		that2, ok := that.(HistoryEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Event != that1.Event {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	return true
+}
+func (this *HistoryEntry) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HistoryEntry)
+	if !ok {
+		that2, ok := that.(HistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HistoryEntry")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HistoryEntry but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HistoryEntry but is not nil && this == nil")
+	}
+	if this.Time != that1.Time {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return fmt.Errorf("Uplink this(%v) Not Equal that(%v)", this.Uplink, that1.Uplink)
+	}
+	if !this.Event.Equal(that1.Event) {
+		return fmt.Errorf("Event this(%v) Not Equal that(%v)", this.Event, that1.Event)
+	}
+	return nil
+}
+func (this *HistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HistoryEntry)
+	if !ok {
+		that2, ok := that.(HistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Event.Equal(that1.Event) {
+		return false
+	}
+	return true
+}
+func (this *History) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*History)
+	if !ok {
+		that2, ok := that.(History)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *History")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *History but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *History but is not nil && this == nil")
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return fmt.Errorf("Entries this(%v) Not Equal that(%v)", len(this.Entries), len(that1.Entries))
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return fmt.Errorf("Entries this[%v](%v) Not Equal that[%v](%v)", i, this.Entries[i], i, that1.Entries[i])
+		}
+	}
+	return nil
+}
+func (this *History) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*History)
+	if !ok {
+		that2, ok := that.(History)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DeviceGroup) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceGroup)
+	if !ok {
+		that2, ok := that.(DeviceGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceGroup")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceGroup but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceGroup but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if len(this.Devices) != len(that1.Devices) {
+		return fmt.Errorf("Devices this(%v) Not Equal that(%v)", len(this.Devices), len(that1.Devices))
+	}
+	for i := range this.Devices {
+		if this.Devices[i] != that1.Devices[i] {
+			return fmt.Errorf("Devices this[%v](%v) Not Equal that[%v](%v)", i, this.Devices[i], i, that1.Devices[i])
+		}
+	}
+	if len(this.Selector) != len(that1.Selector) {
+		return fmt.Errorf("Selector this(%v) Not Equal that(%v)", len(this.Selector), len(that1.Selector))
+	}
+	for i := range this.Selector {
+		if this.Selector[i] != that1.Selector[i] {
+			return fmt.Errorf("Selector this[%v](%v) Not Equal that[%v](%v)", i, this.Selector[i], i, that1.Selector[i])
+		}
+	}
+	if len(this.Members) != len(that1.Members) {
+		return fmt.Errorf("Members this(%v) Not Equal that(%v)", len(this.Members), len(that1.Members))
+	}
+	for i := range this.Members {
+		if this.Members[i] != that1.Members[i] {
+			return fmt.Errorf("Members this[%v](%v) Not Equal that[%v](%v)", i, this.Members[i], i, that1.Members[i])
+		}
+	}
+	return nil
+}
+func (this *DeviceGroup) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceGroup)
+	if !ok {
+		that2, ok := that.(DeviceGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if len(this.Devices) != len(that1.Devices) {
+		return false
+	}
+	for i := range this.Devices {
+		if this.Devices[i] != that1.Devices[i] {
+			return false
+		}
+	}
+	if len(this.Selector) != len(that1.Selector) {
+		return false
+	}
+	for i := range this.Selector {
+		if this.Selector[i] != that1.Selector[i] {
+			return false
+		}
+	}
+	if len(this.Members) != len(that1.Members) {
+		return false
+	}
+	for i := range this.Members {
+		if this.Members[i] != that1.Members[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DeviceGroupList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceGroupList)
+	if !ok {
+		that2, ok := that.(DeviceGroupList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceGroupList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceGroupList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceGroupList but is not nil && this == nil")
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return fmt.Errorf("Groups this(%v) Not Equal that(%v)", len(this.Groups), len(that1.Groups))
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return fmt.Errorf("Groups this[%v](%v) Not Equal that[%v](%v)", i, this.Groups[i], i, that1.Groups[i])
+		}
+	}
+	return nil
+}
+func (this *DeviceGroupList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceGroupList)
+	if !ok {
+		that2, ok := that.(DeviceGroupList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range this.Groups {
+		if !this.Groups[i].Equal(that1.Groups[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DeviceGroupIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceGroupIdentifier)
+	if !ok {
+		that2, ok := that.(DeviceGroupIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceGroupIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceGroupIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceGroupIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.GroupID != that1.GroupID {
+		return fmt.Errorf("GroupID this(%v) Not Equal that(%v)", this.GroupID, that1.GroupID)
+	}
+	return nil
+}
+func (this *DeviceGroupIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceGroupIdentifier)
+	if !ok {
+		that2, ok := that.(DeviceGroupIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	return true
+}
+func (this *GroupDownlinkRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*GroupDownlinkRequest)
+	if !ok {
+		that2, ok := that.(GroupDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *GroupDownlinkRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *GroupDownlinkRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *GroupDownlinkRequest but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.GroupID != that1.GroupID {
+		return fmt.Errorf("GroupID this(%v) Not Equal that(%v)", this.GroupID, that1.GroupID)
+	}
+	if !this.Message.Equal(that1.Message) {
+		return fmt.Errorf("Message this(%v) Not Equal that(%v)", this.Message, that1.Message)
+	}
+	return nil
+}
+func (this *GroupDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*GroupDownlinkRequest)
+	if !ok {
+		that2, ok := that.(GroupDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if !this.Message.Equal(that1.Message) {
+		return false
+	}
+	return true
+}
+func (this *GroupDownlinkIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*GroupDownlinkIdentifier)
+	if !ok {
+		that2, ok := that.(GroupDownlinkIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *GroupDownlinkIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *GroupDownlinkIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *GroupDownlinkIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.GroupID != that1.GroupID {
+		return fmt.Errorf("GroupID this(%v) Not Equal that(%v)", this.GroupID, that1.GroupID)
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	return nil
+}
+func (this *GroupDownlinkIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*GroupDownlinkIdentifier)
+	if !ok {
+		that2, ok := that.(GroupDownlinkIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	return true
+}
+func (this *GroupDownlinkReport) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*GroupDownlinkReport)
+	if !ok {
+		that2, ok := that.(GroupDownlinkReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *GroupDownlinkReport")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *GroupDownlinkReport but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *GroupDownlinkReport but is not nil && this == nil")
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.GroupID != that1.GroupID {
+		return fmt.Errorf("GroupID this(%v) Not Equal that(%v)", this.GroupID, that1.GroupID)
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
+	}
+	if this.Devices != that1.Devices {
+		return fmt.Errorf("Devices this(%v) Not Equal that(%v)", this.Devices, that1.Devices)
+	}
+	if this.Scheduled != that1.Scheduled {
+		return fmt.Errorf("Scheduled this(%v) Not Equal that(%v)", this.Scheduled, that1.Scheduled)
+	}
+	if this.Failed != that1.Failed {
+		return fmt.Errorf("Failed this(%v) Not Equal that(%v)", this.Failed, that1.Failed)
+	}
+	if this.Sent != that1.Sent {
+		return fmt.Errorf("Sent this(%v) Not Equal that(%v)", this.Sent, that1.Sent)
+	}
+	if this.Acked != that1.Acked {
+		return fmt.Errorf("Acked this(%v) Not Equal that(%v)", this.Acked, that1.Acked)
+	}
+	return nil
+}
+func (this *GroupDownlinkReport) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*GroupDownlinkReport)
+	if !ok {
+		that2, ok := that.(GroupDownlinkReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.GroupID != that1.GroupID {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Devices != that1.Devices {
+		return false
+	}
+	if this.Scheduled != that1.Scheduled {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	if this.Sent != that1.Sent {
+		return false
+	}
+	if this.Acked != that1.Acked {
+		return false
+	}
+	return true
+}
+func (this *ImportDevicesRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ImportDevicesRequest)
+	if !ok {
+		that2, ok := that.(ImportDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ImportDevicesRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ImportDevicesRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ImportDevicesRequest but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Format != that1.Format {
+		return fmt.Errorf("Format this(%v) Not Equal that(%v)", this.Format, that1.Format)
+	}
+	if this.DryRun != that1.DryRun {
+		return fmt.Errorf("DryRun this(%v) Not Equal that(%v)", this.DryRun, that1.DryRun)
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return fmt.Errorf("Data this(%v) Not Equal that(%v)", this.Data, that1.Data)
+	}
+	return nil
+}
+func (this *ImportDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ImportDevicesRequest)
+	if !ok {
+		that2, ok := that.(ImportDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *ImportDevicesError) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ImportDevicesError)
+	if !ok {
+		that2, ok := that.(ImportDevicesError)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ImportDevicesError")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ImportDevicesError but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ImportDevicesError but is not nil && this == nil")
+	}
+	if this.Row != that1.Row {
+		return fmt.Errorf("Row this(%v) Not Equal that(%v)", this.Row, that1.Row)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	if this.Error != that1.Error {
+		return fmt.Errorf("Error this(%v) Not Equal that(%v)", this.Error, that1.Error)
+	}
+	return nil
+}
+func (this *ImportDevicesError) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ImportDevicesError)
+	if !ok {
+		that2, ok := that.(ImportDevicesError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Row != that1.Row {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ImportDevicesResult) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ImportDevicesResult)
+	if !ok {
+		that2, ok := that.(ImportDevicesResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ImportDevicesResult")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ImportDevicesResult but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ImportDevicesResult but is not nil && this == nil")
+	}
+	if this.DryRun != that1.DryRun {
+		return fmt.Errorf("DryRun this(%v) Not Equal that(%v)", this.DryRun, that1.DryRun)
+	}
+	if this.Rows != that1.Rows {
+		return fmt.Errorf("Rows this(%v) Not Equal that(%v)", this.Rows, that1.Rows)
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if this.Updated != that1.Updated {
+		return fmt.Errorf("Updated this(%v) Not Equal that(%v)", this.Updated, that1.Updated)
+	}
+	if this.Failed != that1.Failed {
+		return fmt.Errorf("Failed this(%v) Not Equal that(%v)", this.Failed, that1.Failed)
+	}
+	if len(this.Errors) != len(that1.Errors) {
+		return fmt.Errorf("Errors this(%v) Not Equal that(%v)", len(this.Errors), len(that1.Errors))
+	}
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return fmt.Errorf("Errors this[%v](%v) Not Equal that[%v](%v)", i, this.Errors[i], i, that1.Errors[i])
+		}
+	}
+	return nil
+}
+func (this *ImportDevicesResult) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ImportDevicesResult)
+	if !ok {
+		that2, ok := that.(ImportDevicesResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	if this.Rows != that1.Rows {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Updated != that1.Updated {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	if len(this.Errors) != len(that1.Errors) {
+		return false
+	}
+	for i := range this.Errors {
+		if !this.Errors[i].Equal(that1.Errors[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExportDevicesRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ExportDevicesRequest)
+	if !ok {
+		that2, ok := that.(ExportDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ExportDevicesRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ExportDevicesRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ExportDevicesRequest but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Format != that1.Format {
+		return fmt.Errorf("Format this(%v) Not Equal that(%v)", this.Format, that1.Format)
+	}
+	return nil
+}
+func (this *ExportDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ExportDevicesRequest)
+	if !ok {
+		that2, ok := that.(ExportDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	return true
+}
+func (this *ExportDevicesData) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ExportDevicesData)
+	if !ok {
+		that2, ok := that.(ExportDevicesData)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ExportDevicesData")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ExportDevicesData but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ExportDevicesData but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return fmt.Errorf("Data this(%v) Not Equal that(%v)", this.Data, that1.Data)
+	}
+	return nil
+}
+func (this *ExportDevicesData) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*ExportDevicesData)
+	if !ok {
+		that2, ok := that.(ExportDevicesData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *PortFunctions) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PortFunctions)
+	if !ok {
+		that2, ok := that.(PortFunctions)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PortFunctions")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PortFunctions but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PortFunctions but is not nil && this == nil")
+	}
+	if this.Decoder != that1.Decoder {
+		return fmt.Errorf("Decoder this(%v) Not Equal that(%v)", this.Decoder, that1.Decoder)
+	}
+	if this.Converter != that1.Converter {
+		return fmt.Errorf("Converter this(%v) Not Equal that(%v)", this.Converter, that1.Converter)
+	}
+	if this.Validator != that1.Validator {
+		return fmt.Errorf("Validator this(%v) Not Equal that(%v)", this.Validator, that1.Validator)
+	}
+	if this.Encoder != that1.Encoder {
+		return fmt.Errorf("Encoder this(%v) Not Equal that(%v)", this.Encoder, that1.Encoder)
+	}
+	return nil
+}
+func (this *PortFunctions) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PortFunctions)
+	if !ok {
+		that2, ok := that.(PortFunctions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Decoder != that1.Decoder {
+		return false
+	}
+	if this.Converter != that1.Converter {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
+	return true
+}
+func (this *PayloadFunctions) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PayloadFunctions)
+	if !ok {
+		that2, ok := that.(PayloadFunctions)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PayloadFunctions")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PayloadFunctions but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PayloadFunctions but is not nil && this == nil")
+	}
+	if this.PayloadFormat != that1.PayloadFormat {
+		return fmt.Errorf("PayloadFormat this(%v) Not Equal that(%v)", this.PayloadFormat, that1.PayloadFormat)
+	}
+	if this.Decoder != that1.Decoder {
+		return fmt.Errorf("Decoder this(%v) Not Equal that(%v)", this.Decoder, that1.Decoder)
+	}
+	if this.Converter != that1.Converter {
+		return fmt.Errorf("Converter this(%v) Not Equal that(%v)", this.Converter, that1.Converter)
+	}
+	if this.Validator != that1.Validator {
+		return fmt.Errorf("Validator this(%v) Not Equal that(%v)", this.Validator, that1.Validator)
+	}
+	if this.Encoder != that1.Encoder {
+		return fmt.Errorf("Encoder this(%v) Not Equal that(%v)", this.Encoder, that1.Encoder)
+	}
+	if this.BinaryFormat != that1.BinaryFormat {
+		return fmt.Errorf("BinaryFormat this(%v) Not Equal that(%v)", this.BinaryFormat, that1.BinaryFormat)
+	}
+	if len(this.Ports) != len(that1.Ports) {
+		return fmt.Errorf("Ports this(%v) Not Equal that(%v)", len(this.Ports), len(that1.Ports))
+	}
+	for i := range this.Ports {
+		if !this.Ports[i].Equal(that1.Ports[i]) {
+			return fmt.Errorf("Ports this[%v](%v) Not Equal that[%v](%v)", i, this.Ports[i], i, that1.Ports[i])
+		}
+	}
+	if len(this.Sets) != len(that1.Sets) {
+		return fmt.Errorf("Sets this(%v) Not Equal that(%v)", len(this.Sets), len(that1.Sets))
+	}
+	for i := range this.Sets {
+		if !this.Sets[i].Equal(that1.Sets[i]) {
+			return fmt.Errorf("Sets this[%v](%v) Not Equal that[%v](%v)", i, this.Sets[i], i, that1.Sets[i])
+		}
+	}
+	return nil
+}
+func (this *PayloadFunctions) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PayloadFunctions)
+	if !ok {
+		that2, ok := that.(PayloadFunctions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.PayloadFormat != that1.PayloadFormat {
+		return false
+	}
+	if this.Decoder != that1.Decoder {
+		return false
+	}
+	if this.Converter != that1.Converter {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.Encoder != that1.Encoder {
+		return false
+	}
+	if this.BinaryFormat != that1.BinaryFormat {
+		return false
+	}
+	if len(this.Ports) != len(that1.Ports) {
+		return false
+	}
+	for i := range this.Ports {
+		if !this.Ports[i].Equal(that1.Ports[i]) {
+			return false
+		}
+	}
+	if len(this.Sets) != len(that1.Sets) {
+		return false
+	}
+	for i := range this.Sets {
+		if !this.Sets[i].Equal(that1.Sets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Revision) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Revision)
+	if !ok {
+		that2, ok := that.(Revision)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Revision")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Revision but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Revision but is not nil && this == nil")
+	}
+	if this.Number != that1.Number {
+		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
+	}
+	if this.Author != that1.Author {
+		return fmt.Errorf("Author this(%v) Not Equal that(%v)", this.Author, that1.Author)
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
+	}
+	if this.RollbackOf != that1.RollbackOf {
+		return fmt.Errorf("RollbackOf this(%v) Not Equal that(%v)", this.RollbackOf, that1.RollbackOf)
+	}
+	if !this.Functions.Equal(that1.Functions) {
+		return fmt.Errorf("Functions this(%v) Not Equal that(%v)", this.Functions, that1.Functions)
+	}
+	if this.Diff != that1.Diff {
+		return fmt.Errorf("Diff this(%v) Not Equal that(%v)", this.Diff, that1.Diff)
+	}
+	return nil
+}
+func (this *Revision) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Revision)
+	if !ok {
+		that2, ok := that.(Revision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Number != that1.Number {
+		return false
+	}
+	if this.Author != that1.Author {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.RollbackOf != that1.RollbackOf {
+		return false
+	}
+	if !this.Functions.Equal(that1.Functions) {
+		return false
+	}
+	if this.Diff != that1.Diff {
+		return false
+	}
+	return true
+}
+func (this *RevisionList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RevisionList)
+	if !ok {
+		that2, ok := that.(RevisionList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RevisionList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RevisionList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RevisionList but is not nil && this == nil")
+	}
+	if len(this.Revisions) != len(that1.Revisions) {
+		return fmt.Errorf("Revisions this(%v) Not Equal that(%v)", len(this.Revisions), len(that1.Revisions))
+	}
+	for i := range this.Revisions {
+		if !this.Revisions[i].Equal(that1.Revisions[i]) {
+			return fmt.Errorf("Revisions this[%v](%v) Not Equal that[%v](%v)", i, this.Revisions[i], i, that1.Revisions[i])
+		}
+	}
+	return nil
+}
+func (this *RevisionList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RevisionList)
+	if !ok {
+		that2, ok := that.(RevisionList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Revisions) != len(that1.Revisions) {
+		return false
+	}
+	for i := range this.Revisions {
+		if !this.Revisions[i].Equal(that1.Revisions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RevisionIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RevisionIdentifier)
+	if !ok {
+		that2, ok := that.(RevisionIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RevisionIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RevisionIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RevisionIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Number != that1.Number {
+		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
+	}
+	return nil
+}
+func (this *RevisionIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RevisionIdentifier)
+	if !ok {
+		that2, ok := that.(RevisionIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Number != that1.Number {
+		return false
+	}
+	return true
+}
+func (this *PortFunctionsList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PortFunctionsList)
+	if !ok {
+		that2, ok := that.(PortFunctionsList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PortFunctionsList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PortFunctionsList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PortFunctionsList but is not nil && this == nil")
+	}
+	if len(this.Ports) != len(that1.Ports) {
+		return fmt.Errorf("Ports this(%v) Not Equal that(%v)", len(this.Ports), len(that1.Ports))
+	}
+	for i := range this.Ports {
+		if !this.Ports[i].Equal(that1.Ports[i]) {
+			return fmt.Errorf("Ports this[%v](%v) Not Equal that[%v](%v)", i, this.Ports[i], i, that1.Ports[i])
+		}
+	}
+	return nil
+}
+func (this *PortFunctionsList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PortFunctionsList)
+	if !ok {
+		that2, ok := that.(PortFunctionsList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Ports) != len(that1.Ports) {
+		return false
+	}
+	for i := range this.Ports {
+		if !this.Ports[i].Equal(that1.Ports[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PortFunctionsIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PortFunctionsIdentifier)
+	if !ok {
+		that2, ok := that.(PortFunctionsIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PortFunctionsIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PortFunctionsIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PortFunctionsIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.FPort != that1.FPort {
+		return fmt.Errorf("FPort this(%v) Not Equal that(%v)", this.FPort, that1.FPort)
+	}
+	return nil
+}
+func (this *PortFunctionsIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PortFunctionsIdentifier)
+	if !ok {
+		that2, ok := that.(PortFunctionsIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	return true
+}
+func (this *SetPortFunctionsRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SetPortFunctionsRequest)
+	if !ok {
+		that2, ok := that.(SetPortFunctionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SetPortFunctionsRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SetPortFunctionsRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SetPortFunctionsRequest but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.FPort != that1.FPort {
+		return fmt.Errorf("FPort this(%v) Not Equal that(%v)", this.FPort, that1.FPort)
+	}
+	if !this.Functions.Equal(that1.Functions) {
+		return fmt.Errorf("Functions this(%v) Not Equal that(%v)", this.Functions, that1.Functions)
+	}
+	return nil
+}
+func (this *SetPortFunctionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*SetPortFunctionsRequest)
+	if !ok {
+		that2, ok := that.(SetPortFunctionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !this.Functions.Equal(that1.Functions) {
+		return false
+	}
+	return true
+}
+func (this *FunctionSetList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*FunctionSetList)
+	if !ok {
+		that2, ok := that.(FunctionSetList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *FunctionSetList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *FunctionSetList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *FunctionSetList but is not nil && this == nil")
+	}
+	if len(this.Sets) != len(that1.Sets) {
+		return fmt.Errorf("Sets this(%v) Not Equal that(%v)", len(this.Sets), len(that1.Sets))
+	}
+	for i := range this.Sets {
+		if !this.Sets[i].Equal(that1.Sets[i]) {
+			return fmt.Errorf("Sets this[%v](%v) Not Equal that[%v](%v)", i, this.Sets[i], i, that1.Sets[i])
+		}
+	}
+	return nil
+}
+func (this *FunctionSetList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*FunctionSetList)
+	if !ok {
+		that2, ok := that.(FunctionSetList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Sets) != len(that1.Sets) {
+		return false
+	}
+	for i := range this.Sets {
+		if !this.Sets[i].Equal(that1.Sets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FunctionSetIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*FunctionSetIdentifier)
+	if !ok {
+		that2, ok := that.(FunctionSetIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *FunctionSetIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *FunctionSetIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *FunctionSetIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	return nil
+}
+func (this *FunctionSetIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*FunctionSetIdentifier)
+	if !ok {
+		that2, ok := that.(FunctionSetIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *SetFunctionSetRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SetFunctionSetRequest)
+	if !ok {
+		that2, ok := that.(SetFunctionSetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SetFunctionSetRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SetFunctionSetRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SetFunctionSetRequest but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	if !this.Functions.Equal(that1.Functions) {
+		return fmt.Errorf("Functions this(%v) Not Equal that(%v)", this.Functions, that1.Functions)
+	}
+	return nil
+}
+func (this *SetFunctionSetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*SetFunctionSetRequest)
+	if !ok {
+		that2, ok := that.(SetFunctionSetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.Functions.Equal(that1.Functions) {
+		return false
+	}
+	return true
+}
+func (this *DevicePayloadFormat) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DevicePayloadFormat)
+	if !ok {
+		that2, ok := that.(DevicePayloadFormat)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DevicePayloadFormat")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DevicePayloadFormat but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DevicePayloadFormat but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	if this.PayloadFormat != that1.PayloadFormat {
+		return fmt.Errorf("PayloadFormat this(%v) Not Equal that(%v)", this.PayloadFormat, that1.PayloadFormat)
+	}
+	if this.FunctionSet != that1.FunctionSet {
+		return fmt.Errorf("FunctionSet this(%v) Not Equal that(%v)", this.FunctionSet, that1.FunctionSet)
+	}
+	return nil
+}
+func (this *DevicePayloadFormat) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DevicePayloadFormat)
+	if !ok {
+		that2, ok := that.(DevicePayloadFormat)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	if this.PayloadFormat != that1.PayloadFormat {
+		return false
+	}
+	if this.FunctionSet != that1.FunctionSet {
+		return false
+	}
+	return true
+}
+func (this *Fragmentation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Fragmentation)
+	if !ok {
+		that2, ok := that.(Fragmentation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Fragmentation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Fragmentation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Fragmentation but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Port != that1.Port {
+		return fmt.Errorf("Port this(%v) Not Equal that(%v)", this.Port, that1.Port)
+	}
+	if this.Header != that1.Header {
+		return fmt.Errorf("Header this(%v) Not Equal that(%v)", this.Header, that1.Header)
+	}
+	if this.Fragments != that1.Fragments {
+		return fmt.Errorf("Fragments this(%v) Not Equal that(%v)", this.Fragments, that1.Fragments)
+	}
+	if this.Timeout != that1.Timeout {
+		return fmt.Errorf("Timeout this(%v) Not Equal that(%v)", this.Timeout, that1.Timeout)
+	}
+	return nil
+}
+func (this *Fragmentation) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Fragmentation)
+	if !ok {
+		that2, ok := that.(Fragmentation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if this.Header != that1.Header {
+		return false
+	}
+	if this.Fragments != that1.Fragments {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	return true
+}
+func (this *DeviceClass) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceClass)
+	if !ok {
+		that2, ok := that.(DeviceClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceClass")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceClass but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceClass but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	if this.Class != that1.Class {
+		return fmt.Errorf("Class this(%v) Not Equal that(%v)", this.Class, that1.Class)
+	}
+	return nil
+}
+func (this *DeviceClass) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceClass)
+	if !ok {
+		that2, ok := that.(DeviceClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	if this.Class != that1.Class {
+		return false
+	}
+	return true
+}
+func (this *DeviceStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceStatus)
+	if !ok {
+		that2, ok := that.(DeviceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceStatus but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.DevID != that1.DevID {
+		return fmt.Errorf("DevID this(%v) Not Equal that(%v)", this.DevID, that1.DevID)
+	}
+	if this.Battery != that1.Battery {
+		return fmt.Errorf("Battery this(%v) Not Equal that(%v)", this.Battery, that1.Battery)
+	}
+	if this.Margin != that1.Margin {
+		return fmt.Errorf("Margin this(%v) Not Equal that(%v)", this.Margin, that1.Margin)
+	}
+	if this.Time != that1.Time {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	return nil
+}
+func (this *DeviceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeviceStatus)
+	if !ok {
+		that2, ok := that.(DeviceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.DevID != that1.DevID {
+		return false
+	}
+	if this.Battery != that1.Battery {
+		return false
+	}
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	return true
+}
+func (this *Webhook) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Webhook)
+	if !ok {
+		that2, ok := that.(Webhook)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Webhook")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Webhook but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Webhook but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	if this.UplinkURL != that1.UplinkURL {
+		return fmt.Errorf("UplinkURL this(%v) Not Equal that(%v)", this.UplinkURL, that1.UplinkURL)
+	}
+	if this.EventURL != that1.EventURL {
+		return fmt.Errorf("EventURL this(%v) Not Equal that(%v)", this.EventURL, that1.EventURL)
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return fmt.Errorf("Headers this(%v) Not Equal that(%v)", len(this.Headers), len(that1.Headers))
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return fmt.Errorf("Headers this[%v](%v) Not Equal that[%v](%v)", i, this.Headers[i], i, that1.Headers[i])
+		}
+	}
+	return nil
+}
+func (this *Webhook) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Webhook)
+	if !ok {
+		that2, ok := that.(Webhook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.UplinkURL != that1.UplinkURL {
+		return false
+	}
+	if this.EventURL != that1.EventURL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *WebhookList) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*WebhookList)
+	if !ok {
+		that2, ok := that.(WebhookList)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *WebhookList")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *WebhookList but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *WebhookList but is not nil && this == nil")
+	}
+	if len(this.Webhooks) != len(that1.Webhooks) {
+		return fmt.Errorf("Webhooks this(%v) Not Equal that(%v)", len(this.Webhooks), len(that1.Webhooks))
+	}
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return fmt.Errorf("Webhooks this[%v](%v) Not Equal that[%v](%v)", i, this.Webhooks[i], i, that1.Webhooks[i])
+		}
+	}
+	return nil
+}
+func (this *WebhookList) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*WebhookList)
+	if !ok {
+		that2, ok := that.(WebhookList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Webhooks) != len(that1.Webhooks) {
+		return false
+	}
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WebhookIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*WebhookIdentifier)
+	if !ok {
+		that2, ok := that.(WebhookIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *WebhookIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *WebhookIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *WebhookIdentifier but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.ID != that1.ID {
+		return fmt.Errorf("ID this(%v) Not Equal that(%v)", this.ID, that1.ID)
+	}
+	return nil
+}
+func (this *WebhookIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*WebhookIdentifier)
+	if !ok {
+		that2, ok := that.(WebhookIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	return true
+}
+func (this *HandlerStatusRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HandlerStatusRequest)
+	if !ok {
+		that2, ok := that.(HandlerStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HandlerStatusRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HandlerStatusRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HandlerStatusRequest but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *HandlerStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HandlerStatusRequest)
+	if !ok {
+		that2, ok := that.(HandlerStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *IntegrationStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*IntegrationStatus)
+	if !ok {
+		that2, ok := that.(IntegrationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *IntegrationStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *IntegrationStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *IntegrationStatus but is not nil && this == nil")
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	if this.Healthy != that1.Healthy {
+		return fmt.Errorf("Healthy this(%v) Not Equal that(%v)", this.Healthy, that1.Healthy)
+	}
+	if this.Spool != that1.Spool {
+		return fmt.Errorf("Spool this(%v) Not Equal that(%v)", this.Spool, that1.Spool)
+	}
+	return nil
+}
+func (this *IntegrationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*IntegrationStatus)
+	if !ok {
+		that2, ok := that.(IntegrationStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Healthy != that1.Healthy {
+		return false
+	}
+	if this.Spool != that1.Spool {
+		return false
+	}
+	return true
+}
+func (this *FunctionStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*FunctionStatus)
+	if !ok {
+		that2, ok := that.(FunctionStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *FunctionStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *FunctionStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *FunctionStatus but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Direction != that1.Direction {
+		return fmt.Errorf("Direction this(%v) Not Equal that(%v)", this.Direction, that1.Direction)
+	}
+	if this.Count != that1.Count {
+		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
+	}
+	if this.Mean != that1.Mean {
+		return fmt.Errorf("Mean this(%v) Not Equal that(%v)", this.Mean, that1.Mean)
+	}
+	if this.P50 != that1.P50 {
+		return fmt.Errorf("P50 this(%v) Not Equal that(%v)", this.P50, that1.P50)
+	}
+	if this.P95 != that1.P95 {
+		return fmt.Errorf("P95 this(%v) Not Equal that(%v)", this.P95, that1.P95)
+	}
+	if this.P99 != that1.P99 {
+		return fmt.Errorf("P99 this(%v) Not Equal that(%v)", this.P99, that1.P99)
+	}
+	if this.Max != that1.Max {
+		return fmt.Errorf("Max this(%v) Not Equal that(%v)", this.Max, that1.Max)
+	}
+	return nil
+}
+func (this *FunctionStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*FunctionStatus)
+	if !ok {
+		that2, ok := that.(FunctionStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Mean != that1.Mean {
+		return false
+	}
+	if this.P50 != that1.P50 {
+		return false
+	}
+	if this.P95 != that1.P95 {
+		return false
+	}
+	if this.P99 != that1.P99 {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	return true
+}
+func (this *HandlerStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*HandlerStatus)
+	if !ok {
+		that2, ok := that.(HandlerStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HandlerStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HandlerStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HandlerStatus but is not nil && this == nil")
+	}
+	if len(this.Integrations) != len(that1.Integrations) {
+		return fmt.Errorf("Integrations this(%v) Not Equal that(%v)", len(this.Integrations), len(that1.Integrations))
+	}
+	for i := range this.Integrations {
+		if !this.Integrations[i].Equal(that1.Integrations[i]) {
+			return fmt.Errorf("Integrations this[%v](%v) Not Equal that[%v](%v)", i, this.Integrations[i], i, that1.Integrations[i])
+		}
+	}
+	if len(this.PayloadFunctions) != len(that1.PayloadFunctions) {
+		return fmt.Errorf("PayloadFunctions this(%v) Not Equal that(%v)", len(this.PayloadFunctions), len(that1.PayloadFunctions))
+	}
+	for i := range this.PayloadFunctions {
+		if !this.PayloadFunctions[i].Equal(that1.PayloadFunctions[i]) {
+			return fmt.Errorf("PayloadFunctions this[%v](%v) Not Equal that[%v](%v)", i, this.PayloadFunctions[i], i, that1.PayloadFunctions[i])
+		}
+	}
+	return nil
+}
+func (this *HandlerStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HandlerStatus)
+	if !ok {
+		that2, ok := that.(HandlerStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Integrations) != len(that1.Integrations) {
+		return false
+	}
+	for i := range this.Integrations {
+		if !this.Integrations[i].Equal(that1.Integrations[i]) {
+			return false
+		}
+	}
+	if len(this.PayloadFunctions) != len(that1.PayloadFunctions) {
+		return false
+	}
+	for i := range this.PayloadFunctions {
+		if !this.PayloadFunctions[i].Equal(that1.PayloadFunctions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QuotaLimits) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QuotaLimits)
+	if !ok {
+		that2, ok := that.(QuotaLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QuotaLimits")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QuotaLimits but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QuotaLimits but is not nil && this == nil")
+	}
+	if this.UplinksPerHour != that1.UplinksPerHour {
+		return fmt.Errorf("UplinksPerHour this(%v) Not Equal that(%v)", this.UplinksPerHour, that1.UplinksPerHour)
+	}
+	if this.DownlinksPerDay != that1.DownlinksPerDay {
+		return fmt.Errorf("DownlinksPerDay this(%v) Not Equal that(%v)", this.DownlinksPerDay, that1.DownlinksPerDay)
+	}
+	if this.DownlinkAirtimePerDay != that1.DownlinkAirtimePerDay {
+		return fmt.Errorf("DownlinkAirtimePerDay this(%v) Not Equal that(%v)", this.DownlinkAirtimePerDay, that1.DownlinkAirtimePerDay)
+	}
+	return nil
+}
+func (this *QuotaLimits) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuotaLimits)
+	if !ok {
+		that2, ok := that.(QuotaLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.UplinksPerHour != that1.UplinksPerHour {
+		return false
+	}
+	if this.DownlinksPerDay != that1.DownlinksPerDay {
+		return false
+	}
+	if this.DownlinkAirtimePerDay != that1.DownlinkAirtimePerDay {
+		return false
+	}
+	return true
+}
+func (this *QuotaUsage) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QuotaUsage)
+	if !ok {
+		that2, ok := that.(QuotaUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QuotaUsage")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QuotaUsage but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QuotaUsage but is not nil && this == nil")
+	}
+	if this.UplinksPerHour != that1.UplinksPerHour {
+		return fmt.Errorf("UplinksPerHour this(%v) Not Equal that(%v)", this.UplinksPerHour, that1.UplinksPerHour)
+	}
+	if this.DownlinksPerDay != that1.DownlinksPerDay {
+		return fmt.Errorf("DownlinksPerDay this(%v) Not Equal that(%v)", this.DownlinksPerDay, that1.DownlinksPerDay)
+	}
+	if this.DownlinkAirtimePerDay != that1.DownlinkAirtimePerDay {
+		return fmt.Errorf("DownlinkAirtimePerDay this(%v) Not Equal that(%v)", this.DownlinkAirtimePerDay, that1.DownlinkAirtimePerDay)
+	}
+	return nil
+}
+func (this *QuotaUsage) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*QuotaUsage)
+	if !ok {
+		that2, ok := that.(QuotaUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.UplinksPerHour != that1.UplinksPerHour {
+		return false
+	}
+	if this.DownlinksPerDay != that1.DownlinksPerDay {
+		return false
+	}
+	if this.DownlinkAirtimePerDay != that1.DownlinkAirtimePerDay {
+		return false
+	}
+	return true
+}
+func (this *Quotas) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Quotas)
+	if !ok {
+		that2, ok := that.(Quotas)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Quotas")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Quotas but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Quotas but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if !this.Application.Equal(that1.Application) {
+		return fmt.Errorf("Application this(%v) Not Equal that(%v)", this.Application, that1.Application)
+	}
+	if !this.Device.Equal(that1.Device) {
+		return fmt.Errorf("Device this(%v) Not Equal that(%v)", this.Device, that1.Device)
+	}
+	if !this.Usage.Equal(that1.Usage) {
+		return fmt.Errorf("Usage this(%v) Not Equal that(%v)", this.Usage, that1.Usage)
+	}
+	return nil
+}
+func (this *Quotas) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Quotas)
+	if !ok {
+		that2, ok := that.(Quotas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if !this.Application.Equal(that1.Application) {
+		return false
+	}
+	if !this.Device.Equal(that1.Device) {
+		return false
+	}
+	if !this.Usage.Equal(that1.Usage) {
+		return false
+	}
+	return true
+}
+func (this *BinaryFormat) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*BinaryFormat)
+	if !ok {
+		that2, ok := that.(BinaryFormat)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *BinaryFormat")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *BinaryFormat but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *BinaryFormat but is not nil && this == nil")
+	}
+	if this.AppID != that1.AppID {
+		return fmt.Errorf("AppID this(%v) Not Equal that(%v)", this.AppID, that1.AppID)
+	}
+	if this.Format != that1.Format {
+		return fmt.Errorf("Format this(%v) Not Equal that(%v)", this.Format, that1.Format)
+	}
+	return nil
+}
+func (this *BinaryFormat) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*BinaryFormat)
+	if !ok {
+		that2, ok := that.(BinaryFormat)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.AppID != that1.AppID {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	return true
+}
+func (this *NetworkServerDeviceIdentifier) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NetworkServerDeviceIdentifier)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NetworkServerDeviceIdentifier")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NetworkServerDeviceIdentifier but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NetworkServerDeviceIdentifier but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return fmt.Errorf("AppEUI this(%v) Not Equal that(%v)", this.AppEUI, that1.AppEUI)
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return fmt.Errorf("DevEUI this(%v) Not Equal that(%v)", this.DevEUI, that1.DevEUI)
+	}
+	return nil
+}
+func (this *NetworkServerDeviceIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*NetworkServerDeviceIdentifier)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return false
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return false
+	}
+	return true
+}
+func (this *NetworkServerDeviceClass) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NetworkServerDeviceClass)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NetworkServerDeviceClass")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NetworkServerDeviceClass but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NetworkServerDeviceClass but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return fmt.Errorf("AppEUI this(%v) Not Equal that(%v)", this.AppEUI, that1.AppEUI)
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return fmt.Errorf("DevEUI this(%v) Not Equal that(%v)", this.DevEUI, that1.DevEUI)
+	}
+	if this.Class != that1.Class {
+		return fmt.Errorf("Class this(%v) Not Equal that(%v)", this.Class, that1.Class)
+	}
+	return nil
+}
+func (this *NetworkServerDeviceClass) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*NetworkServerDeviceClass)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceClass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return false
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return false
+	}
+	if this.Class != that1.Class {
+		return false
+	}
+	return true
+}
+func (this *RXSettings) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RXSettings)
+	if !ok {
+		that2, ok := that.(RXSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RXSettings")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RXSettings but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RXSettings but is not nil && this == nil")
+	}
+	if this.RX1DROffset != that1.RX1DROffset {
+		return fmt.Errorf("RX1DROffset this(%v) Not Equal that(%v)", this.RX1DROffset, that1.RX1DROffset)
+	}
+	if this.RX2DataRate != that1.RX2DataRate {
+		return fmt.Errorf("RX2DataRate this(%v) Not Equal that(%v)", this.RX2DataRate, that1.RX2DataRate)
+	}
+	if this.RX2Frequency != that1.RX2Frequency {
+		return fmt.Errorf("RX2Frequency this(%v) Not Equal that(%v)", this.RX2Frequency, that1.RX2Frequency)
+	}
+	if this.RX1Delay != that1.RX1Delay {
+		return fmt.Errorf("RX1Delay this(%v) Not Equal that(%v)", this.RX1Delay, that1.RX1Delay)
+	}
+	return nil
+}
+func (this *RXSettings) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*RXSettings)
+	if !ok {
+		that2, ok := that.(RXSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.RX1DROffset != that1.RX1DROffset {
+		return false
+	}
+	if this.RX2DataRate != that1.RX2DataRate {
+		return false
+	}
+	if this.RX2Frequency != that1.RX2Frequency {
+		return false
+	}
+	if this.RX1Delay != that1.RX1Delay {
+		return false
+	}
+	return true
+}
+func (this *NetworkServerDeviceRXSettings) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NetworkServerDeviceRXSettings)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceRXSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NetworkServerDeviceRXSettings")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NetworkServerDeviceRXSettings but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NetworkServerDeviceRXSettings but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return fmt.Errorf("AppEUI this(%v) Not Equal that(%v)", this.AppEUI, that1.AppEUI)
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return fmt.Errorf("DevEUI this(%v) Not Equal that(%v)", this.DevEUI, that1.DevEUI)
+	}
+	if !this.Current.Equal(that1.Current) {
+		return fmt.Errorf("Current this(%v) Not Equal that(%v)", this.Current, that1.Current)
+	}
+	if !this.Desired.Equal(that1.Desired) {
+		return fmt.Errorf("Desired this(%v) Not Equal that(%v)", this.Desired, that1.Desired)
+	}
+	return nil
+}
+func (this *NetworkServerDeviceRXSettings) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*NetworkServerDeviceRXSettings)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceRXSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return false
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return false
+	}
+	if !this.Current.Equal(that1.Current) {
+		return false
+	}
+	if !this.Desired.Equal(that1.Desired) {
+		return false
+	}
+	return true
+}
+func (this *Channel) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Channel)
+	if !ok {
+		that2, ok := that.(Channel)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Channel")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Channel but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Channel but is not nil && this == nil")
+	}
+	if this.Index != that1.Index {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	}
+	if this.Frequency != that1.Frequency {
+		return fmt.Errorf("Frequency this(%v) Not Equal that(%v)", this.Frequency, that1.Frequency)
+	}
+	if this.DownlinkFrequency != that1.DownlinkFrequency {
+		return fmt.Errorf("DownlinkFrequency this(%v) Not Equal that(%v)", this.DownlinkFrequency, that1.DownlinkFrequency)
+	}
+	if this.MinDataRate != that1.MinDataRate {
+		return fmt.Errorf("MinDataRate this(%v) Not Equal that(%v)", this.MinDataRate, that1.MinDataRate)
+	}
+	if this.MaxDataRate != that1.MaxDataRate {
+		return fmt.Errorf("MaxDataRate this(%v) Not Equal that(%v)", this.MaxDataRate, that1.MaxDataRate)
+	}
+	return nil
+}
+func (this *Channel) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Channel)
+	if !ok {
+		that2, ok := that.(Channel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.DownlinkFrequency != that1.DownlinkFrequency {
+		return false
+	}
+	if this.MinDataRate != that1.MinDataRate {
+		return false
+	}
+	if this.MaxDataRate != that1.MaxDataRate {
+		return false
+	}
+	return true
+}
+func (this *NetworkServerDeviceChannels) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NetworkServerDeviceChannels)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceChannels)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NetworkServerDeviceChannels")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NetworkServerDeviceChannels but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NetworkServerDeviceChannels but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return fmt.Errorf("AppEUI this(%v) Not Equal that(%v)", this.AppEUI, that1.AppEUI)
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return fmt.Errorf("DevEUI this(%v) Not Equal that(%v)", this.DevEUI, that1.DevEUI)
+	}
+	if this.Band != that1.Band {
+		return fmt.Errorf("Band this(%v) Not Equal that(%v)", this.Band, that1.Band)
+	}
+	if len(this.Override) != len(that1.Override) {
+		return fmt.Errorf("Override this(%v) Not Equal that(%v)", len(this.Override), len(that1.Override))
+	}
+	for i := range this.Override {
+		if !this.Override[i].Equal(that1.Override[i]) {
+			return fmt.Errorf("Override this[%v](%v) Not Equal that[%v](%v)", i, this.Override[i], i, that1.Override[i])
+		}
+	}
+	if len(this.Confirmed) != len(that1.Confirmed) {
+		return fmt.Errorf("Confirmed this(%v) Not Equal that(%v)", len(this.Confirmed), len(that1.Confirmed))
+	}
+	for i := range this.Confirmed {
+		if !this.Confirmed[i].Equal(that1.Confirmed[i]) {
+			return fmt.Errorf("Confirmed this[%v](%v) Not Equal that[%v](%v)", i, this.Confirmed[i], i, that1.Confirmed[i])
+		}
+	}
+	return nil
+}
+func (this *NetworkServerDeviceChannels) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*NetworkServerDeviceChannels)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceChannels)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return false
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return false
+	}
+	if this.Band != that1.Band {
+		return false
+	}
+	if len(this.Override) != len(that1.Override) {
+		return false
+	}
+	for i := range this.Override {
+		if !this.Override[i].Equal(that1.Override[i]) {
+			return false
+		}
+	}
+	if len(this.Confirmed) != len(that1.Confirmed) {
+		return false
+	}
+	for i := range this.Confirmed {
+		if !this.Confirmed[i].Equal(that1.Confirmed[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NetworkServerDeviceStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NetworkServerDeviceStatus)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NetworkServerDeviceStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NetworkServerDeviceStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NetworkServerDeviceStatus but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return fmt.Errorf("AppEUI this(%v) Not Equal that(%v)", this.AppEUI, that1.AppEUI)
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return fmt.Errorf("DevEUI this(%v) Not Equal that(%v)", this.DevEUI, that1.DevEUI)
+	}
+	if this.Interval != that1.Interval {
+		return fmt.Errorf("Interval this(%v) Not Equal that(%v)", this.Interval, that1.Interval)
+	}
+	if this.Battery != that1.Battery {
+		return fmt.Errorf("Battery this(%v) Not Equal that(%v)", this.Battery, that1.Battery)
+	}
+	if this.Margin != that1.Margin {
+		return fmt.Errorf("Margin this(%v) Not Equal that(%v)", this.Margin, that1.Margin)
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return fmt.Errorf("UpdatedAt this(%v) Not Equal that(%v)", this.UpdatedAt, that1.UpdatedAt)
+	}
+	return nil
+}
+func (this *NetworkServerDeviceStatus) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*NetworkServerDeviceStatus)
+	if !ok {
+		that2, ok := that.(NetworkServerDeviceStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.AppEUI, that1.AppEUI) {
+		return false
+	}
+	if !bytes.Equal(this.DevEUI, that1.DevEUI) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.Battery != that1.Battery {
+		return false
+	}
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApplicationManager service
+
+type ApplicationManagerClient interface {
+	// GetDownlinkQueue returns the current downlink and the queued downlinks of a device
+	GetDownlinkQueue(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DownlinkQueue, error)
+	// DeleteQueuedDownlink removes a downlink from the queue of a device
+	DeleteQueuedDownlink(ctx context.Context, in *QueuedDownlinkIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// FlushDownlinkQueue removes the current downlink and all queued downlinks of a device
+	FlushDownlinkQueue(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetHistory returns the recent uplink messages and events of a device
+	GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*History, error)
+	// ListGroups returns the device groups of an application
+	ListGroups(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceGroupList, error)
+	// GetGroup returns a device group with its current members
+	GetGroup(ctx context.Context, in *DeviceGroupIdentifier, opts ...grpc.CallOption) (*DeviceGroup, error)
+	// SetGroup creates or updates a device group
+	SetGroup(ctx context.Context, in *DeviceGroup, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteGroup deletes a device group
+	DeleteGroup(ctx context.Context, in *DeviceGroupIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// EnqueueGroupDownlink enqueues a downlink for all devices of a group
+	EnqueueGroupDownlink(ctx context.Context, in *GroupDownlinkRequest, opts ...grpc.CallOption) (*GroupDownlinkReport, error)
+	// GetGroupDownlinkReport returns the state of a downlink that was sent to a device group
+	GetGroupDownlinkReport(ctx context.Context, in *GroupDownlinkIdentifier, opts ...grpc.CallOption) (*GroupDownlinkReport, error)
+	// ImportDevices creates or updates the devices in the file that is streamed by the client
+	ImportDevices(ctx context.Context, opts ...grpc.CallOption) (ApplicationManager_ImportDevicesClient, error)
+	// ExportDevices streams a file with all devices of an application
+	ExportDevices(ctx context.Context, in *ExportDevicesRequest, opts ...grpc.CallOption) (ApplicationManager_ExportDevicesClient, error)
+	// ListRevisions returns the revisions of the payload functions of an application
+	ListRevisions(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*RevisionList, error)
+	// GetRevision returns a revision of the payload functions of an application
+	GetRevision(ctx context.Context, in *RevisionIdentifier, opts ...grpc.CallOption) (*Revision, error)
+	// RollbackPayloadFunctions restores the payload functions of a revision, and returns the new revision that records this
+	RollbackPayloadFunctions(ctx context.Context, in *RevisionIdentifier, opts ...grpc.CallOption) (*Revision, error)
+	// ListPortFunctions returns the payload functions of an application per FPort
+	ListPortFunctions(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*PortFunctionsList, error)
+	// GetPortFunctions returns the payload functions of an application for an FPort
+	GetPortFunctions(ctx context.Context, in *PortFunctionsIdentifier, opts ...grpc.CallOption) (*PortFunctions, error)
+	// SetPortFunctions sets the payload functions of an application for an FPort
+	SetPortFunctions(ctx context.Context, in *SetPortFunctionsRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeletePortFunctions removes the payload functions of an application for an FPort, so that the default functions are used
+	DeletePortFunctions(ctx context.Context, in *PortFunctionsIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ListFunctionSets returns the function sets of an application
+	ListFunctionSets(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*FunctionSetList, error)
+	// GetFunctionSet returns a function set of an application
+	GetFunctionSet(ctx context.Context, in *FunctionSetIdentifier, opts ...grpc.CallOption) (*PortFunctions, error)
+	// SetFunctionSet creates or updates a function set of an application
+	SetFunctionSet(ctx context.Context, in *SetFunctionSetRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteFunctionSet removes a function set of an application
+	DeleteFunctionSet(ctx context.Context, in *FunctionSetIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDevicePayloadFormat returns the payload format of a device
+	GetDevicePayloadFormat(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DevicePayloadFormat, error)
+	// SetDevicePayloadFormat sets the payload format of a device; an empty payload format removes the override
+	SetDevicePayloadFormat(ctx context.Context, in *DevicePayloadFormat, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetFragmentation returns the fragmentation settings of an application
+	GetFragmentation(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Fragmentation, error)
+	// SetFragmentation sets the fragmentation settings of an application
+	SetFragmentation(ctx context.Context, in *Fragmentation, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteFragmentation disables fragment reassembly for an application
+	DeleteFragmentation(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeviceClass returns the LoRaWAN class of a device
+	GetDeviceClass(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceClass, error)
+	// SetDeviceClass sets the LoRaWAN class of a device in the Handler and NetworkServer
+	SetDeviceClass(ctx context.Context, in *DeviceClass, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDeviceStatus returns the status that a device reported in response to a DevStatusReq
+	GetDeviceStatus(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceStatus, error)
+	// GetWebhooks returns the webhooks of an application
+	GetWebhooks(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*WebhookList, error)
+	// SetWebhook adds a webhook to an application, or replaces the webhook with the same ID
+	SetWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteWebhook removes a webhook of an application
+	DeleteWebhook(ctx context.Context, in *WebhookIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetHandlerStatus returns the status of the integrations and payload functions of the Handler
+	GetHandlerStatus(ctx context.Context, in *HandlerStatusRequest, opts ...grpc.CallOption) (*HandlerStatus, error)
+	// GetQuotas returns the quotas of an application and its current usage
+	GetQuotas(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Quotas, error)
+	// SetQuotas sets the quotas of an application
+	SetQuotas(ctx context.Context, in *Quotas, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// DeleteQuotas removes the quotas of an application
+	DeleteQuotas(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetBinaryFormat returns the binary format of an application
+	GetBinaryFormat(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*BinaryFormat, error)
+	// SetBinaryFormat sets the binary format of an application, which is used with the binary payload format
+	SetBinaryFormat(ctx context.Context, in *BinaryFormat, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type applicationManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationManagerClient(cc *grpc.ClientConn) ApplicationManagerClient {
+	return &applicationManagerClient{cc}
+}
+
+func (c *applicationManagerClient) GetDownlinkQueue(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DownlinkQueue, error) {
+	out := new(DownlinkQueue)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetDownlinkQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteQueuedDownlink(ctx context.Context, in *QueuedDownlinkIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeleteQueuedDownlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) FlushDownlinkQueue(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/FlushDownlinkQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*History, error) {
+	out := new(History)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ListGroups(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*DeviceGroupList, error) {
+	out := new(DeviceGroupList)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/ListGroups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetGroup(ctx context.Context, in *DeviceGroupIdentifier, opts ...grpc.CallOption) (*DeviceGroup, error) {
+	out := new(DeviceGroup)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetGroup(ctx context.Context, in *DeviceGroup, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteGroup(ctx context.Context, in *DeviceGroupIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeleteGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) EnqueueGroupDownlink(ctx context.Context, in *GroupDownlinkRequest, opts ...grpc.CallOption) (*GroupDownlinkReport, error) {
+	out := new(GroupDownlinkReport)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/EnqueueGroupDownlink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetGroupDownlinkReport(ctx context.Context, in *GroupDownlinkIdentifier, opts ...grpc.CallOption) (*GroupDownlinkReport, error) {
+	out := new(GroupDownlinkReport)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetGroupDownlinkReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ImportDevices(ctx context.Context, opts ...grpc.CallOption) (ApplicationManager_ImportDevicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApplicationManager_serviceDesc.Streams[0], c.cc, "/manager.ApplicationManager/ImportDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationManagerImportDevicesClient{stream}
+	return x, nil
+}
+
+type ApplicationManager_ImportDevicesClient interface {
+	Send(*ImportDevicesRequest) error
+	CloseAndRecv() (*ImportDevicesResult, error)
+	grpc.ClientStream
+}
+
+type applicationManagerImportDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationManagerImportDevicesClient) Send(m *ImportDevicesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *applicationManagerImportDevicesClient) CloseAndRecv() (*ImportDevicesResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportDevicesResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationManagerClient) ExportDevices(ctx context.Context, in *ExportDevicesRequest, opts ...grpc.CallOption) (ApplicationManager_ExportDevicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApplicationManager_serviceDesc.Streams[1], c.cc, "/manager.ApplicationManager/ExportDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationManagerExportDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationManager_ExportDevicesClient interface {
+	Recv() (*ExportDevicesData, error)
+	grpc.ClientStream
+}
+
+type applicationManagerExportDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationManagerExportDevicesClient) Recv() (*ExportDevicesData, error) {
+	m := new(ExportDevicesData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationManagerClient) ListRevisions(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*RevisionList, error) {
+	out := new(RevisionList)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/ListRevisions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetRevision(ctx context.Context, in *RevisionIdentifier, opts ...grpc.CallOption) (*Revision, error) {
+	out := new(Revision)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetRevision", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) RollbackPayloadFunctions(ctx context.Context, in *RevisionIdentifier, opts ...grpc.CallOption) (*Revision, error) {
+	out := new(Revision)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/RollbackPayloadFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ListPortFunctions(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*PortFunctionsList, error) {
+	out := new(PortFunctionsList)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/ListPortFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetPortFunctions(ctx context.Context, in *PortFunctionsIdentifier, opts ...grpc.CallOption) (*PortFunctions, error) {
+	out := new(PortFunctions)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetPortFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetPortFunctions(ctx context.Context, in *SetPortFunctionsRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetPortFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeletePortFunctions(ctx context.Context, in *PortFunctionsIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeletePortFunctions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) ListFunctionSets(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*FunctionSetList, error) {
+	out := new(FunctionSetList)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/ListFunctionSets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetFunctionSet(ctx context.Context, in *FunctionSetIdentifier, opts ...grpc.CallOption) (*PortFunctions, error) {
+	out := new(PortFunctions)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetFunctionSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetFunctionSet(ctx context.Context, in *SetFunctionSetRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetFunctionSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteFunctionSet(ctx context.Context, in *FunctionSetIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeleteFunctionSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDevicePayloadFormat(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DevicePayloadFormat, error) {
+	out := new(DevicePayloadFormat)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetDevicePayloadFormat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetDevicePayloadFormat(ctx context.Context, in *DevicePayloadFormat, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetDevicePayloadFormat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetFragmentation(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Fragmentation, error) {
+	out := new(Fragmentation)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetFragmentation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetFragmentation(ctx context.Context, in *Fragmentation, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetFragmentation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteFragmentation(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeleteFragmentation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeviceClass(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceClass, error) {
+	out := new(DeviceClass)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetDeviceClass", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetDeviceClass(ctx context.Context, in *DeviceClass, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetDeviceClass", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetDeviceStatus(ctx context.Context, in *DeviceIdentifier, opts ...grpc.CallOption) (*DeviceStatus, error) {
+	out := new(DeviceStatus)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetDeviceStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetWebhooks(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*WebhookList, error) {
+	out := new(WebhookList)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetWebhooks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteWebhook(ctx context.Context, in *WebhookIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeleteWebhook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetHandlerStatus(ctx context.Context, in *HandlerStatusRequest, opts ...grpc.CallOption) (*HandlerStatus, error) {
+	out := new(HandlerStatus)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetHandlerStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetQuotas(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*Quotas, error) {
+	out := new(Quotas)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetQuotas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetQuotas(ctx context.Context, in *Quotas, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetQuotas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) DeleteQuotas(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/DeleteQuotas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) GetBinaryFormat(ctx context.Context, in *ApplicationIdentifier, opts ...grpc.CallOption) (*BinaryFormat, error) {
+	out := new(BinaryFormat)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/GetBinaryFormat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationManagerClient) SetBinaryFormat(ctx context.Context, in *BinaryFormat, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.ApplicationManager/SetBinaryFormat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApplicationManager service
+
+type ApplicationManagerServer interface {
+	// GetDownlinkQueue returns the current downlink and the queued downlinks of a device
+	GetDownlinkQueue(context.Context, *DeviceIdentifier) (*DownlinkQueue, error)
+	// DeleteQueuedDownlink removes a downlink from the queue of a device
+	DeleteQueuedDownlink(context.Context, *QueuedDownlinkIdentifier) (*google_protobuf.Empty, error)
+	// FlushDownlinkQueue removes the current downlink and all queued downlinks of a device
+	FlushDownlinkQueue(context.Context, *DeviceIdentifier) (*google_protobuf.Empty, error)
+	// GetHistory returns the recent uplink messages and events of a device
+	GetHistory(context.Context, *HistoryRequest) (*History, error)
+	// ListGroups returns the device groups of an application
+	ListGroups(context.Context, *ApplicationIdentifier) (*DeviceGroupList, error)
+	// GetGroup returns a device group with its current members
+	GetGroup(context.Context, *DeviceGroupIdentifier) (*DeviceGroup, error)
+	// SetGroup creates or updates a device group
+	SetGroup(context.Context, *DeviceGroup) (*google_protobuf.Empty, error)
+	// DeleteGroup deletes a device group
+	DeleteGroup(context.Context, *DeviceGroupIdentifier) (*google_protobuf.Empty, error)
+	// EnqueueGroupDownlink enqueues a downlink for all devices of a group
+	EnqueueGroupDownlink(context.Context, *GroupDownlinkRequest) (*GroupDownlinkReport, error)
+	// GetGroupDownlinkReport returns the state of a downlink that was sent to a device group
+	GetGroupDownlinkReport(context.Context, *GroupDownlinkIdentifier) (*GroupDownlinkReport, error)
+	// ImportDevices creates or updates the devices in the file that is streamed by the client
+	ImportDevices(ApplicationManager_ImportDevicesServer) error
+	// ExportDevices streams a file with all devices of an application
+	ExportDevices(*ExportDevicesRequest, ApplicationManager_ExportDevicesServer) error
+	// ListRevisions returns the revisions of the payload functions of an application
+	ListRevisions(context.Context, *ApplicationIdentifier) (*RevisionList, error)
+	// GetRevision returns a revision of the payload functions of an application
+	GetRevision(context.Context, *RevisionIdentifier) (*Revision, error)
+	// RollbackPayloadFunctions restores the payload functions of a revision, and returns the new revision that records this
+	RollbackPayloadFunctions(context.Context, *RevisionIdentifier) (*Revision, error)
+	// ListPortFunctions returns the payload functions of an application per FPort
+	ListPortFunctions(context.Context, *ApplicationIdentifier) (*PortFunctionsList, error)
+	// GetPortFunctions returns the payload functions of an application for an FPort
+	GetPortFunctions(context.Context, *PortFunctionsIdentifier) (*PortFunctions, error)
+	// SetPortFunctions sets the payload functions of an application for an FPort
+	SetPortFunctions(context.Context, *SetPortFunctionsRequest) (*google_protobuf.Empty, error)
+	// DeletePortFunctions removes the payload functions of an application for an FPort, so that the default functions are used
+	DeletePortFunctions(context.Context, *PortFunctionsIdentifier) (*google_protobuf.Empty, error)
+	// ListFunctionSets returns the function sets of an application
+	ListFunctionSets(context.Context, *ApplicationIdentifier) (*FunctionSetList, error)
+	// GetFunctionSet returns a function set of an application
+	GetFunctionSet(context.Context, *FunctionSetIdentifier) (*PortFunctions, error)
+	// SetFunctionSet creates or updates a function set of an application
+	SetFunctionSet(context.Context, *SetFunctionSetRequest) (*google_protobuf.Empty, error)
+	// DeleteFunctionSet removes a function set of an application
+	DeleteFunctionSet(context.Context, *FunctionSetIdentifier) (*google_protobuf.Empty, error)
+	// GetDevicePayloadFormat returns the payload format of a device
+	GetDevicePayloadFormat(context.Context, *DeviceIdentifier) (*DevicePayloadFormat, error)
+	// SetDevicePayloadFormat sets the payload format of a device; an empty payload format removes the override
+	SetDevicePayloadFormat(context.Context, *DevicePayloadFormat) (*google_protobuf.Empty, error)
+	// GetFragmentation returns the fragmentation settings of an application
+	GetFragmentation(context.Context, *ApplicationIdentifier) (*Fragmentation, error)
+	// SetFragmentation sets the fragmentation settings of an application
+	SetFragmentation(context.Context, *Fragmentation) (*google_protobuf.Empty, error)
+	// DeleteFragmentation disables fragment reassembly for an application
+	DeleteFragmentation(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
+	// GetDeviceClass returns the LoRaWAN class of a device
+	GetDeviceClass(context.Context, *DeviceIdentifier) (*DeviceClass, error)
+	// SetDeviceClass sets the LoRaWAN class of a device in the Handler and NetworkServer
+	SetDeviceClass(context.Context, *DeviceClass) (*google_protobuf.Empty, error)
+	// GetDeviceStatus returns the status that a device reported in response to a DevStatusReq
+	GetDeviceStatus(context.Context, *DeviceIdentifier) (*DeviceStatus, error)
+	// GetWebhooks returns the webhooks of an application
+	GetWebhooks(context.Context, *ApplicationIdentifier) (*WebhookList, error)
+	// SetWebhook adds a webhook to an application, or replaces the webhook with the same ID
+	SetWebhook(context.Context, *Webhook) (*google_protobuf.Empty, error)
+	// DeleteWebhook removes a webhook of an application
+	DeleteWebhook(context.Context, *WebhookIdentifier) (*google_protobuf.Empty, error)
+	// GetHandlerStatus returns the status of the integrations and payload functions of the Handler
+	GetHandlerStatus(context.Context, *HandlerStatusRequest) (*HandlerStatus, error)
+	// GetQuotas returns the quotas of an application and its current usage
+	GetQuotas(context.Context, *ApplicationIdentifier) (*Quotas, error)
+	// SetQuotas sets the quotas of an application
+	SetQuotas(context.Context, *Quotas) (*google_protobuf.Empty, error)
+	// DeleteQuotas removes the quotas of an application
+	DeleteQuotas(context.Context, *ApplicationIdentifier) (*google_protobuf.Empty, error)
+	// GetBinaryFormat returns the binary format of an application
+	GetBinaryFormat(context.Context, *ApplicationIdentifier) (*BinaryFormat, error)
+	// SetBinaryFormat sets the binary format of an application, which is used with the binary payload format
+	SetBinaryFormat(context.Context, *BinaryFormat) (*google_protobuf.Empty, error)
+}
+
+func RegisterApplicationManagerServer(s *grpc.Server, srv ApplicationManagerServer) {
+	s.RegisterService(&_ApplicationManager_serviceDesc, srv)
+}
+
+func _ApplicationManager_GetDownlinkQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDownlinkQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetDownlinkQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDownlinkQueue(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteQueuedDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedDownlinkIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteQueuedDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeleteQueuedDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteQueuedDownlink(ctx, req.(*QueuedDownlinkIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_FlushDownlinkQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).FlushDownlinkQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/FlushDownlinkQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).FlushDownlinkQueue(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ListGroups(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceGroupIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetGroup(ctx, req.(*DeviceGroupIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetGroup(ctx, req.(*DeviceGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceGroupIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteGroup(ctx, req.(*DeviceGroupIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_EnqueueGroupDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).EnqueueGroupDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/EnqueueGroupDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).EnqueueGroupDownlink(ctx, req.(*GroupDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetGroupDownlinkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupDownlinkIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetGroupDownlinkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetGroupDownlinkReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetGroupDownlinkReport(ctx, req.(*GroupDownlinkIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ImportDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApplicationManagerServer).ImportDevices(&applicationManagerImportDevicesServer{stream})
+}
+
+type ApplicationManager_ImportDevicesServer interface {
+	SendAndClose(*ImportDevicesResult) error
+	Recv() (*ImportDevicesRequest, error)
+	grpc.ServerStream
+}
+
+type applicationManagerImportDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationManagerImportDevicesServer) SendAndClose(m *ImportDevicesResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *applicationManagerImportDevicesServer) Recv() (*ImportDevicesRequest, error) {
+	m := new(ImportDevicesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApplicationManager_ExportDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationManagerServer).ExportDevices(m, &applicationManagerExportDevicesServer{stream})
+}
+
+type ApplicationManager_ExportDevicesServer interface {
+	Send(*ExportDevicesData) error
+	grpc.ServerStream
+}
+
+type applicationManagerExportDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationManagerExportDevicesServer) Send(m *ExportDevicesData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationManager_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ListRevisions(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetRevision(ctx, req.(*RevisionIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_RollbackPayloadFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).RollbackPayloadFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/RollbackPayloadFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).RollbackPayloadFunctions(ctx, req.(*RevisionIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ListPortFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ListPortFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/ListPortFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ListPortFunctions(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetPortFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortFunctionsIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetPortFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetPortFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetPortFunctions(ctx, req.(*PortFunctionsIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetPortFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPortFunctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetPortFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetPortFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetPortFunctions(ctx, req.(*SetPortFunctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeletePortFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortFunctionsIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeletePortFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeletePortFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeletePortFunctions(ctx, req.(*PortFunctionsIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_ListFunctionSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).ListFunctionSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/ListFunctionSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).ListFunctionSets(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetFunctionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FunctionSetIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetFunctionSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetFunctionSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetFunctionSet(ctx, req.(*FunctionSetIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetFunctionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFunctionSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetFunctionSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetFunctionSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetFunctionSet(ctx, req.(*SetFunctionSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteFunctionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FunctionSetIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteFunctionSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeleteFunctionSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteFunctionSet(ctx, req.(*FunctionSetIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDevicePayloadFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDevicePayloadFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetDevicePayloadFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDevicePayloadFormat(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetDevicePayloadFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DevicePayloadFormat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetDevicePayloadFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetDevicePayloadFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetDevicePayloadFormat(ctx, req.(*DevicePayloadFormat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetFragmentation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetFragmentation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetFragmentation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetFragmentation(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetFragmentation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Fragmentation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetFragmentation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetFragmentation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetFragmentation(ctx, req.(*Fragmentation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteFragmentation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteFragmentation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeleteFragmentation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteFragmentation(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeviceClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetDeviceClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceClass(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetDeviceClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetDeviceClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetDeviceClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetDeviceClass(ctx, req.(*DeviceClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetDeviceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetDeviceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetDeviceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetDeviceStatus(ctx, req.(*DeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetWebhooks(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeleteWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteWebhook(ctx, req.(*WebhookIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetHandlerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetHandlerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetHandlerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetHandlerStatus(ctx, req.(*HandlerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetQuotas(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Quotas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetQuotas(ctx, req.(*Quotas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_DeleteQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).DeleteQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/DeleteQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).DeleteQuotas(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_GetBinaryFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).GetBinaryFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/GetBinaryFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).GetBinaryFormat(ctx, req.(*ApplicationIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationManager_SetBinaryFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryFormat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationManagerServer).SetBinaryFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.ApplicationManager/SetBinaryFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationManagerServer).SetBinaryFormat(ctx, req.(*BinaryFormat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "manager.ApplicationManager",
+	HandlerType: (*ApplicationManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDownlinkQueue",
+			Handler:    _ApplicationManager_GetDownlinkQueue_Handler,
+		},
+		{
+			MethodName: "DeleteQueuedDownlink",
+			Handler:    _ApplicationManager_DeleteQueuedDownlink_Handler,
+		},
+		{
+			MethodName: "FlushDownlinkQueue",
+			Handler:    _ApplicationManager_FlushDownlinkQueue_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _ApplicationManager_GetHistory_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _ApplicationManager_ListGroups_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _ApplicationManager_GetGroup_Handler,
+		},
+		{
+			MethodName: "SetGroup",
+			Handler:    _ApplicationManager_SetGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _ApplicationManager_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "EnqueueGroupDownlink",
+			Handler:    _ApplicationManager_EnqueueGroupDownlink_Handler,
+		},
+		{
+			MethodName: "GetGroupDownlinkReport",
+			Handler:    _ApplicationManager_GetGroupDownlinkReport_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _ApplicationManager_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _ApplicationManager_GetRevision_Handler,
+		},
+		{
+			MethodName: "RollbackPayloadFunctions",
+			Handler:    _ApplicationManager_RollbackPayloadFunctions_Handler,
+		},
+		{
+			MethodName: "ListPortFunctions",
+			Handler:    _ApplicationManager_ListPortFunctions_Handler,
+		},
+		{
+			MethodName: "GetPortFunctions",
+			Handler:    _ApplicationManager_GetPortFunctions_Handler,
+		},
+		{
+			MethodName: "SetPortFunctions",
+			Handler:    _ApplicationManager_SetPortFunctions_Handler,
+		},
+		{
+			MethodName: "DeletePortFunctions",
+			Handler:    _ApplicationManager_DeletePortFunctions_Handler,
+		},
+		{
+			MethodName: "ListFunctionSets",
+			Handler:    _ApplicationManager_ListFunctionSets_Handler,
+		},
+		{
+			MethodName: "GetFunctionSet",
+			Handler:    _ApplicationManager_GetFunctionSet_Handler,
+		},
+		{
+			MethodName: "SetFunctionSet",
+			Handler:    _ApplicationManager_SetFunctionSet_Handler,
+		},
+		{
+			MethodName: "DeleteFunctionSet",
+			Handler:    _ApplicationManager_DeleteFunctionSet_Handler,
+		},
+		{
+			MethodName: "GetDevicePayloadFormat",
+			Handler:    _ApplicationManager_GetDevicePayloadFormat_Handler,
+		},
+		{
+			MethodName: "SetDevicePayloadFormat",
+			Handler:    _ApplicationManager_SetDevicePayloadFormat_Handler,
+		},
+		{
+			MethodName: "GetFragmentation",
+			Handler:    _ApplicationManager_GetFragmentation_Handler,
+		},
+		{
+			MethodName: "SetFragmentation",
+			Handler:    _ApplicationManager_SetFragmentation_Handler,
+		},
+		{
+			MethodName: "DeleteFragmentation",
+			Handler:    _ApplicationManager_DeleteFragmentation_Handler,
+		},
+		{
+			MethodName: "GetDeviceClass",
+			Handler:    _ApplicationManager_GetDeviceClass_Handler,
+		},
+		{
+			MethodName: "SetDeviceClass",
+			Handler:    _ApplicationManager_SetDeviceClass_Handler,
+		},
+		{
+			MethodName: "GetDeviceStatus",
+			Handler:    _ApplicationManager_GetDeviceStatus_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _ApplicationManager_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "SetWebhook",
+			Handler:    _ApplicationManager_SetWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _ApplicationManager_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetHandlerStatus",
+			Handler:    _ApplicationManager_GetHandlerStatus_Handler,
+		},
+		{
+			MethodName: "GetQuotas",
+			Handler:    _ApplicationManager_GetQuotas_Handler,
+		},
+		{
+			MethodName: "SetQuotas",
+			Handler:    _ApplicationManager_SetQuotas_Handler,
+		},
+		{
+			MethodName: "DeleteQuotas",
+			Handler:    _ApplicationManager_DeleteQuotas_Handler,
+		},
+		{
+			MethodName: "GetBinaryFormat",
+			Handler:    _ApplicationManager_GetBinaryFormat_Handler,
+		},
+		{
+			MethodName: "SetBinaryFormat",
+			Handler:    _ApplicationManager_SetBinaryFormat_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportDevices",
+			Handler:       _ApplicationManager_ImportDevices_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportDevices",
+			Handler:       _ApplicationManager_ExportDevices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/manager/manager.proto",
+}
+
+// Client API for NetworkServerManager service
+
+type NetworkServerManagerClient interface {
+	// GetDeviceClass returns the LoRaWAN class of a device
+	GetDeviceClass(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceClass, error)
+	// SetDeviceClass sets the LoRaWAN class of a device, which determines how downlink without uplink is sent
+	SetDeviceClass(ctx context.Context, in *NetworkServerDeviceClass, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetRXSettings returns the current and desired receive window settings of a device
+	GetRXSettings(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceRXSettings, error)
+	// SetRXSettings sets the desired receive window settings of a device, or stops changing them if empty
+	SetRXSettings(ctx context.Context, in *NetworkServerDeviceRXSettings, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetChannels returns the band, override and confirmed channels of a device
+	GetChannels(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceChannels, error)
+	// SetChannels sets the channels that override the extra channels of the band of a device, or resets the device
+	// to the channels of the band if empty
+	SetChannels(ctx context.Context, in *NetworkServerDeviceChannels, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// GetDevStatus returns the status interval and the last status of a device
+	GetDevStatus(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceStatus, error)
+	// SetDevStatus sets the status interval of a device
+	SetDevStatus(ctx context.Context, in *NetworkServerDeviceStatus, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type networkServerManagerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNetworkServerManagerClient(cc *grpc.ClientConn) NetworkServerManagerClient {
+	return &networkServerManagerClient{cc}
+}
+
+func (c *networkServerManagerClient) GetDeviceClass(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceClass, error) {
+	out := new(NetworkServerDeviceClass)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/GetDeviceClass", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) SetDeviceClass(ctx context.Context, in *NetworkServerDeviceClass, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/SetDeviceClass", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) GetRXSettings(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceRXSettings, error) {
+	out := new(NetworkServerDeviceRXSettings)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/GetRXSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) SetRXSettings(ctx context.Context, in *NetworkServerDeviceRXSettings, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/SetRXSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) GetChannels(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceChannels, error) {
+	out := new(NetworkServerDeviceChannels)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/GetChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) SetChannels(ctx context.Context, in *NetworkServerDeviceChannels, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/SetChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) GetDevStatus(ctx context.Context, in *NetworkServerDeviceIdentifier, opts ...grpc.CallOption) (*NetworkServerDeviceStatus, error) {
+	out := new(NetworkServerDeviceStatus)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/GetDevStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServerManagerClient) SetDevStatus(ctx context.Context, in *NetworkServerDeviceStatus, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/manager.NetworkServerManager/SetDevStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for NetworkServerManager service
+
+type NetworkServerManagerServer interface {
+	// GetDeviceClass returns the LoRaWAN class of a device
+	GetDeviceClass(context.Context, *NetworkServerDeviceIdentifier) (*NetworkServerDeviceClass, error)
+	// SetDeviceClass sets the LoRaWAN class of a device, which determines how downlink without uplink is sent
+	SetDeviceClass(context.Context, *NetworkServerDeviceClass) (*google_protobuf.Empty, error)
+	// GetRXSettings returns the current and desired receive window settings of a device
+	GetRXSettings(context.Context, *NetworkServerDeviceIdentifier) (*NetworkServerDeviceRXSettings, error)
+	// SetRXSettings sets the desired receive window settings of a device, or stops changing them if empty
+	SetRXSettings(context.Context, *NetworkServerDeviceRXSettings) (*google_protobuf.Empty, error)
+	// GetChannels returns the band, override and confirmed channels of a device
+	GetChannels(context.Context, *NetworkServerDeviceIdentifier) (*NetworkServerDeviceChannels, error)
+	// SetChannels sets the channels that override the extra channels of the band of a device, or resets the device
+	// to the channels of the band if empty
+	SetChannels(context.Context, *NetworkServerDeviceChannels) (*google_protobuf.Empty, error)
+	// GetDevStatus returns the status interval and the last status of a device
+	GetDevStatus(context.Context, *NetworkServerDeviceIdentifier) (*NetworkServerDeviceStatus, error)
+	// SetDevStatus sets the status interval of a device
+	SetDevStatus(context.Context, *NetworkServerDeviceStatus) (*google_protobuf.Empty, error)
+}
+
+func RegisterNetworkServerManagerServer(s *grpc.Server, srv NetworkServerManagerServer) {
+	s.RegisterService(&_NetworkServerManager_serviceDesc, srv)
+}
+
+func _NetworkServerManager_GetDeviceClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).GetDeviceClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/GetDeviceClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).GetDeviceClass(ctx, req.(*NetworkServerDeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_SetDeviceClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).SetDeviceClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/SetDeviceClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).SetDeviceClass(ctx, req.(*NetworkServerDeviceClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_GetRXSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).GetRXSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/GetRXSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).GetRXSettings(ctx, req.(*NetworkServerDeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_SetRXSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceRXSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).SetRXSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/SetRXSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).SetRXSettings(ctx, req.(*NetworkServerDeviceRXSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).GetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/GetChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).GetChannels(ctx, req.(*NetworkServerDeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_SetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceChannels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).SetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/SetChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).SetChannels(ctx, req.(*NetworkServerDeviceChannels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_GetDevStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).GetDevStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/GetDevStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).GetDevStatus(ctx, req.(*NetworkServerDeviceIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkServerManager_SetDevStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkServerDeviceStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServerManagerServer).SetDevStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.NetworkServerManager/SetDevStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServerManagerServer).SetDevStatus(ctx, req.(*NetworkServerDeviceStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NetworkServerManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "manager.NetworkServerManager",
+	HandlerType: (*NetworkServerManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeviceClass",
+			Handler:    _NetworkServerManager_GetDeviceClass_Handler,
+		},
+		{
+			MethodName: "SetDeviceClass",
+			Handler:    _NetworkServerManager_SetDeviceClass_Handler,
+		},
+		{
+			MethodName: "GetRXSettings",
+			Handler:    _NetworkServerManager_GetRXSettings_Handler,
+		},
+		{
+			MethodName: "SetRXSettings",
+			Handler:    _NetworkServerManager_SetRXSettings_Handler,
+		},
+		{
+			MethodName: "GetChannels",
+			Handler:    _NetworkServerManager_GetChannels_Handler,
+		},
+		{
+			MethodName: "SetChannels",
+			Handler:    _NetworkServerManager_SetChannels_Handler,
+		},
+		{
+			MethodName: "GetDevStatus",
+			Handler:    _NetworkServerManager_GetDevStatus_Handler,
+		},
+		{
+			MethodName: "SetDevStatus",
+			Handler:    _NetworkServerManager_SetDevStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/TheThingsNetwork/ttn/api/manager/manager.proto",
+}
+
+func (m *ApplicationIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	return i, nil
+}
+
+func (m *DeviceIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	return i, nil
+}
+
+func (m *DownlinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.FPort != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.FPort))
+	}
+	if m.Confirmed {
+		dAtA[i] = 0x18
+		i++
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Schedule) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Schedule)))
+		i += copy(dAtA[i:], m.Schedule)
+	}
+	if len(m.PayloadRaw) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.PayloadRaw)))
+		i += copy(dAtA[i:], m.PayloadRaw)
+	}
+	if len(m.PayloadFields) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.PayloadFields)))
+		i += copy(dAtA[i:], m.PayloadFields)
+	}
+	if len(m.TTL) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.TTL)))
+		i += copy(dAtA[i:], m.TTL)
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.ExpiresAt))
+	}
+	if m.MaxRetries != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.MaxRetries.Size()))
+		n1, err := m.MaxRetries.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.GroupDownlinkID) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.GroupDownlinkID)))
+		i += copy(dAtA[i:], m.GroupDownlinkID)
+	}
+	return i, nil
+}
+
+func (m *DownlinkQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkQueue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Current != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Current.Size()))
+		n2, err := m.Current.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Attempts != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Attempts))
+	}
+	if len(m.Queue) > 0 {
+		for _, msg := range m.Queue {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueuedDownlinkIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedDownlinkIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	if m.Since != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Since))
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Until))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *HistoryUplink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryUplink) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FPort != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.FPort))
+	}
+	if m.FCnt != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.FCnt))
+	}
+	if m.Confirmed {
+		dAtA[i] = 0x18
+		i++
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.IsRetry {
+		dAtA[i] = 0x20
+		i++
+		if m.IsRetry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PayloadRaw) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.PayloadRaw)))
+		i += copy(dAtA[i:], m.PayloadRaw)
+	}
+	if len(m.PayloadFields) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.PayloadFields)))
+		i += copy(dAtA[i:], m.PayloadFields)
+	}
+	if len(m.Metadata) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Metadata)))
+		i += copy(dAtA[i:], m.Metadata)
+	}
+	return i, nil
+}
+
+func (m *HistoryEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Event) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Event)))
+		i += copy(dAtA[i:], m.Event)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *HistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Time))
+	}
+	if m.Uplink != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Uplink.Size()))
+		n3, err := m.Uplink.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Event != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Event.Size()))
+		n4, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *History) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *History) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Selector) > 0 {
+		for k, _ := range m.Selector {
+			dAtA[i] = 0x22
+			i++
+			v := m.Selector[k]
+			mapSize := 1 + len(k) + sovManager(uint64(len(k))) + 1 + len(v) + sovManager(uint64(len(v)))
+			i = encodeVarintManager(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceGroupList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceGroupList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, msg := range m.Groups {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeviceGroupIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceGroupIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.GroupID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.GroupID)))
+		i += copy(dAtA[i:], m.GroupID)
+	}
+	return i, nil
+}
+
+func (m *GroupDownlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupDownlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.GroupID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.GroupID)))
+		i += copy(dAtA[i:], m.GroupID)
+	}
+	if m.Message != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Message.Size()))
+		n5, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *GroupDownlinkIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupDownlinkIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.GroupID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.GroupID)))
+		i += copy(dAtA[i:], m.GroupID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *GroupDownlinkReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupDownlinkReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.GroupID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.GroupID)))
+		i += copy(dAtA[i:], m.GroupID)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.Devices != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Devices))
+	}
+	if m.Scheduled != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Scheduled))
+	}
+	if m.Failed != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Failed))
+	}
+	if m.Sent != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Sent))
+	}
+	if m.Acked != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Acked))
+	}
+	return i, nil
+}
+
+func (m *ImportDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *ImportDevicesError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportDevicesError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Row != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Row))
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ImportDevicesResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportDevicesResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		dAtA[i] = 0x8
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Rows != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Rows))
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Updated))
+	}
+	if m.Failed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Failed))
+	}
+	if len(m.Errors) > 0 {
+		for _, msg := range m.Errors {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExportDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	return i, nil
+}
+
+func (m *ExportDevicesData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDevicesData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *PortFunctions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortFunctions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	return i, nil
+}
+
+func (m *PayloadFunctions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFunctions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayloadFormat) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.PayloadFormat)))
+		i += copy(dAtA[i:], m.PayloadFormat)
+	}
+	if len(m.Decoder) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Decoder)))
+		i += copy(dAtA[i:], m.Decoder)
+	}
+	if len(m.Converter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Converter)))
+		i += copy(dAtA[i:], m.Converter)
+	}
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Encoder) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Encoder)))
+		i += copy(dAtA[i:], m.Encoder)
+	}
+	if len(m.BinaryFormat) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.BinaryFormat)))
+		i += copy(dAtA[i:], m.BinaryFormat)
+	}
+	if len(m.Ports) > 0 {
+		for k, _ := range m.Ports {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Ports[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovManager(uint64(msgSize))
+			}
+			mapSize := 1 + sovManager(uint64(k)) + msgSize
+			i = encodeVarintManager(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(k))
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintManager(dAtA, i, uint64(v.Size()))
+				n6, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n6
+			}
+		}
+	}
+	if len(m.Sets) > 0 {
+		for k, _ := range m.Sets {
+			dAtA[i] = 0x42
+			i++
+			v := m.Sets[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovManager(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovManager(uint64(len(k))) + msgSize
+			i = encodeVarintManager(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintManager(dAtA, i, uint64(v.Size()))
+				n7, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n7
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *Revision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Revision) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Number))
+	}
+	if len(m.Author) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Author)))
+		i += copy(dAtA[i:], m.Author)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.RollbackOf != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.RollbackOf))
+	}
+	if m.Functions != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Functions.Size()))
+		n8, err := m.Functions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.Diff) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Diff)))
+		i += copy(dAtA[i:], m.Diff)
+	}
+	return i, nil
+}
+
+func (m *RevisionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, msg := range m.Revisions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RevisionIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if m.Number != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Number))
+	}
+	return i, nil
+}
+
+func (m *PortFunctionsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortFunctionsList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for k, _ := range m.Ports {
+			dAtA[i] = 0xa
+			i++
+			v := m.Ports[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovManager(uint64(msgSize))
+			}
+			mapSize := 1 + sovManager(uint64(k)) + msgSize
+			i = encodeVarintManager(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(k))
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintManager(dAtA, i, uint64(v.Size()))
+				n9, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n9
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *PortFunctionsIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortFunctionsIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if m.FPort != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.FPort))
+	}
+	return i, nil
+}
+
+func (m *SetPortFunctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPortFunctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if m.FPort != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.FPort))
+	}
+	if m.Functions != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Functions.Size()))
+		n10, err := m.Functions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *FunctionSetList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunctionSetList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sets) > 0 {
+		for k, _ := range m.Sets {
+			dAtA[i] = 0xa
+			i++
+			v := m.Sets[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovManager(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovManager(uint64(len(k))) + msgSize
+			i = encodeVarintManager(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintManager(dAtA, i, uint64(v.Size()))
+				n11, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n11
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *FunctionSetIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunctionSetIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *SetFunctionSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFunctionSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Functions != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Functions.Size()))
+		n12, err := m.Functions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+
+func (m *DevicePayloadFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevicePayloadFormat) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	if len(m.PayloadFormat) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.PayloadFormat)))
+		i += copy(dAtA[i:], m.PayloadFormat)
+	}
+	if len(m.FunctionSet) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.FunctionSet)))
+		i += copy(dAtA[i:], m.FunctionSet)
+	}
+	return i, nil
+}
+
+func (m *Fragmentation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fragmentation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if m.Port != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Port))
+	}
+	if len(m.Header) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Header)))
+		i += copy(dAtA[i:], m.Header)
+	}
+	if m.Fragments != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Fragments))
+	}
+	if len(m.Timeout) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Timeout)))
+		i += copy(dAtA[i:], m.Timeout)
+	}
+	return i, nil
+}
+
+func (m *DeviceClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceClass) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	if len(m.Class) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Class)))
+		i += copy(dAtA[i:], m.Class)
+	}
+	return i, nil
+}
+
+func (m *DeviceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.DevID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevID)))
+		i += copy(dAtA[i:], m.DevID)
+	}
+	if m.Battery != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Margin))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.UplinkURL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.UplinkURL)))
+		i += copy(dAtA[i:], m.UplinkURL)
+	}
+	if len(m.EventURL) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.EventURL)))
+		i += copy(dAtA[i:], m.EventURL)
+	}
+	if len(m.Headers) > 0 {
+		for k, _ := range m.Headers {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovManager(uint64(len(k))) + 1 + len(v) + sovManager(uint64(len(v)))
+			i = encodeVarintManager(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *WebhookList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WebhookIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *HandlerStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *IntegrationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegrationStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Healthy {
+		dAtA[i] = 0x10
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Spool != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Spool))
+	}
+	return i, nil
+}
+
+func (m *FunctionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunctionStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.Direction) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Direction)))
+		i += copy(dAtA[i:], m.Direction)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Count))
+	}
+	if m.Mean != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Manager(dAtA, i, uint64(math.Float64bits(float64(m.Mean))))
+	}
+	if m.P50 != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Manager(dAtA, i, uint64(math.Float64bits(float64(m.P50))))
+	}
+	if m.P95 != 0 {
+		dAtA[i] = 0x31
+		i++
+		i = encodeFixed64Manager(dAtA, i, uint64(math.Float64bits(float64(m.P95))))
+	}
+	if m.P99 != 0 {
+		dAtA[i] = 0x39
+		i++
+		i = encodeFixed64Manager(dAtA, i, uint64(math.Float64bits(float64(m.P99))))
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x41
+		i++
+		i = encodeFixed64Manager(dAtA, i, uint64(math.Float64bits(float64(m.Max))))
+	}
+	return i, nil
+}
+
+func (m *HandlerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Integrations) > 0 {
+		for _, msg := range m.Integrations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PayloadFunctions) > 0 {
+		for _, msg := range m.PayloadFunctions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QuotaLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaLimits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.UplinksPerHour != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.UplinksPerHour))
+	}
+	if m.DownlinksPerDay != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.DownlinksPerDay))
+	}
+	if m.DownlinkAirtimePerDay != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.DownlinkAirtimePerDay))
+	}
+	return i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.UplinksPerHour != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.UplinksPerHour))
+	}
+	if m.DownlinksPerDay != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.DownlinksPerDay))
+	}
+	if m.DownlinkAirtimePerDay != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.DownlinkAirtimePerDay))
+	}
+	return i, nil
+}
+
+func (m *Quotas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quotas) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if m.Application != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Application.Size()))
+		n13, err := m.Application.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Device != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Device.Size()))
+		n14, err := m.Device.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Usage != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Usage.Size()))
+		n15, err := m.Usage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *BinaryFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinaryFormat) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppID)))
+		i += copy(dAtA[i:], m.AppID)
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	return i, nil
+}
+
+func (m *NetworkServerDeviceIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkServerDeviceIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppEUI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppEUI)))
+		i += copy(dAtA[i:], m.AppEUI)
+	}
+	if len(m.DevEUI) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevEUI)))
+		i += copy(dAtA[i:], m.DevEUI)
+	}
+	return i, nil
+}
+
+func (m *NetworkServerDeviceClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkServerDeviceClass) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppEUI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppEUI)))
+		i += copy(dAtA[i:], m.AppEUI)
+	}
+	if len(m.DevEUI) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevEUI)))
+		i += copy(dAtA[i:], m.DevEUI)
+	}
+	if len(m.Class) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Class)))
+		i += copy(dAtA[i:], m.Class)
+	}
+	return i, nil
+}
+
+func (m *RXSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RXSettings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RX1DROffset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.RX1DROffset))
+	}
+	if m.RX2DataRate != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.RX2DataRate))
+	}
+	if m.RX2Frequency != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.RX2Frequency))
+	}
+	if m.RX1Delay != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.RX1Delay))
+	}
+	return i, nil
+}
+
+func (m *NetworkServerDeviceRXSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkServerDeviceRXSettings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppEUI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppEUI)))
+		i += copy(dAtA[i:], m.AppEUI)
+	}
+	if len(m.DevEUI) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevEUI)))
+		i += copy(dAtA[i:], m.DevEUI)
+	}
+	if m.Current != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Current.Size()))
+		n16, err := m.Current.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Desired != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Desired.Size()))
+		n17, err := m.Desired.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func (m *Channel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Index))
+	}
+	if m.Frequency != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Frequency))
+	}
+	if m.DownlinkFrequency != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.DownlinkFrequency))
+	}
+	if m.MinDataRate != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.MinDataRate))
+	}
+	if m.MaxDataRate != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.MaxDataRate))
+	}
+	return i, nil
+}
+
+func (m *NetworkServerDeviceChannels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkServerDeviceChannels) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppEUI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppEUI)))
+		i += copy(dAtA[i:], m.AppEUI)
+	}
+	if len(m.DevEUI) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevEUI)))
+		i += copy(dAtA[i:], m.DevEUI)
+	}
+	if len(m.Band) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Band)))
+		i += copy(dAtA[i:], m.Band)
+	}
+	if len(m.Override) > 0 {
+		for _, msg := range m.Override {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Confirmed) > 0 {
+		for _, msg := range m.Confirmed {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintManager(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NetworkServerDeviceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkServerDeviceStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppEUI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.AppEUI)))
+		i += copy(dAtA[i:], m.AppEUI)
+	}
+	if len(m.DevEUI) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.DevEUI)))
+		i += copy(dAtA[i:], m.DevEUI)
+	}
+	if len(m.Interval) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(len(m.Interval)))
+		i += copy(dAtA[i:], m.Interval)
+	}
+	if m.Battery != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.Margin))
+	}
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintManager(dAtA, i, uint64(m.UpdatedAt))
+	}
+	return i, nil
+}
+
+func encodeFixed64Manager(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Manager(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintManager(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ApplicationIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *DownlinkMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovManager(uint64(m.FPort))
+	}
+	if m.Confirmed {
+		n += 2
+	}
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.PayloadRaw)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.PayloadFields)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.TTL)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovManager(uint64(m.ExpiresAt))
+	}
+	if m.MaxRetries != nil {
+		l = m.MaxRetries.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.GroupDownlinkID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *DownlinkQueue) Size() (n int) {
+	var l int
+	_ = l
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovManager(uint64(m.Attempts))
+	}
+	if len(m.Queue) > 0 {
+		for _, e := range m.Queue {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedDownlinkIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovManager(uint64(m.Since))
+	}
+	if m.Until != 0 {
+		n += 1 + sovManager(uint64(m.Until))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovManager(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *HistoryUplink) Size() (n int) {
+	var l int
+	_ = l
+	if m.FPort != 0 {
+		n += 1 + sovManager(uint64(m.FPort))
+	}
+	if m.FCnt != 0 {
+		n += 1 + sovManager(uint64(m.FCnt))
+	}
+	if m.Confirmed {
+		n += 2
+	}
+	if m.IsRetry {
+		n += 2
+	}
+	l = len(m.PayloadRaw)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.PayloadFields)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovManager(uint64(m.Time))
+	}
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *History) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	if len(m.Selector) > 0 {
+		for k, v := range m.Selector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovManager(uint64(len(k))) + 1 + len(v) + sovManager(uint64(len(v)))
+			n += mapEntrySize + 1 + sovManager(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceGroupList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceGroupIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupDownlinkRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupDownlinkIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupDownlinkReport) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovManager(uint64(m.CreatedAt))
+	}
+	if m.Devices != 0 {
+		n += 1 + sovManager(uint64(m.Devices))
+	}
+	if m.Scheduled != 0 {
+		n += 1 + sovManager(uint64(m.Scheduled))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovManager(uint64(m.Failed))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovManager(uint64(m.Sent))
+	}
+	if m.Acked != 0 {
+		n += 1 + sovManager(uint64(m.Acked))
+	}
+	return n
+}
+
+func (m *ImportDevicesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportDevicesError) Size() (n int) {
+	var l int
+	_ = l
+	if m.Row != 0 {
+		n += 1 + sovManager(uint64(m.Row))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportDevicesResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.Rows != 0 {
+		n += 1 + sovManager(uint64(m.Rows))
+	}
+	if m.Created != 0 {
+		n += 1 + sovManager(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovManager(uint64(m.Updated))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovManager(uint64(m.Failed))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportDevicesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportDevicesData) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *PortFunctions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadFunctions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PayloadFormat)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Decoder)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Encoder)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.BinaryFormat)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if len(m.Ports) > 0 {
+		for k, v := range m.Ports {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovManager(uint64(l))
+			}
+			mapEntrySize := 1 + sovManager(uint64(k)) + l
+			n += mapEntrySize + 1 + sovManager(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Sets) > 0 {
+		for k, v := range m.Sets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovManager(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovManager(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovManager(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Revision) Size() (n int) {
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovManager(uint64(m.Number))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovManager(uint64(m.CreatedAt))
+	}
+	if m.RollbackOf != 0 {
+		n += 1 + sovManager(uint64(m.RollbackOf))
+	}
+	if m.Functions != nil {
+		l = m.Functions.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Diff)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *RevisionList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevisionIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovManager(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *PortFunctionsList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for k, v := range m.Ports {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovManager(uint64(l))
+			}
+			mapEntrySize := 1 + sovManager(uint64(k)) + l
+			n += mapEntrySize + 1 + sovManager(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PortFunctionsIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovManager(uint64(m.FPort))
+	}
+	return n
+}
+
+func (m *SetPortFunctionsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.FPort != 0 {
+		n += 1 + sovManager(uint64(m.FPort))
+	}
+	if m.Functions != nil {
+		l = m.Functions.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *FunctionSetList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Sets) > 0 {
+		for k, v := range m.Sets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovManager(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovManager(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovManager(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *FunctionSetIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *SetFunctionSetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Functions != nil {
+		l = m.Functions.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *DevicePayloadFormat) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.PayloadFormat)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.FunctionSet)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *Fragmentation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovManager(uint64(m.Port))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Fragments != 0 {
+		n += 1 + sovManager(uint64(m.Fragments))
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceClass) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Class)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *DeviceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Battery != 0 {
+		n += 1 + sovManager(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovManager(uint64(m.Margin))
+	}
+	if m.Time != 0 {
+		n += 1 + sovManager(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *Webhook) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.UplinkURL)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.EventURL)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovManager(uint64(len(k))) + 1 + len(v) + sovManager(uint64(len(v)))
+			n += mapEntrySize + 1 + sovManager(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *WebhookList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebhookIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *HandlerStatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *IntegrationStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.Spool != 0 {
+		n += 1 + sovManager(uint64(m.Spool))
+	}
+	return n
+}
+
+func (m *FunctionStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovManager(uint64(m.Count))
+	}
+	if m.Mean != 0 {
+		n += 9
+	}
+	if m.P50 != 0 {
+		n += 9
+	}
+	if m.P95 != 0 {
+		n += 9
+	}
+	if m.P99 != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *HandlerStatus) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Integrations) > 0 {
+		for _, e := range m.Integrations {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	if len(m.PayloadFunctions) > 0 {
+		for _, e := range m.PayloadFunctions {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaLimits) Size() (n int) {
+	var l int
+	_ = l
+	if m.UplinksPerHour != 0 {
+		n += 1 + sovManager(uint64(m.UplinksPerHour))
+	}
+	if m.DownlinksPerDay != 0 {
+		n += 1 + sovManager(uint64(m.DownlinksPerDay))
+	}
+	if m.DownlinkAirtimePerDay != 0 {
+		n += 1 + sovManager(uint64(m.DownlinkAirtimePerDay))
+	}
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.UplinksPerHour != 0 {
+		n += 1 + sovManager(uint64(m.UplinksPerHour))
+	}
+	if m.DownlinksPerDay != 0 {
+		n += 1 + sovManager(uint64(m.DownlinksPerDay))
+	}
+	if m.DownlinkAirtimePerDay != 0 {
+		n += 1 + sovManager(uint64(m.DownlinkAirtimePerDay))
+	}
+	return n
+}
+
+func (m *Quotas) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Application != nil {
+		l = m.Application.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Device != nil {
+		l = m.Device.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *BinaryFormat) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppID)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkServerDeviceIdentifier) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkServerDeviceClass) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Class)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *RXSettings) Size() (n int) {
+	var l int
+	_ = l
+	if m.RX1DROffset != 0 {
+		n += 1 + sovManager(uint64(m.RX1DROffset))
+	}
+	if m.RX2DataRate != 0 {
+		n += 1 + sovManager(uint64(m.RX2DataRate))
+	}
+	if m.RX2Frequency != 0 {
+		n += 1 + sovManager(uint64(m.RX2Frequency))
+	}
+	if m.RX1Delay != 0 {
+		n += 1 + sovManager(uint64(m.RX1Delay))
+	}
+	return n
+}
+
+func (m *NetworkServerDeviceRXSettings) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Desired != nil {
+		l = m.Desired.Size()
+		n += 1 + l + sovManager(uint64(l))
+	}
+	return n
+}
+
+func (m *Channel) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovManager(uint64(m.Index))
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovManager(uint64(m.Frequency))
+	}
+	if m.DownlinkFrequency != 0 {
+		n += 1 + sovManager(uint64(m.DownlinkFrequency))
+	}
+	if m.MinDataRate != 0 {
+		n += 1 + sovManager(uint64(m.MinDataRate))
+	}
+	if m.MaxDataRate != 0 {
+		n += 1 + sovManager(uint64(m.MaxDataRate))
+	}
+	return n
+}
+
+func (m *NetworkServerDeviceChannels) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Band)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if len(m.Override) > 0 {
+		for _, e := range m.Override {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	if len(m.Confirmed) > 0 {
+		for _, e := range m.Confirmed {
+			l = e.Size()
+			n += 1 + l + sovManager(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkServerDeviceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.AppEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.DevEUI)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovManager(uint64(l))
+	}
+	if m.Battery != 0 {
+		n += 1 + sovManager(uint64(m.Battery))
+	}
+	if m.Margin != 0 {
+		n += 1 + sovManager(uint64(m.Margin))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovManager(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovManager(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozManager(x uint64) (n int) {
+	return sovManager(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ApplicationIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkMessage{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`Confirmed:` + fmt.Sprintf("%v", this.Confirmed) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`PayloadRaw:` + fmt.Sprintf("%v", this.PayloadRaw) + `,`,
+		`PayloadFields:` + fmt.Sprintf("%v", this.PayloadFields) + `,`,
+		`TTL:` + fmt.Sprintf("%v", this.TTL) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`MaxRetries:` + strings.Replace(fmt.Sprintf("%v", this.MaxRetries), "Int32Value", "google_protobuf1.Int32Value", 1) + `,`,
+		`GroupDownlinkID:` + fmt.Sprintf("%v", this.GroupDownlinkID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkQueue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkQueue{`,
+		`Current:` + strings.Replace(fmt.Sprintf("%v", this.Current), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`Queue:` + strings.Replace(fmt.Sprintf("%v", this.Queue), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueuedDownlinkIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueuedDownlinkIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryRequest{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`Until:` + fmt.Sprintf("%v", this.Until) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryUplink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryUplink{`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`Confirmed:` + fmt.Sprintf("%v", this.Confirmed) + `,`,
+		`IsRetry:` + fmt.Sprintf("%v", this.IsRetry) + `,`,
+		`PayloadRaw:` + fmt.Sprintf("%v", this.PayloadRaw) + `,`,
+		`PayloadFields:` + fmt.Sprintf("%v", this.PayloadFields) + `,`,
+		`Metadata:` + fmt.Sprintf("%v", this.Metadata) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryEvent{`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryEntry{`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`Uplink:` + strings.Replace(fmt.Sprintf("%v", this.Uplink), "HistoryUplink", "HistoryUplink", 1) + `,`,
+		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "HistoryEvent", "HistoryEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *History) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&History{`,
+		`Entries:` + strings.Replace(fmt.Sprintf("%v", this.Entries), "HistoryEntry", "HistoryEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSelector := make([]string, 0, len(this.Selector))
+	for k, _ := range this.Selector {
+		keysForSelector = append(keysForSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSelector)
+	mapStringForSelector := "map[string]string{"
+	for _, k := range keysForSelector {
+		mapStringForSelector += fmt.Sprintf("%v: %v,", k, this.Selector[k])
+	}
+	mapStringForSelector += "}"
+	s := strings.Join([]string{`&DeviceGroup{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Devices:` + fmt.Sprintf("%v", this.Devices) + `,`,
+		`Selector:` + mapStringForSelector + `,`,
+		`Members:` + fmt.Sprintf("%v", this.Members) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceGroupList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceGroupList{`,
+		`Groups:` + strings.Replace(fmt.Sprintf("%v", this.Groups), "DeviceGroup", "DeviceGroup", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceGroupIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceGroupIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`GroupID:` + fmt.Sprintf("%v", this.GroupID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupDownlinkRequest{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`GroupID:` + fmt.Sprintf("%v", this.GroupID) + `,`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupDownlinkIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupDownlinkIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`GroupID:` + fmt.Sprintf("%v", this.GroupID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GroupDownlinkReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupDownlinkReport{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`GroupID:` + fmt.Sprintf("%v", this.GroupID) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Devices:` + fmt.Sprintf("%v", this.Devices) + `,`,
+		`Scheduled:` + fmt.Sprintf("%v", this.Scheduled) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Sent:` + fmt.Sprintf("%v", this.Sent) + `,`,
+		`Acked:` + fmt.Sprintf("%v", this.Acked) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportDevicesRequest{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportDevicesError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportDevicesError{`,
+		`Row:` + fmt.Sprintf("%v", this.Row) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportDevicesResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportDevicesResult{`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Rows:` + fmt.Sprintf("%v", this.Rows) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Errors:` + strings.Replace(fmt.Sprintf("%v", this.Errors), "ImportDevicesError", "ImportDevicesError", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportDevicesRequest{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportDevicesData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportDevicesData{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortFunctions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortFunctions{`,
+		`Decoder:` + fmt.Sprintf("%v", this.Decoder) + `,`,
+		`Converter:` + fmt.Sprintf("%v", this.Converter) + `,`,
+		`Validator:` + fmt.Sprintf("%v", this.Validator) + `,`,
+		`Encoder:` + fmt.Sprintf("%v", this.Encoder) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadFunctions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPorts := make([]uint32, 0, len(this.Ports))
+	for k, _ := range this.Ports {
+		keysForPorts = append(keysForPorts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForPorts)
+	mapStringForPorts := "map[uint32]*PortFunctions{"
+	for _, k := range keysForPorts {
+		mapStringForPorts += fmt.Sprintf("%v: %v,", k, this.Ports[k])
+	}
+	mapStringForPorts += "}"
+	keysForSets := make([]string, 0, len(this.Sets))
+	for k, _ := range this.Sets {
+		keysForSets = append(keysForSets, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSets)
+	mapStringForSets := "map[string]*PortFunctions{"
+	for _, k := range keysForSets {
+		mapStringForSets += fmt.Sprintf("%v: %v,", k, this.Sets[k])
+	}
+	mapStringForSets += "}"
+	s := strings.Join([]string{`&PayloadFunctions{`,
+		`PayloadFormat:` + fmt.Sprintf("%v", this.PayloadFormat) + `,`,
+		`Decoder:` + fmt.Sprintf("%v", this.Decoder) + `,`,
+		`Converter:` + fmt.Sprintf("%v", this.Converter) + `,`,
+		`Validator:` + fmt.Sprintf("%v", this.Validator) + `,`,
+		`Encoder:` + fmt.Sprintf("%v", this.Encoder) + `,`,
+		`BinaryFormat:` + fmt.Sprintf("%v", this.BinaryFormat) + `,`,
+		`Ports:` + mapStringForPorts + `,`,
+		`Sets:` + mapStringForSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Revision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Revision{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`RollbackOf:` + fmt.Sprintf("%v", this.RollbackOf) + `,`,
+		`Functions:` + strings.Replace(fmt.Sprintf("%v", this.Functions), "PayloadFunctions", "PayloadFunctions", 1) + `,`,
+		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionList{`,
+		`Revisions:` + strings.Replace(fmt.Sprintf("%v", this.Revisions), "Revision", "Revision", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortFunctionsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPorts := make([]uint32, 0, len(this.Ports))
+	for k, _ := range this.Ports {
+		keysForPorts = append(keysForPorts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForPorts)
+	mapStringForPorts := "map[uint32]*PortFunctions{"
+	for _, k := range keysForPorts {
+		mapStringForPorts += fmt.Sprintf("%v: %v,", k, this.Ports[k])
+	}
+	mapStringForPorts += "}"
+	s := strings.Join([]string{`&PortFunctionsList{`,
+		`Ports:` + mapStringForPorts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortFunctionsIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortFunctionsIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPortFunctionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPortFunctionsRequest{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`Functions:` + strings.Replace(fmt.Sprintf("%v", this.Functions), "PortFunctions", "PortFunctions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FunctionSetList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSets := make([]string, 0, len(this.Sets))
+	for k, _ := range this.Sets {
+		keysForSets = append(keysForSets, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSets)
+	mapStringForSets := "map[string]*PortFunctions{"
+	for _, k := range keysForSets {
+		mapStringForSets += fmt.Sprintf("%v: %v,", k, this.Sets[k])
+	}
+	mapStringForSets += "}"
+	s := strings.Join([]string{`&FunctionSetList{`,
+		`Sets:` + mapStringForSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FunctionSetIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FunctionSetIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetFunctionSetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetFunctionSetRequest{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Functions:` + strings.Replace(fmt.Sprintf("%v", this.Functions), "PortFunctions", "PortFunctions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DevicePayloadFormat) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DevicePayloadFormat{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`PayloadFormat:` + fmt.Sprintf("%v", this.PayloadFormat) + `,`,
+		`FunctionSet:` + fmt.Sprintf("%v", this.FunctionSet) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Fragmentation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Fragmentation{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Fragments:` + fmt.Sprintf("%v", this.Fragments) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceClass) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceClass{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceStatus{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`DevID:` + fmt.Sprintf("%v", this.DevID) + `,`,
+		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`Time:` + fmt.Sprintf("%v", this.Time) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Webhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k, _ := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&Webhook{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`UplinkURL:` + fmt.Sprintf("%v", this.UplinkURL) + `,`,
+		`EventURL:` + fmt.Sprintf("%v", this.EventURL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookList{`,
+		`Webhooks:` + strings.Replace(fmt.Sprintf("%v", this.Webhooks), "Webhook", "Webhook", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookIdentifier{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HandlerStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandlerStatusRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IntegrationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IntegrationStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
+		`Spool:` + fmt.Sprintf("%v", this.Spool) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FunctionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FunctionStatus{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Mean:` + fmt.Sprintf("%v", this.Mean) + `,`,
+		`P50:` + fmt.Sprintf("%v", this.P50) + `,`,
+		`P95:` + fmt.Sprintf("%v", this.P95) + `,`,
+		`P99:` + fmt.Sprintf("%v", this.P99) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HandlerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandlerStatus{`,
+		`Integrations:` + strings.Replace(fmt.Sprintf("%v", this.Integrations), "IntegrationStatus", "IntegrationStatus", 1) + `,`,
+		`PayloadFunctions:` + strings.Replace(fmt.Sprintf("%v", this.PayloadFunctions), "FunctionStatus", "FunctionStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaLimits{`,
+		`UplinksPerHour:` + fmt.Sprintf("%v", this.UplinksPerHour) + `,`,
+		`DownlinksPerDay:` + fmt.Sprintf("%v", this.DownlinksPerDay) + `,`,
+		`DownlinkAirtimePerDay:` + fmt.Sprintf("%v", this.DownlinkAirtimePerDay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaUsage{`,
+		`UplinksPerHour:` + fmt.Sprintf("%v", this.UplinksPerHour) + `,`,
+		`DownlinksPerDay:` + fmt.Sprintf("%v", this.DownlinksPerDay) + `,`,
+		`DownlinkAirtimePerDay:` + fmt.Sprintf("%v", this.DownlinkAirtimePerDay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Quotas) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Quotas{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Application:` + strings.Replace(fmt.Sprintf("%v", this.Application), "QuotaLimits", "QuotaLimits", 1) + `,`,
+		`Device:` + strings.Replace(fmt.Sprintf("%v", this.Device), "QuotaLimits", "QuotaLimits", 1) + `,`,
+		`Usage:` + strings.Replace(fmt.Sprintf("%v", this.Usage), "QuotaUsage", "QuotaUsage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BinaryFormat) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BinaryFormat{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkServerDeviceIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkServerDeviceIdentifier{`,
+		`AppEUI:` + fmt.Sprintf("%v", this.AppEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkServerDeviceClass) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkServerDeviceClass{`,
+		`AppEUI:` + fmt.Sprintf("%v", this.AppEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RXSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RXSettings{`,
+		`RX1DROffset:` + fmt.Sprintf("%v", this.RX1DROffset) + `,`,
+		`RX2DataRate:` + fmt.Sprintf("%v", this.RX2DataRate) + `,`,
+		`RX2Frequency:` + fmt.Sprintf("%v", this.RX2Frequency) + `,`,
+		`RX1Delay:` + fmt.Sprintf("%v", this.RX1Delay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkServerDeviceRXSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkServerDeviceRXSettings{`,
+		`AppEUI:` + fmt.Sprintf("%v", this.AppEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`Current:` + strings.Replace(fmt.Sprintf("%v", this.Current), "RXSettings", "RXSettings", 1) + `,`,
+		`Desired:` + strings.Replace(fmt.Sprintf("%v", this.Desired), "RXSettings", "RXSettings", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Channel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Channel{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DownlinkFrequency:` + fmt.Sprintf("%v", this.DownlinkFrequency) + `,`,
+		`MinDataRate:` + fmt.Sprintf("%v", this.MinDataRate) + `,`,
+		`MaxDataRate:` + fmt.Sprintf("%v", this.MaxDataRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkServerDeviceChannels) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkServerDeviceChannels{`,
+		`AppEUI:` + fmt.Sprintf("%v", this.AppEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`Band:` + fmt.Sprintf("%v", this.Band) + `,`,
+		`Override:` + strings.Replace(fmt.Sprintf("%v", this.Override), "Channel", "Channel", 1) + `,`,
+		`Confirmed:` + strings.Replace(fmt.Sprintf("%v", this.Confirmed), "Channel", "Channel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkServerDeviceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkServerDeviceStatus{`,
+		`AppEUI:` + fmt.Sprintf("%v", this.AppEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Battery:` + fmt.Sprintf("%v", this.Battery) + `,`,
+		`Margin:` + fmt.Sprintf("%v", this.Margin) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringManager(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ApplicationIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadRaw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadRaw = append(m.PayloadRaw[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadRaw == nil {
+				m.PayloadRaw = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TTL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxRetries == nil {
+				m.MaxRetries = &google_protobuf1.Int32Value{}
+			}
+			if err := m.MaxRetries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupDownlinkID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupDownlinkID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Current == nil {
+				m.Current = &DownlinkMessage{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = append(m.Queue, &DownlinkMessage{})
+			if err := m.Queue[len(m.Queue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedDownlinkIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedDownlinkIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedDownlinkIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryUplink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryUplink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryUplink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
+			}
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRetry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRetry = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadRaw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadRaw = append(m.PayloadRaw[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadRaw == nil {
+				m.PayloadRaw = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFields = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &HistoryUplink{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &HistoryEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *History) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: History: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: History: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &HistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthManager
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Selector == nil {
+				m.Selector = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthManager
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Selector[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Selector[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceGroupList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceGroupList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &DeviceGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceGroupIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceGroupIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceGroupIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupDownlinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupDownlinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &DownlinkMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupDownlinkIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupDownlinkIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupDownlinkIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupDownlinkReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupDownlinkReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupDownlinkReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			m.Devices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Devices |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			m.Scheduled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheduled |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acked", wireType)
+			}
+			m.Acked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Acked |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportDevicesError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportDevicesError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportDevicesError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportDevicesResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportDevicesResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportDevicesResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &ImportDevicesError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportDevicesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportDevicesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportDevicesData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportDevicesData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportDevicesData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortFunctions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortFunctions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortFunctions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadFunctions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadFunctions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadFunctions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Ports == nil {
+				m.Ports = make(map[uint32]*PortFunctions)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &PortFunctions{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Ports[mapkey] = mapvalue
+			} else {
+				var mapvalue *PortFunctions
+				m.Ports[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthManager
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Sets == nil {
+				m.Sets = make(map[string]*PortFunctions)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &PortFunctions{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Sets[mapkey] = mapvalue
+			} else {
+				var mapvalue *PortFunctions
+				m.Sets[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Revision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Revision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Revision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackOf", wireType)
+			}
+			m.RollbackOf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollbackOf |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Functions == nil {
+				m.Functions = &PayloadFunctions{}
+			}
+			if err := m.Functions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &Revision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortFunctionsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortFunctionsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortFunctionsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Ports == nil {
+				m.Ports = make(map[uint32]*PortFunctions)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &PortFunctions{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Ports[mapkey] = mapvalue
+			} else {
+				var mapvalue *PortFunctions
+				m.Ports[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortFunctionsIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortFunctionsIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortFunctionsIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPortFunctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPortFunctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPortFunctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPort", wireType)
+			}
+			m.FPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Functions == nil {
+				m.Functions = &PortFunctions{}
+			}
+			if err := m.Functions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunctionSetList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunctionSetList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunctionSetList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthManager
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Sets == nil {
+				m.Sets = make(map[string]*PortFunctions)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthManager
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &PortFunctions{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Sets[mapkey] = mapvalue
+			} else {
+				var mapvalue *PortFunctions
+				m.Sets[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunctionSetIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunctionSetIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunctionSetIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFunctionSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFunctionSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFunctionSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Functions == nil {
+				m.Functions = &PortFunctions{}
+			}
+			if err := m.Functions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevicePayloadFormat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevicePayloadFormat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevicePayloadFormat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fragmentation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fragmentation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fragmentation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragments", wireType)
+			}
+			m.Fragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fragments |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Class = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Battery |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Webhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthManager
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthManager
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Headers[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Headers[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntegrationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegrationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegrationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spool", wireType)
+			}
+			m.Spool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Spool |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunctionStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunctionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunctionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Mean = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.P50 = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.P95 = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.P99 = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Max = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Integrations = append(m.Integrations, &IntegrationStatus{})
+			if err := m.Integrations[len(m.Integrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFunctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadFunctions = append(m.PayloadFunctions, &FunctionStatus{})
+			if err := m.PayloadFunctions[len(m.PayloadFunctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinksPerHour", wireType)
+			}
+			m.UplinksPerHour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinksPerHour |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinksPerDay", wireType)
+			}
+			m.DownlinksPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinksPerDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtimePerDay", wireType)
+			}
+			m.DownlinkAirtimePerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkAirtimePerDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinksPerHour", wireType)
+			}
+			m.UplinksPerHour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinksPerHour |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinksPerDay", wireType)
+			}
+			m.DownlinksPerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinksPerDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtimePerDay", wireType)
+			}
+			m.DownlinkAirtimePerDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkAirtimePerDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quotas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quotas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quotas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Application == nil {
+				m.Application = &QuotaLimits{}
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Device == nil {
+				m.Device = &QuotaLimits{}
+			}
+			if err := m.Device.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &QuotaUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryFormat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryFormat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryFormat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkServerDeviceIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkServerDeviceIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkServerDeviceIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppEUI = append(m.AppEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppEUI == nil {
+				m.AppEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEUI = append(m.DevEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.DevEUI == nil {
+				m.DevEUI = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkServerDeviceClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkServerDeviceClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkServerDeviceClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppEUI = append(m.AppEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppEUI == nil {
+				m.AppEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEUI = append(m.DevEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.DevEUI == nil {
+				m.DevEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Class = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RXSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RXSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RXSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RX1DROffset", wireType)
+			}
+			m.RX1DROffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RX1DROffset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RX2DataRate", wireType)
+			}
+			m.RX2DataRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RX2DataRate |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RX2Frequency", wireType)
+			}
+			m.RX2Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RX2Frequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RX1Delay", wireType)
+			}
+			m.RX1Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RX1Delay |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkServerDeviceRXSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkServerDeviceRXSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkServerDeviceRXSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppEUI = append(m.AppEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppEUI == nil {
+				m.AppEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEUI = append(m.DevEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.DevEUI == nil {
+				m.DevEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Current == nil {
+				m.Current = &RXSettings{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Desired == nil {
+				m.Desired = &RXSettings{}
+			}
+			if err := m.Desired.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Channel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFrequency", wireType)
+			}
+			m.DownlinkFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkFrequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDataRate", wireType)
+			}
+			m.MinDataRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDataRate |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataRate", wireType)
+			}
+			m.MaxDataRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataRate |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkServerDeviceChannels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkServerDeviceChannels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkServerDeviceChannels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppEUI = append(m.AppEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppEUI == nil {
+				m.AppEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEUI = append(m.DevEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.DevEUI == nil {
+				m.DevEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Band", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Band = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Override = append(m.Override, &Channel{})
+			if err := m.Override[len(m.Override)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmed = append(m.Confirmed, &Channel{})
+			if err := m.Confirmed[len(m.Confirmed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkServerDeviceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkServerDeviceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkServerDeviceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppEUI = append(m.AppEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppEUI == nil {
+				m.AppEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevEUI = append(m.DevEUI[:0], dAtA[iNdEx:postIndex]...)
+			if m.DevEUI == nil {
+				m.DevEUI = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			m.Battery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Battery |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			m.Margin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Margin |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthManager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipManager(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowManager
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowManager
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthManager
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowManager
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipManager(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthManager = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowManager   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("github.com/TheThingsNetwork/ttn/api/manager/manager.proto", fileDescriptorManager)
+}
+
+var fileDescriptorManager = []byte{
+	// 3302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4d, 0x6f, 0x23, 0xc7,
+	0xb1, 0x1e, 0x91, 0x14, 0xc9, 0xa2, 0xb8, 0x92, 0x7a, 0x25, 0x2d, 0x97, 0xbb, 0x2b, 0xc9, 0xe3,
+	0x8f, 0xb7, 0x7e, 0xf6, 0x4a, 0xb6, 0xf6, 0xad, 0xd7, 0xbb, 0xf6, 0xb3, 0x2d, 0xad, 0x3e, 0x6d,
+	0xed, 0x57, 0x73, 0xd7, 0x5e, 0xd8, 0xc0, 0x23, 0x46, 0x9c, 0xa6, 0x34, 0x58, 0x72, 0x86, 0xee,
+	0x69, 0x52, 0xd2, 0x7b, 0x78, 0x70, 0x80, 0x5c, 0x72, 0x8b, 0x03, 0x24, 0x41, 0x90, 0xe4, 0x94,
+	0x5c, 0xf2, 0x03, 0x72, 0xcb, 0x2d, 0x09, 0x90, 0x1c, 0x83, 0x9c, 0x9c, 0x8b, 0x60, 0x33, 0x40,
+	0xf2, 0x17, 0x02, 0xe4, 0x12, 0xf4, 0xc7, 0x7c, 0x72, 0x86, 0x4b, 0x39, 0x0a, 0x90, 0x13, 0xa7,
+	0xaa, 0xab, 0xaa, 0xab, 0xab, 0xaa, 0xab, 0xba, 0xab, 0x09, 0xb7, 0xf6, 0x2d, 0x76, 0xd0, 0xdd,
+	0x5b, 0x6a, 0x38, 0xed, 0xe5, 0x47, 0x07, 0xe4, 0xd1, 0x81, 0x65, 0xef, 0xbb, 0xf7, 0x08, 0x3b,
+	0x74, 0xe8, 0xd3, 0x65, 0xc6, 0xec, 0x65, 0xa3, 0x63, 0x2d, 0xb7, 0x0d, 0xdb, 0xd8, 0x27, 0xd4,
+	0xfb, 0x5d, 0xea, 0x50, 0x87, 0x39, 0x28, 0xaf, 0xc0, 0xea, 0xa5, 0x7d, 0xc7, 0xd9, 0x6f, 0x91,
+	0x65, 0x81, 0xde, 0xeb, 0x36, 0x97, 0x49, 0xbb, 0xc3, 0x8e, 0x25, 0x55, 0x75, 0x3e, 0x3e, 0x78,
+	0x48, 0x8d, 0x4e, 0x87, 0x50, 0x57, 0x8d, 0x5f, 0x0b, 0x29, 0xb0, 0xef, 0xec, 0x3b, 0x01, 0x21,
+	0x87, 0x04, 0x20, 0xbe, 0x24, 0xb9, 0x7e, 0x0b, 0x66, 0x57, 0x3b, 0x9d, 0x96, 0xd5, 0x30, 0x98,
+	0xe5, 0xd8, 0x3b, 0x26, 0xb1, 0x99, 0xd5, 0xb4, 0x08, 0x45, 0x8b, 0x30, 0x6e, 0x74, 0x3a, 0x75,
+	0xcb, 0xac, 0x68, 0x8b, 0xda, 0xd5, 0xe2, 0x5a, 0xb1, 0x7f, 0xb2, 0x90, 0x5b, 0xed, 0x74, 0x76,
+	0xd6, 0x71, 0xce, 0xe8, 0x74, 0x76, 0x4c, 0xfd, 0x23, 0x98, 0x5a, 0x27, 0x3d, 0xab, 0x41, 0x4e,
+	0xc3, 0xc5, 0x29, 0x4c, 0xd2, 0xe3, 0x14, 0x63, 0x01, 0xc5, 0x3a, 0xe9, 0x71, 0x0a, 0x93, 0xf4,
+	0x76, 0x4c, 0xfd, 0xef, 0x63, 0x30, 0xb9, 0xee, 0x1c, 0xda, 0x2d, 0xcb, 0x7e, 0x7a, 0x97, 0xb8,
+	0xae, 0xb1, 0x4f, 0xd0, 0x1c, 0x8c, 0xf9, 0x32, 0xc7, 0xfb, 0x27, 0x0b, 0x63, 0x3b, 0xeb, 0x78,
+	0xcc, 0x32, 0xd1, 0x2c, 0x8c, 0x37, 0xeb, 0x1d, 0x87, 0x32, 0x21, 0xad, 0x8c, 0x73, 0xcd, 0x07,
+	0x0e, 0x65, 0xe8, 0x32, 0x14, 0x1b, 0x8e, 0xdd, 0xb4, 0x68, 0x9b, 0x98, 0x95, 0xcc, 0xa2, 0x76,
+	0xb5, 0x80, 0x03, 0x04, 0xaa, 0x42, 0xc1, 0x6d, 0x1c, 0x10, 0xb3, 0xdb, 0x22, 0x95, 0x2c, 0x17,
+	0x89, 0x7d, 0x18, 0x2d, 0x40, 0xa9, 0x63, 0x1c, 0xb7, 0x1c, 0xc3, 0xac, 0x53, 0xe3, 0xb0, 0x92,
+	0x5b, 0xd4, 0xae, 0x4e, 0x60, 0x50, 0x28, 0x6c, 0x1c, 0xa2, 0x97, 0xe0, 0x9c, 0x47, 0xd0, 0xb4,
+	0x48, 0xcb, 0x74, 0x2b, 0xe3, 0x42, 0x44, 0x59, 0x61, 0x37, 0x05, 0x12, 0x5d, 0x84, 0x0c, 0x63,
+	0xad, 0x4a, 0x5e, 0x68, 0x9c, 0xef, 0x9f, 0x2c, 0x64, 0x1e, 0x3d, 0xda, 0xc5, 0x1c, 0x87, 0xae,
+	0x00, 0x90, 0xa3, 0x8e, 0x45, 0x89, 0x5b, 0x37, 0x58, 0xa5, 0xb0, 0xa8, 0x5d, 0xcd, 0xe0, 0xa2,
+	0xc2, 0xac, 0x32, 0xf4, 0x0e, 0x94, 0xda, 0xc6, 0x51, 0x9d, 0x12, 0x46, 0x2d, 0xe2, 0x56, 0x8a,
+	0x8b, 0xda, 0xd5, 0xd2, 0xca, 0xa5, 0x25, 0xe9, 0xf6, 0x25, 0xcf, 0x9b, 0x4b, 0x3b, 0x36, 0xbb,
+	0xbe, 0xf2, 0x91, 0xd1, 0xea, 0x12, 0x0c, 0x6d, 0xe3, 0x08, 0x4b, 0x72, 0xf4, 0x1e, 0x4c, 0xef,
+	0x53, 0xa7, 0xdb, 0xa9, 0x9b, 0xca, 0x82, 0xdc, 0xd2, 0x20, 0xb4, 0x38, 0xdf, 0x3f, 0x59, 0x98,
+	0xdc, 0xe2, 0x83, 0x9e, 0x75, 0x77, 0xd6, 0xf1, 0xe4, 0x7e, 0x04, 0x61, 0xea, 0xdf, 0xd5, 0xa0,
+	0xec, 0x81, 0x0f, 0xbb, 0xa4, 0x4b, 0xd0, 0x0a, 0xe4, 0x1b, 0x5d, 0x4a, 0x89, 0xcd, 0x84, 0x03,
+	0x4a, 0x2b, 0x95, 0x25, 0x2f, 0x70, 0x63, 0x6e, 0xc2, 0x1e, 0x21, 0x37, 0xb1, 0xc1, 0x18, 0x8f,
+	0x5b, 0x57, 0x79, 0xc6, 0x87, 0xd1, 0x12, 0xe4, 0x3e, 0xe3, 0x82, 0x2b, 0x99, 0xc5, 0xcc, 0x50,
+	0x69, 0x92, 0x4c, 0xef, 0x41, 0x45, 0x28, 0x62, 0x06, 0x5a, 0x9e, 0x65, 0xbc, 0xa9, 0xd8, 0xca,
+	0xc4, 0x63, 0x4b, 0xff, 0xa1, 0x06, 0xe7, 0xb6, 0x2d, 0x97, 0x39, 0xf4, 0x18, 0x93, 0xcf, 0xba,
+	0xc4, 0x65, 0x67, 0x32, 0xdd, 0x0c, 0xe4, 0x5c, 0xcb, 0x6e, 0x10, 0x31, 0x63, 0x06, 0x4b, 0x80,
+	0x63, 0xbb, 0x36, 0xb3, 0x5a, 0x22, 0x20, 0x33, 0x58, 0x02, 0x1c, 0xdb, 0xb2, 0xda, 0x16, 0x13,
+	0x71, 0x58, 0xc6, 0x12, 0xd0, 0xbf, 0xd4, 0xa0, 0xac, 0x14, 0x7b, 0xdc, 0xe1, 0x06, 0x09, 0x6d,
+	0x03, 0x2d, 0xbc, 0x0d, 0xce, 0x43, 0xae, 0x59, 0x6f, 0xd8, 0xde, 0xe6, 0xc8, 0x36, 0xef, 0xd8,
+	0xcf, 0xda, 0x1b, 0x17, 0xa1, 0x60, 0xb9, 0x22, 0xf8, 0x8e, 0x85, 0x2a, 0x05, 0x9c, 0xb7, 0x5c,
+	0x1e, 0x5c, 0xc7, 0x67, 0xb6, 0x35, 0xaa, 0x50, 0x68, 0x13, 0x66, 0x98, 0x06, 0x33, 0xe4, 0xfe,
+	0xc0, 0x3e, 0xac, 0xbf, 0x05, 0x13, 0x6a, 0x65, 0x1b, 0x3d, 0x1e, 0x47, 0x33, 0x90, 0x23, 0x3d,
+	0x2f, 0xf2, 0x8a, 0x58, 0x02, 0x08, 0x41, 0x56, 0x70, 0x0b, 0x13, 0x63, 0xf1, 0xad, 0x7f, 0x1e,
+	0x70, 0xda, 0x5c, 0x5b, 0x04, 0x59, 0x66, 0xb5, 0x89, 0x60, 0xcc, 0x60, 0xf1, 0x8d, 0x96, 0x60,
+	0xbc, 0x2b, 0x0c, 0x26, 0x38, 0x4b, 0x2b, 0x73, 0x7e, 0xe8, 0x45, 0xcc, 0x89, 0x15, 0x15, 0x7a,
+	0xd5, 0x9b, 0x3d, 0x23, 0xc8, 0x67, 0xe3, 0xe4, 0x42, 0x47, 0xa5, 0x94, 0x7e, 0x1b, 0xf2, 0x0a,
+	0x8d, 0x96, 0x21, 0x4f, 0x6c, 0xb9, 0x7d, 0xb5, 0xc5, 0x4c, 0x22, 0x27, 0xd7, 0x11, 0x7b, 0x54,
+	0xfa, 0xdf, 0x34, 0x28, 0xc9, 0x5c, 0x2a, 0xf6, 0xe7, 0x08, 0x71, 0x26, 0x83, 0x76, 0x6c, 0x20,
+	0x21, 0x56, 0x20, 0x6f, 0x0a, 0x41, 0xae, 0xd8, 0x5e, 0x45, 0xec, 0x81, 0xe8, 0x5d, 0x28, 0xb8,
+	0xa4, 0x45, 0x1a, 0xcc, 0xa1, 0x95, 0xac, 0xd0, 0x4a, 0x0f, 0x76, 0x5e, 0x30, 0xf7, 0x52, 0x4d,
+	0x11, 0x49, 0x15, 0x7d, 0x1e, 0x2e, 0xb9, 0x4d, 0xda, 0x7b, 0x84, 0xba, 0x95, 0x9c, 0x94, 0xac,
+	0xc0, 0xea, 0xdb, 0x50, 0x8e, 0x30, 0xa1, 0x29, 0xc8, 0x3c, 0x25, 0xc7, 0xca, 0x67, 0xfc, 0x93,
+	0xfb, 0xb1, 0xc7, 0x73, 0x95, 0x72, 0x99, 0x04, 0x6e, 0x8f, 0xbd, 0xa5, 0xe9, 0xef, 0xc1, 0x64,
+	0x68, 0xf6, 0x5d, 0xcb, 0x65, 0xe8, 0x35, 0x18, 0x17, 0x59, 0xc9, 0xb3, 0xde, 0x4c, 0x92, 0x9e,
+	0x58, 0xd1, 0xe8, 0x06, 0xcc, 0x86, 0xd0, 0xa7, 0xca, 0x0d, 0x2f, 0x43, 0x41, 0x26, 0x4b, 0xdf,
+	0x94, 0xa5, 0xfe, 0xc9, 0x42, 0x5e, 0x0a, 0x5a, 0xc7, 0xf9, 0x7d, 0x29, 0x51, 0xff, 0xbe, 0x06,
+	0x33, 0x91, 0xc4, 0x39, 0x7a, 0x3e, 0x18, 0x71, 0x0a, 0x9e, 0x64, 0xdb, 0x32, 0xed, 0xa9, 0x60,
+	0x1b, 0x92, 0x64, 0x15, 0xa1, 0xfe, 0x7f, 0x70, 0x21, 0x9a, 0xce, 0xff, 0x05, 0x6b, 0x4f, 0xcd,
+	0x8e, 0x5f, 0x8c, 0xc1, 0xf9, 0x98, 0x4d, 0x78, 0x02, 0x4a, 0xad, 0xd4, 0x81, 0x46, 0x63, 0x23,
+	0x68, 0x94, 0x19, 0xa2, 0xd1, 0x15, 0x80, 0x06, 0x25, 0x06, 0x23, 0x26, 0xaf, 0x9f, 0x32, 0x5f,
+	0x16, 0x15, 0x66, 0x95, 0x85, 0x77, 0x80, 0xcc, 0x9a, 0x1e, 0xc8, 0x33, 0x9f, 0x57, 0xe7, 0x4d,
+	0x91, 0x9a, 0xca, 0x38, 0x40, 0xa0, 0x39, 0x18, 0x6f, 0x1a, 0x16, 0x1f, 0xca, 0x8b, 0x21, 0x05,
+	0xf1, 0x44, 0xe2, 0x12, 0x5b, 0x16, 0xea, 0x32, 0x16, 0xdf, 0x3c, 0x9c, 0x8d, 0xc6, 0x53, 0x62,
+	0x8a, 0xea, 0x5c, 0xc6, 0x12, 0xd0, 0xff, 0x1f, 0x66, 0x76, 0xda, 0xdc, 0x08, 0x32, 0x1e, 0xdd,
+	0xd1, 0xa3, 0x84, 0xcf, 0xed, 0xd0, 0xb6, 0xc1, 0xd4, 0xfe, 0x50, 0x10, 0xba, 0x00, 0x79, 0x93,
+	0x1e, 0xd7, 0x69, 0xd7, 0x56, 0x99, 0x7a, 0xdc, 0xa4, 0xc7, 0xb8, 0x6b, 0xfb, 0x19, 0x30, 0x2b,
+	0x92, 0xb0, 0xcc, 0x80, 0xff, 0x03, 0x28, 0x32, 0xfd, 0x06, 0xa5, 0x0e, 0xe5, 0x7b, 0x91, 0x3a,
+	0x87, 0xaa, 0x2e, 0xf0, 0xcf, 0xd1, 0x4a, 0x14, 0xe1, 0xcc, 0xd2, 0x0d, 0x58, 0x02, 0xfa, 0x6f,
+	0x34, 0x38, 0x1f, 0x5b, 0x9f, 0xdb, 0x6d, 0x45, 0x94, 0xd4, 0xe2, 0x4a, 0x52, 0xe7, 0xd0, 0x3b,
+	0x00, 0x88, 0x6f, 0xee, 0x1d, 0xe5, 0x2a, 0x21, 0xbc, 0x8c, 0x3d, 0x90, 0x8f, 0x74, 0x3b, 0xa6,
+	0x18, 0xc9, 0xca, 0x11, 0x05, 0x86, 0x3c, 0x93, 0x8b, 0x78, 0xe6, 0x3a, 0x8c, 0x0b, 0xcd, 0x78,
+	0x9d, 0xc9, 0x88, 0x43, 0x92, 0xb7, 0x65, 0x06, 0xed, 0x80, 0x15, 0xa9, 0xfe, 0x00, 0x66, 0x36,
+	0x8e, 0xce, 0xd2, 0x49, 0xfa, 0x7f, 0xc0, 0x74, 0x44, 0xe2, 0xba, 0xc1, 0x0c, 0xdf, 0x41, 0x5a,
+	0xc8, 0x41, 0x9f, 0x43, 0x99, 0x97, 0xe5, 0xcd, 0xae, 0xdd, 0xe0, 0x87, 0x6d, 0x57, 0x86, 0x6a,
+	0xc3, 0x31, 0x09, 0x55, 0xb9, 0xd2, 0x03, 0x55, 0x91, 0xee, 0x11, 0xca, 0x08, 0x55, 0xd3, 0x05,
+	0x08, 0x3e, 0xda, 0x33, 0x5a, 0x96, 0x69, 0x30, 0xdf, 0x47, 0x01, 0x82, 0x4b, 0x25, 0xb6, 0x94,
+	0x2a, 0x4f, 0xb7, 0x1e, 0xa8, 0xff, 0x25, 0x03, 0x53, 0x0f, 0x54, 0x2d, 0xf6, 0x95, 0x08, 0x57,
+	0x6d, 0xb9, 0x3c, 0x2d, 0x5a, 0xb5, 0x05, 0x32, 0xac, 0xeb, 0xd8, 0x10, 0x5d, 0x33, 0x43, 0x75,
+	0xcd, 0x0e, 0xd1, 0x35, 0x17, 0xd1, 0x15, 0xbd, 0x00, 0xe5, 0x3d, 0xcb, 0x36, 0xe8, 0xb1, 0xa7,
+	0x95, 0x3c, 0x4b, 0x4c, 0x48, 0xa4, 0x52, 0xea, 0x36, 0xe4, 0xb8, 0xe1, 0xdd, 0x4a, 0x5e, 0x04,
+	0xc0, 0x8b, 0x7e, 0x00, 0xc4, 0x57, 0xb9, 0xc4, 0x0d, 0xef, 0xca, 0x92, 0x26, 0x59, 0xd0, 0x4d,
+	0xbe, 0xaf, 0x99, 0x5b, 0x29, 0x08, 0xd6, 0x17, 0xd2, 0x59, 0x6b, 0xc4, 0xe3, 0x14, 0x0c, 0xd5,
+	0x07, 0x00, 0x81, 0xb4, 0x70, 0xad, 0x2b, 0xcb, 0x5a, 0xf7, 0x5a, 0xb8, 0xd6, 0x85, 0x0f, 0x19,
+	0x11, 0xe7, 0x87, 0x6a, 0x60, 0xf5, 0x3e, 0x14, 0x6b, 0x24, 0x41, 0x60, 0xf1, 0x1b, 0x0a, 0xd4,
+	0x7f, 0xa7, 0x41, 0x01, 0x93, 0x9e, 0xe5, 0x5a, 0x8e, 0xcd, 0xe3, 0xd6, 0xee, 0xf2, 0x4a, 0xad,
+	0x94, 0x54, 0x10, 0xc7, 0x1b, 0x5d, 0x76, 0xe0, 0x78, 0x0e, 0x55, 0x50, 0x2c, 0xbf, 0x66, 0xe2,
+	0xf9, 0x75, 0x01, 0x4a, 0xd4, 0x69, 0xb5, 0xf6, 0x8c, 0xc6, 0xd3, 0xba, 0xd3, 0x54, 0x7b, 0x15,
+	0x3c, 0xd4, 0xfd, 0x26, 0xba, 0x09, 0xc5, 0xa6, 0xa7, 0x94, 0xf0, 0x6a, 0x69, 0xe5, 0x62, 0xaa,
+	0x75, 0x71, 0x40, 0x2b, 0xf6, 0x8c, 0xd5, 0x6c, 0x2a, 0x4f, 0x8b, 0x6f, 0xfd, 0x3d, 0x98, 0xf0,
+	0x16, 0x22, 0xce, 0x06, 0xcb, 0x50, 0xa4, 0x0a, 0xf6, 0x8e, 0x07, 0xd3, 0xbe, 0x70, 0x8f, 0x12,
+	0x07, 0x34, 0xfa, 0x3d, 0x40, 0x1e, 0xfa, 0x54, 0xf5, 0x31, 0xb0, 0xda, 0x58, 0xd8, 0x6a, 0xfa,
+	0xcf, 0x34, 0x98, 0x8e, 0xd8, 0x5d, 0xa8, 0xf5, 0xb6, 0x17, 0x88, 0x52, 0xa5, 0x97, 0x92, 0x5d,
+	0xc4, 0x49, 0x07, 0x23, 0xf1, 0xec, 0x03, 0x4a, 0xc7, 0x70, 0x21, 0x32, 0x76, 0xaa, 0x95, 0x27,
+	0xdf, 0xa9, 0xf5, 0xef, 0x68, 0x70, 0xa1, 0x46, 0x58, 0x74, 0xce, 0x91, 0x93, 0x67, 0xb2, 0x50,
+	0xf4, 0x5f, 0xe1, 0x58, 0xc9, 0x0c, 0x5d, 0x5e, 0x40, 0xa8, 0xff, 0x58, 0x83, 0x49, 0x6f, 0xa0,
+	0x46, 0x98, 0xf0, 0xc0, 0x9b, 0x6a, 0x3b, 0x6b, 0xb1, 0xa3, 0x6d, 0x8c, 0x6e, 0x60, 0x37, 0x9f,
+	0xf9, 0xde, 0xbb, 0x0b, 0xb3, 0xa1, 0x39, 0x4f, 0x65, 0x79, 0x04, 0x59, 0xdb, 0x68, 0x7b, 0x87,
+	0x64, 0xf1, 0xad, 0x7f, 0x5b, 0x83, 0xd9, 0x1a, 0x61, 0x21, 0x91, 0xa3, 0x1b, 0x3d, 0x41, 0xde,
+	0x37, 0xb4, 0xf8, 0xcf, 0x35, 0x38, 0x2f, 0xcb, 0xdb, 0x83, 0x48, 0x55, 0x38, 0x8b, 0x0b, 0xf1,
+	0x60, 0x01, 0xca, 0x24, 0x15, 0xa0, 0xe7, 0x61, 0xc2, 0xd3, 0xa7, 0xee, 0x12, 0xa6, 0x6a, 0x49,
+	0xa9, 0x19, 0x18, 0x46, 0xff, 0x81, 0x06, 0xe5, 0x4d, 0x6a, 0xec, 0xb7, 0x89, 0xcd, 0x44, 0x3f,
+	0x6b, 0x34, 0x1b, 0x85, 0xc2, 0x32, 0xab, 0xce, 0xb0, 0xe3, 0x07, 0xc4, 0x30, 0xfd, 0x72, 0xa6,
+	0x20, 0x5e, 0xcb, 0x9a, 0x4a, 0xbc, 0xab, 0x12, 0x5f, 0x80, 0xe0, 0xb5, 0x8c, 0xdf, 0x32, 0x9d,
+	0x2e, 0xf3, 0x6a, 0x99, 0x02, 0xf5, 0x7d, 0xef, 0x76, 0x77, 0xa7, 0x65, 0xb8, 0xee, 0x59, 0x75,
+	0x11, 0x1a, 0x5c, 0x98, 0x77, 0x44, 0x13, 0x80, 0xfe, 0x23, 0x0d, 0x26, 0xe4, 0x4c, 0x35, 0x66,
+	0xb0, 0xee, 0xd9, 0x4c, 0x55, 0x81, 0xfc, 0x9e, 0xc1, 0x18, 0xa1, 0xc7, 0xde, 0x91, 0x4d, 0x81,
+	0xdc, 0x4e, 0x6d, 0x83, 0xee, 0x5b, 0xb6, 0x30, 0x46, 0x0e, 0x2b, 0xc8, 0xbf, 0x7b, 0xe7, 0x82,
+	0xbb, 0xb7, 0xfe, 0xbd, 0x31, 0xc8, 0x7f, 0x4c, 0xf6, 0x0e, 0x1c, 0xe7, 0xe9, 0x3f, 0x71, 0xbd,
+	0x7d, 0x0d, 0x40, 0xde, 0xcd, 0xeb, 0x5d, 0xda, 0x52, 0xb7, 0x84, 0x72, 0xff, 0x64, 0xa1, 0x28,
+	0x6f, 0xee, 0x8f, 0xf1, 0x2e, 0x2e, 0x4a, 0x82, 0xc7, 0xb4, 0x85, 0x5e, 0x81, 0xa2, 0xb8, 0x9b,
+	0x0b, 0x62, 0x11, 0x2f, 0x6b, 0x13, 0xfd, 0x93, 0x85, 0x82, 0xb8, 0xb7, 0x73, 0xda, 0x82, 0x18,
+	0xe6, 0xa4, 0x37, 0x21, 0x2f, 0x9d, 0x2c, 0x6f, 0xb7, 0xa5, 0x95, 0x2b, 0xfe, 0xa6, 0x50, 0x5a,
+	0x2f, 0x6d, 0xcb, 0x71, 0x75, 0x75, 0x57, 0xd4, 0xd5, 0xdb, 0x30, 0x11, 0x1e, 0x38, 0xd5, 0xdd,
+	0xf7, 0x6d, 0x28, 0x29, 0xe1, 0xea, 0xde, 0x5b, 0x38, 0x94, 0xa0, 0x97, 0xc6, 0xa6, 0xe2, 0x4a,
+	0x60, 0x9f, 0x42, 0xbf, 0x0b, 0xd3, 0x0a, 0x79, 0xca, 0xba, 0x96, 0x68, 0x59, 0x7d, 0x0e, 0x66,
+	0xb6, 0x0d, 0xdb, 0x6c, 0x11, 0x2a, 0x43, 0x47, 0x65, 0x19, 0xfd, 0x63, 0x98, 0xde, 0xb1, 0x19,
+	0xd9, 0xa7, 0x62, 0x43, 0xc9, 0x31, 0x3f, 0xb1, 0x68, 0xa1, 0xc4, 0x52, 0x11, 0x16, 0x6c, 0xb1,
+	0x83, 0x63, 0x21, 0xbd, 0x80, 0x3d, 0x50, 0x74, 0xbc, 0x3a, 0x8e, 0xd3, 0x52, 0xe1, 0x23, 0x01,
+	0xfd, 0xd7, 0x1a, 0x9c, 0xf3, 0xb3, 0xda, 0xa8, 0xd1, 0x7a, 0x19, 0x8a, 0xa6, 0x45, 0x89, 0x60,
+	0xf2, 0xce, 0xc5, 0x3e, 0x42, 0x6c, 0x0a, 0xa7, 0x6b, 0x7b, 0x87, 0x16, 0x09, 0x70, 0x65, 0xdb,
+	0xc4, 0x90, 0x31, 0xaa, 0x61, 0xf1, 0xcd, 0xbd, 0xd4, 0xb9, 0xf1, 0xba, 0x08, 0x50, 0x0d, 0xf3,
+	0x4f, 0x81, 0xb9, 0x75, 0xa3, 0x32, 0xae, 0x30, 0xb7, 0x6e, 0x48, 0xcc, 0xad, 0x4a, 0xde, 0xc3,
+	0xdc, 0xe2, 0x98, 0xb6, 0x71, 0x24, 0x6e, 0x82, 0x1a, 0xe6, 0x9f, 0x22, 0xe3, 0x44, 0xcc, 0x86,
+	0xde, 0x85, 0x09, 0x2b, 0xb0, 0x97, 0xe7, 0xc8, 0x6a, 0x70, 0x35, 0x89, 0x1b, 0x13, 0x47, 0xe8,
+	0xd1, 0x3a, 0x4c, 0xfb, 0xd9, 0xd0, 0xcf, 0xd3, 0x63, 0x42, 0xc8, 0x85, 0xc1, 0xa2, 0x26, 0x25,
+	0x4c, 0x75, 0x62, 0xa7, 0x2a, 0xfd, 0xa7, 0x1a, 0x94, 0x1e, 0x76, 0x1d, 0x66, 0xec, 0xf2, 0x8e,
+	0xa1, 0x8b, 0xae, 0xc2, 0x94, 0xdc, 0x16, 0x6e, 0xbd, 0x43, 0x68, 0xfd, 0xc0, 0xe9, 0x52, 0xd5,
+	0x19, 0x3b, 0xa7, 0xf0, 0x0f, 0x08, 0xdd, 0x76, 0xba, 0x14, 0xfd, 0x27, 0x4c, 0x7b, 0xad, 0x63,
+	0x49, 0x6b, 0x1a, 0xd2, 0xa1, 0x19, 0x3c, 0xe9, 0x0f, 0x3c, 0x20, 0x74, 0xdd, 0x38, 0x46, 0x37,
+	0xa1, 0xe2, 0xa1, 0xea, 0x86, 0x45, 0xf9, 0x3e, 0xf7, 0x59, 0xa4, 0x0b, 0x66, 0xbd, 0xf1, 0x55,
+	0x39, 0x2c, 0x19, 0xf5, 0x9f, 0x68, 0x00, 0x42, 0xbd, 0xc7, 0xa2, 0xbb, 0xff, 0x6f, 0xa6, 0xdd,
+	0xaf, 0x34, 0x18, 0x17, 0xda, 0x8d, 0x12, 0x91, 0x6f, 0x42, 0xc9, 0x08, 0x1e, 0x50, 0xd4, 0x31,
+	0x21, 0xe8, 0x58, 0x85, 0x9c, 0x80, 0xc3, 0x84, 0xbc, 0xc9, 0x25, 0xfb, 0x12, 0x95, 0xcc, 0x10,
+	0x16, 0x45, 0x83, 0x5e, 0x81, 0x5c, 0x57, 0x34, 0x87, 0xb2, 0x82, 0xf8, 0x7c, 0x94, 0xf8, 0xb1,
+	0x6c, 0x97, 0x0b, 0x0a, 0x7d, 0x1b, 0x26, 0xd6, 0xc2, 0x77, 0xa4, 0x6f, 0x7e, 0xb1, 0xb5, 0xe0,
+	0x8a, 0x7a, 0xb9, 0xaa, 0x11, 0xda, 0x23, 0x74, 0xe0, 0xb5, 0xe7, 0x05, 0xc8, 0x73, 0xd1, 0xa4,
+	0x6b, 0xc9, 0x7b, 0xee, 0x1a, 0xf4, 0x4f, 0x16, 0xc6, 0x57, 0x3b, 0x9d, 0x8d, 0xc7, 0x3b, 0x98,
+	0xcf, 0xba, 0xd1, 0xb5, 0x38, 0x11, 0x2f, 0x30, 0x9c, 0x68, 0x2c, 0x20, 0x5a, 0x27, 0x3d, 0x41,
+	0x64, 0x92, 0xde, 0x46, 0xd7, 0xd2, 0xff, 0x17, 0x2a, 0x09, 0x53, 0xc9, 0x72, 0x79, 0x66, 0xb3,
+	0xa4, 0x14, 0xcd, 0x2f, 0x35, 0x00, 0xfc, 0xa4, 0x46, 0x18, 0xe3, 0x6f, 0x75, 0xe8, 0x3a, 0x94,
+	0xe9, 0xd1, 0x1b, 0x75, 0x93, 0xd6, 0x9d, 0x66, 0x93, 0x1f, 0x34, 0xc4, 0x49, 0x7c, 0x6d, 0xb2,
+	0x7f, 0xb2, 0x50, 0xc2, 0x4f, 0xde, 0x58, 0xc7, 0xf7, 0x05, 0x1a, 0x97, 0xe8, 0xd1, 0x1b, 0xeb,
+	0x54, 0x02, 0x92, 0x69, 0xa5, 0x6e, 0x1a, 0xcc, 0xa8, 0x53, 0x83, 0xc9, 0x5c, 0xef, 0x33, 0xad,
+	0xac, 0x1b, 0xcc, 0xc0, 0x06, 0x23, 0x9c, 0xc9, 0x07, 0xd0, 0x0d, 0xc9, 0xd4, 0xa4, 0x3c, 0xd5,
+	0xda, 0x0d, 0x19, 0x95, 0xd9, 0xb5, 0xa9, 0xfe, 0xc9, 0xc2, 0x04, 0x7e, 0xb2, 0xb2, 0xe9, 0xe1,
+	0xf1, 0x04, 0x3d, 0x0a, 0x20, 0x5e, 0xd5, 0x84, 0x82, 0xa4, 0x65, 0xc8, 0x1e, 0x7d, 0x59, 0x56,
+	0x35, 0xae, 0x1c, 0xc7, 0xe1, 0x02, 0x3d, 0x92, 0x5f, 0xfc, 0x1e, 0x98, 0xe4, 0xc2, 0xd0, 0x6a,
+	0xcf, 0xce, 0xb8, 0xd7, 0x82, 0x67, 0xa2, 0x4c, 0x2c, 0x48, 0x83, 0xf9, 0x82, 0x17, 0xa2, 0x6b,
+	0x5c, 0xa6, 0x6b, 0x51, 0xd5, 0xee, 0x49, 0x23, 0x57, 0x34, 0xfa, 0x2f, 0x35, 0xc8, 0xdf, 0x39,
+	0x30, 0x6c, 0x9b, 0x88, 0x57, 0x11, 0xcb, 0x36, 0xc9, 0x91, 0xf7, 0xd8, 0x21, 0x00, 0x79, 0x38,
+	0xf3, 0x2c, 0xc9, 0xd5, 0xcc, 0xe2, 0x00, 0x81, 0xae, 0x01, 0xf2, 0x93, 0x41, 0xcc, 0xe0, 0xd8,
+	0x4f, 0x29, 0x81, 0x8d, 0x75, 0x28, 0xb7, 0x2d, 0x3b, 0xe4, 0x4f, 0x79, 0xda, 0x2b, 0xb5, 0x2d,
+	0xdb, 0x77, 0x1f, 0xa7, 0x31, 0x8e, 0x42, 0x34, 0x39, 0x45, 0x63, 0x1c, 0x79, 0x34, 0xfa, 0x9f,
+	0x34, 0xb8, 0x94, 0x14, 0xd8, 0x72, 0x25, 0x67, 0x69, 0x7e, 0x04, 0xd9, 0x3d, 0xc3, 0x56, 0x9d,
+	0x53, 0x2c, 0xbe, 0xf9, 0x81, 0xc2, 0xe9, 0x11, 0x4a, 0x2d, 0x93, 0x54, 0xb2, 0xb1, 0x03, 0x85,
+	0x52, 0x01, 0xfb, 0x14, 0x68, 0x29, 0xfc, 0x30, 0x94, 0x4b, 0x21, 0x0f, 0x48, 0xf4, 0x3f, 0x6a,
+	0x70, 0x31, 0x61, 0x6d, 0xaa, 0x0e, 0x9e, 0xdd, 0xca, 0xaa, 0x50, 0xe0, 0x15, 0x92, 0xf6, 0x0c,
+	0x75, 0xe2, 0xc3, 0x3e, 0x1c, 0x3e, 0x9b, 0x66, 0xd3, 0xce, 0xa6, 0xb9, 0xc8, 0xd9, 0xf4, 0x0a,
+	0x80, 0xea, 0x2b, 0xd6, 0x55, 0x53, 0x29, 0x83, 0x8b, 0x0a, 0xb3, 0xca, 0x56, 0xfe, 0x3a, 0x07,
+	0x28, 0xf4, 0x20, 0x7e, 0x57, 0x2e, 0x1f, 0x6d, 0xc0, 0xd4, 0x16, 0x61, 0xd1, 0x77, 0xd1, 0x8b,
+	0xb1, 0x67, 0x89, 0x20, 0x31, 0x56, 0xe7, 0x06, 0x9a, 0xf7, 0x92, 0xe5, 0x21, 0xcc, 0xac, 0x93,
+	0x16, 0x61, 0x24, 0xfa, 0xa0, 0x89, 0x9e, 0x0f, 0xe5, 0xf3, 0xe4, 0x97, 0xce, 0xea, 0xdc, 0xc0,
+	0x0b, 0xf0, 0x06, 0xff, 0x57, 0x00, 0xda, 0x02, 0xb4, 0xd9, 0xea, 0xba, 0x07, 0xa7, 0xd0, 0x2d,
+	0x59, 0xd0, 0x4d, 0x80, 0x2d, 0xc2, 0xbc, 0x27, 0xac, 0x0b, 0xf1, 0x17, 0x2b, 0x75, 0x1e, 0xac,
+	0x4e, 0xc5, 0x07, 0xd0, 0x26, 0x00, 0x3f, 0xbe, 0x8a, 0x3e, 0xbd, 0x8b, 0xe6, 0xfd, 0xf1, 0xc4,
+	0xff, 0x15, 0x54, 0x2b, 0x49, 0x8f, 0x39, 0x9c, 0x1f, 0xbd, 0x0f, 0x85, 0x2d, 0x22, 0xc5, 0xa0,
+	0xf9, 0x24, 0xaa, 0x90, 0x94, 0xc4, 0x27, 0x21, 0xf4, 0x16, 0x14, 0x6a, 0x9e, 0x84, 0x44, 0x8a,
+	0xd4, 0xc5, 0x6f, 0x40, 0x49, 0x3a, 0x66, 0xb4, 0xe9, 0xd3, 0xc4, 0xd4, 0x60, 0x66, 0xc3, 0x16,
+	0xaf, 0xd6, 0x91, 0xa7, 0x11, 0x14, 0x5c, 0x26, 0x92, 0x9e, 0x91, 0xaa, 0x97, 0xd3, 0x86, 0xc5,
+	0x6d, 0xf4, 0x09, 0xcc, 0x79, 0x76, 0x89, 0x8d, 0x2c, 0x26, 0xf3, 0x85, 0x14, 0x1d, 0x2e, 0xf9,
+	0x1e, 0x94, 0x23, 0x9d, 0xf2, 0x90, 0x9e, 0x49, 0x0f, 0x19, 0xd5, 0xcb, 0x69, 0xc3, 0xfc, 0x1d,
+	0xe0, 0xaa, 0x86, 0x76, 0xa1, 0xbc, 0x71, 0x94, 0x2c, 0x2f, 0xa9, 0xe7, 0x5e, 0xad, 0x26, 0x0f,
+	0xf3, 0xec, 0xf9, 0xba, 0x86, 0x36, 0xa1, 0xcc, 0xe3, 0xc2, 0xeb, 0xde, 0x3d, 0x3b, 0xb4, 0x66,
+	0x07, 0x1a, 0x81, 0x22, 0xae, 0xfe, 0x1b, 0x4a, 0x5b, 0xc4, 0x17, 0x83, 0x2e, 0x0d, 0x50, 0x85,
+	0x44, 0x0c, 0xf6, 0x12, 0xd1, 0x07, 0x50, 0xc1, 0xaa, 0xb9, 0x39, 0xd0, 0x3b, 0x3f, 0xad, 0xac,
+	0xfb, 0x30, 0xcd, 0x55, 0x8a, 0xbe, 0x02, 0x3c, 0x6b, 0x59, 0xd5, 0xf4, 0x66, 0x22, 0xda, 0x15,
+	0x79, 0x29, 0x2a, 0x6f, 0x31, 0x99, 0x3e, 0x31, 0x3d, 0x45, 0x39, 0x77, 0x61, 0xaa, 0x96, 0x2e,
+	0x2d, 0xa5, 0xf9, 0x97, 0xba, 0x19, 0xee, 0xc3, 0x79, 0xb9, 0xa7, 0x4e, 0xaf, 0x5e, 0xb2, 0xc0,
+	0x5d, 0x98, 0xe2, 0x8b, 0x0e, 0xb5, 0xc2, 0x4e, 0x93, 0x6e, 0xe2, 0x0d, 0xc3, 0x6d, 0x38, 0xb7,
+	0x15, 0xe9, 0xab, 0x85, 0x64, 0x25, 0x36, 0xf0, 0x52, 0xcd, 0xb6, 0x0d, 0xe7, 0x6a, 0x69, 0x92,
+	0x12, 0x5b, 0x77, 0xa9, 0x2b, 0xfc, 0x10, 0xa6, 0xa5, 0xc9, 0x4e, 0xa7, 0x56, 0xb2, 0xb0, 0x87,
+	0x22, 0x6f, 0x24, 0x75, 0xed, 0x86, 0x54, 0x87, 0xcb, 0xb1, 0xa1, 0x28, 0xe3, 0x3d, 0x98, 0xab,
+	0x25, 0x8b, 0x1c, 0xca, 0x97, 0xaa, 0xe2, 0x07, 0x22, 0x7c, 0xa3, 0x2d, 0xbb, 0x67, 0x79, 0x34,
+	0xf0, 0x42, 0x94, 0x6f, 0x4d, 0x04, 0x6f, 0x14, 0x97, 0x42, 0x9b, 0xaa, 0xcf, 0x5d, 0x2f, 0x64,
+	0x4f, 0xab, 0x52, 0xb2, 0xb8, 0x55, 0x11, 0x62, 0xe1, 0xbb, 0xcc, 0x10, 0xcb, 0xc7, 0x0b, 0x96,
+	0x64, 0x78, 0x57, 0xc4, 0x56, 0x18, 0x93, 0x48, 0x97, 0xaa, 0xc2, 0x1d, 0x98, 0xf4, 0x55, 0x50,
+	0x27, 0xb3, 0x21, 0x3a, 0xcc, 0xc6, 0x86, 0x14, 0xc7, 0x1d, 0x91, 0x41, 0x55, 0xb7, 0xe9, 0xd9,
+	0x7b, 0x6e, 0x26, 0xde, 0xb5, 0x52, 0x0d, 0x7a, 0xa8, 0xf9, 0x42, 0xd0, 0x40, 0x67, 0x6b, 0xc8,
+	0x0a, 0xca, 0xd2, 0x27, 0x1e, 0x6b, 0x35, 0xce, 0x3a, 0x82, 0x27, 0x76, 0x44, 0xa0, 0x45, 0x3b,
+	0x35, 0x41, 0x71, 0x4a, 0x6a, 0x7c, 0x55, 0xe7, 0x92, 0x87, 0xd1, 0x3b, 0x50, 0xdc, 0x22, 0x4c,
+	0xf5, 0x07, 0x9e, 0x65, 0x8a, 0xc9, 0xe8, 0x45, 0xdd, 0xe5, 0xed, 0xf7, 0x9a, 0xcf, 0x1d, 0x1f,
+	0x4d, 0x55, 0x7f, 0x13, 0x26, 0xa4, 0x0d, 0x46, 0x9c, 0x36, 0x4d, 0xce, 0xb6, 0x88, 0x86, 0x48,
+	0x7b, 0x60, 0xf4, 0xa2, 0x1a, 0x61, 0x7b, 0x1f, 0x26, 0x6b, 0x31, 0x49, 0xc9, 0x94, 0x69, 0xba,
+	0xac, 0xfc, 0x36, 0x07, 0x33, 0x91, 0xeb, 0x83, 0x77, 0xd6, 0xfe, 0x74, 0x60, 0xd7, 0xbc, 0xec,
+	0x4b, 0x1e, 0xda, 0x8f, 0xa8, 0x3e, 0x3f, 0x8c, 0x4e, 0x8a, 0xfa, 0x70, 0x60, 0x3f, 0x3d, 0x9b,
+	0x29, 0xd5, 0x9c, 0x75, 0x28, 0xf3, 0x93, 0x45, 0x70, 0x9b, 0x1e, 0x55, 0xd1, 0xa1, 0x74, 0x21,
+	0x79, 0xf7, 0xf9, 0x3f, 0xab, 0x46, 0x9e, 0x20, 0xa0, 0x4b, 0xd5, 0xf8, 0x53, 0xb1, 0x93, 0xfd,
+	0xeb, 0xe7, 0xa8, 0xfa, 0xbe, 0x38, 0xd4, 0x46, 0x9e, 0xb4, 0x0f, 0xa1, 0x54, 0x0b, 0x09, 0x1f,
+	0x89, 0x29, 0x55, 0xd3, 0x4f, 0x60, 0x42, 0x46, 0x81, 0xda, 0x76, 0xa3, 0xaa, 0xaa, 0x0f, 0xa3,
+	0x53, 0xb2, 0x3e, 0x80, 0x89, 0x5a, 0x58, 0xf6, 0x08, 0x3c, 0x69, 0x7a, 0xae, 0xdd, 0xfd, 0xf2,
+	0xeb, 0xf9, 0xe7, 0xbe, 0xfa, 0x7a, 0x5e, 0xfb, 0x56, 0x7f, 0x5e, 0xfb, 0x45, 0x7f, 0x5e, 0xfb,
+	0x7d, 0x7f, 0x5e, 0xfb, 0x43, 0x7f, 0x5e, 0xfb, 0xaa, 0x3f, 0xaf, 0x7d, 0xf1, 0xe7, 0xf9, 0xe7,
+	0x3e, 0x79, 0xf5, 0x14, 0x7f, 0x09, 0xdf, 0x1b, 0x17, 0xe2, 0xaf, 0xff, 0x63, 0x00, 0x4c, 0x49,
+	0xc7, 0x51, 0x48, 0x2e, 0x00, 0x00,
+}
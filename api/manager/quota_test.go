@@ -0,0 +1,54 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+)
+
+// quotaServer only implements the quota RPCs of the ApplicationManager
+type quotaServer struct {
+	ApplicationManagerServer
+	quotas *Quotas
+}
+
+func (s *quotaServer) GetQuotas(ctx context.Context, in *ApplicationIdentifier) (*Quotas, error) {
+	return s.quotas, nil
+}
+
+func TestGetQuotas(t *testing.T) {
+	a := New(t)
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	RegisterApplicationManagerServer(s, &quotaServer{quotas: &Quotas{
+		AppID:       "app",
+		Application: &QuotaLimits{UplinksPerHour: 100, DownlinkAirtimePerDay: 60000},
+		Device:      &QuotaLimits{DownlinksPerDay: 10},
+		Usage:       &QuotaUsage{UplinksPerHour: 42, DownlinksPerDay: 3, DownlinkAirtimePerDay: 1234},
+	}})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+
+	quotas, err := NewApplicationManagerClient(conn).GetQuotas(context.Background(), &ApplicationIdentifier{AppID: "app"})
+	a.So(err, ShouldBeNil)
+	a.So(quotas.AppID, ShouldEqual, "app")
+	a.So(quotas.Application, ShouldResemble, &QuotaLimits{UplinksPerHour: 100, DownlinkAirtimePerDay: 60000})
+	a.So(quotas.Device, ShouldResemble, &QuotaLimits{DownlinksPerDay: 10})
+	a.So(quotas.Usage, ShouldResemble, &QuotaUsage{UplinksPerHour: 42, DownlinksPerDay: 3, DownlinkAirtimePerDay: 1234})
+}
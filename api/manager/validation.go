@@ -0,0 +1,223 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package manager contains the management APIs of the Handler and NetworkServer that are not part of the
+// ApplicationManager and DeviceManager of github.com/TheThingsNetwork/api. They are served on the same gRPC servers,
+// with the same authorization.
+package manager
+
+import (
+	"strings"
+
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Validate the identifier
+func (m *ApplicationIdentifier) Validate() error {
+	return api.NotEmptyAndValidID(m.AppID, "AppID")
+}
+
+// Validate the identifier
+func (m *DeviceIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	return api.NotEmptyAndValidID(m.DevID, "DevID")
+}
+
+// Validate the identifier
+func (m *QueuedDownlinkIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(m.DevID, "DevID"); err != nil {
+		return err
+	}
+	if m.ID == "" {
+		return errors.NewErrInvalidArgument("ID", "can not be empty")
+	}
+	return nil
+}
+
+// Validate the request
+func (m *HistoryRequest) Validate() error {
+	if err := (&DeviceIdentifier{AppID: m.AppID, DevID: m.DevID}).Validate(); err != nil {
+		return err
+	}
+	if m.Since != 0 && m.Until != 0 && m.Until < m.Since {
+		return errors.NewErrInvalidArgument("Until", "can not be before Since")
+	}
+	return nil
+}
+
+// Validate the identifier
+func (m *DeviceGroupIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	return api.NotEmptyAndValidID(m.GroupID, "GroupID")
+}
+
+// Validate the request
+func (m *GroupDownlinkRequest) Validate() error {
+	if err := (&DeviceGroupIdentifier{AppID: m.AppID, GroupID: m.GroupID}).Validate(); err != nil {
+		return err
+	}
+	if m.Message == nil {
+		return errors.NewErrInvalidArgument("Message", "can not be empty")
+	}
+	return nil
+}
+
+// Validate the identifier
+func (m *GroupDownlinkIdentifier) Validate() error {
+	if err := (&DeviceGroupIdentifier{AppID: m.AppID, GroupID: m.GroupID}).Validate(); err != nil {
+		return err
+	}
+	if m.ID == "" {
+		return errors.NewErrInvalidArgument("ID", "can not be empty")
+	}
+	return nil
+}
+
+// Validate the identifier
+func (m *RevisionIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	if m.Number == 0 {
+		return errors.NewErrInvalidArgument("Number", "can not be empty")
+	}
+	return nil
+}
+
+// Validate the identifier
+func (m *PortFunctionsIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	if m.FPort < 1 || m.FPort > 223 {
+		return errors.NewErrInvalidArgument("FPort", "must be between 1 and 223")
+	}
+	return nil
+}
+
+// Validate the request
+func (m *SetPortFunctionsRequest) Validate() error {
+	if err := (&PortFunctionsIdentifier{AppID: m.AppID, FPort: m.FPort}).Validate(); err != nil {
+		return err
+	}
+	if m.Functions == nil {
+		return errors.NewErrInvalidArgument("Functions", "can not be empty")
+	}
+	return nil
+}
+
+// Validate the identifier
+func (m *FunctionSetIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	return api.NotEmptyAndValidID(m.Name, "Name")
+}
+
+// Validate the request
+func (m *SetFunctionSetRequest) Validate() error {
+	if err := (&FunctionSetIdentifier{AppID: m.AppID, Name: m.Name}).Validate(); err != nil {
+		return err
+	}
+	if m.Functions == nil {
+		return errors.NewErrInvalidArgument("Functions", "can not be empty")
+	}
+	return nil
+}
+
+// Validate the payload format
+func (m *DevicePayloadFormat) Validate() error {
+	if err := (&DeviceIdentifier{AppID: m.AppID, DevID: m.DevID}).Validate(); err != nil {
+		return err
+	}
+	if m.FunctionSet != "" && m.PayloadFormat != "" && m.PayloadFormat != "custom" {
+		return errors.NewErrInvalidArgument("FunctionSet", "can only be used with the custom payload format")
+	}
+	return nil
+}
+
+// Validate the identifier of the application; the settings themselves are validated by the Handler
+func (m *Fragmentation) Validate() error {
+	return api.NotEmptyAndValidID(m.AppID, "AppID")
+}
+
+// Validate the device class
+func (m *DeviceClass) Validate() error {
+	if err := (&DeviceIdentifier{AppID: m.AppID, DevID: m.DevID}).Validate(); err != nil {
+		return err
+	}
+	return validateClass(m.Class)
+}
+
+// Validate the identifier of the application; the webhook itself is validated by the Handler
+func (m *Webhook) Validate() error {
+	return api.NotEmptyAndValidID(m.AppID, "AppID")
+}
+
+// Validate the identifier
+func (m *WebhookIdentifier) Validate() error {
+	if err := api.NotEmptyAndValidID(m.AppID, "AppID"); err != nil {
+		return err
+	}
+	return api.NotEmptyAndValidID(m.ID, "Webhook ID")
+}
+
+// Validate the identifier of the application; the limits are validated by the Handler
+func (m *Quotas) Validate() error {
+	return api.NotEmptyAndValidID(m.AppID, "AppID")
+}
+
+// Validate the identifier of the application; the format itself is validated by the Handler
+func (m *BinaryFormat) Validate() error {
+	return api.NotEmptyAndValidID(m.AppID, "AppID")
+}
+
+// Validate the identifier
+func (m *NetworkServerDeviceIdentifier) Validate() error {
+	if len(m.AppEUI) != 8 {
+		return errors.NewErrInvalidArgument("AppEUI", "must be 8 bytes")
+	}
+	if len(m.DevEUI) != 8 {
+		return errors.NewErrInvalidArgument("DevEUI", "must be 8 bytes")
+	}
+	return nil
+}
+
+// Validate the device class
+func (m *NetworkServerDeviceClass) Validate() error {
+	if err := (&NetworkServerDeviceIdentifier{AppEUI: m.AppEUI, DevEUI: m.DevEUI}).Validate(); err != nil {
+		return err
+	}
+	return validateClass(m.Class)
+}
+
+func validateClass(class string) error {
+	switch strings.ToUpper(class) {
+	case "", "A", "B", "C":
+		return nil
+	}
+	return errors.NewErrInvalidArgument("Class", "must be A, B or C")
+}
+
+// Validate the receive window settings
+func (m *NetworkServerDeviceRXSettings) Validate() error {
+	return (&NetworkServerDeviceIdentifier{AppEUI: m.AppEUI, DevEUI: m.DevEUI}).Validate()
+}
+
+// Validate the channels
+func (m *NetworkServerDeviceChannels) Validate() error {
+	return (&NetworkServerDeviceIdentifier{AppEUI: m.AppEUI, DevEUI: m.DevEUI}).Validate()
+}
+
+// Validate the device status
+func (m *NetworkServerDeviceStatus) Validate() error {
+	return (&NetworkServerDeviceIdentifier{AppEUI: m.AppEUI, DevEUI: m.DevEUI}).Validate()
+}
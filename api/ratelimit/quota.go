@@ -0,0 +1,136 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/redis.v5"
+)
+
+// Quota keeps the usage of entities in fixed periods, so that it can be compared with a limit per entity.
+// Periods are aligned to the zero time, so a Quota per day resets at midnight UTC.
+// The usage is kept in Redis, so that it is shared between processes and kept on restart. The counters of a period
+// expire after the period.
+type Quota struct {
+	client *redis.Client
+	prefix string
+	per    time.Duration
+}
+
+// QuotaLimit is the limit of an entity. A limit of 0 is unlimited.
+type QuotaLimit struct {
+	ID    string
+	Limit int64
+}
+
+// NewQuota returns a new Quota with the given period, that stores its counters in Redis under the given prefix
+func NewQuota(client *redis.Client, prefix string, per time.Duration) *Quota {
+	return &Quota{
+		client: client,
+		prefix: prefix,
+		per:    per,
+	}
+}
+
+// period returns the start of the current period
+func (q *Quota) period() time.Time {
+	return time.Now().UTC().Truncate(q.per)
+}
+
+// key returns the key of the usage of the entity in the period
+func (q *Quota) key(id string, period time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", q.prefix, id, period.Unix())
+}
+
+// Usage returns the usage of the entity in the current period
+func (q *Quota) Usage(id string) (int64, error) {
+	used, err := q.client.Get(q.key(id, q.period())).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return used, err
+}
+
+// Add adds n to the usage of the entities in the current period
+func (q *Quota) Add(n int64, ids ...string) error {
+	period := q.period()
+	_, err := q.client.Pipelined(func(pipe *redis.Pipeline) error {
+		for _, id := range ids {
+			pipe.IncrBy(q.key(id, period), n)
+			pipe.ExpireAt(q.key(id, period), period.Add(q.per))
+		}
+		return nil
+	})
+	return err
+}
+
+// takeAttempts is the number of times that taking from a quota is tried when the usage is changed concurrently
+const takeAttempts = 5
+
+// Take adds n to the usage of all entities in the current period, unless that exceeds the limit of one of them.
+// Checking and adding is one transaction, so concurrent calls do not exceed the limits. If a limit would be
+// exceeded, nothing is added and the entity is returned.
+func (q *Quota) Take(n int64, limits ...QuotaLimit) (exceeded *QuotaLimit, err error) {
+	for attempt := 0; attempt < takeAttempts; attempt++ {
+		exceeded, err = q.take(n, limits)
+		if err != redis.TxFailedErr {
+			break
+		}
+	}
+	return
+}
+
+func (q *Quota) take(n int64, limits []QuotaLimit) (exceeded *QuotaLimit, err error) {
+	period := q.period()
+	keys := make([]string, len(limits))
+	for i, limit := range limits {
+		keys[i] = q.key(limit.ID, period)
+	}
+	err = q.client.Watch(func(tx *redis.Tx) error {
+		for i, limit := range limits {
+			if limit.Limit <= 0 {
+				continue
+			}
+			used, err := tx.Get(keys[i]).Int64()
+			if err != nil && err != redis.Nil {
+				return err
+			}
+			if used+n > limit.Limit {
+				exceeded = &limits[i]
+				return nil
+			}
+		}
+		_, err := tx.Pipelined(func(pipe *redis.Pipeline) error {
+			for _, key := range keys {
+				pipe.IncrBy(key, n)
+				pipe.ExpireAt(key, period.Add(q.per))
+			}
+			return nil
+		})
+		return err
+	}, keys...)
+	if err != nil {
+		return nil, err
+	}
+	return exceeded, nil
+}
+
+// MarkExceeded marks the entity as exceeding its limit in the current period. It returns false if the entity
+// was already marked, so that callers can notify only once per period.
+func (q *Quota) MarkExceeded(id string) (bool, error) {
+	period := q.period()
+	return q.client.SetNX(q.key(id, period)+":exceeded", 1, period.Add(q.per).Sub(time.Now())).Result()
+}
+
+// Delete deletes the usage of the entity, and of the entities with an ID that starts with the ID of the entity
+// followed by a colon
+func (q *Quota) Delete(id string) error {
+	keys, err := q.client.Keys(fmt.Sprintf("%s:%s:*", q.prefix, id)).Result()
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	return q.client.Del(keys...).Err()
+}
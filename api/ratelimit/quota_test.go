@@ -0,0 +1,94 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestQuota(t *testing.T) {
+	a := New(t)
+
+	q := NewQuota(GetRedisClient(), "test-quota", time.Hour)
+	defer q.Delete("test")
+	defer q.Delete("other")
+	usage := func(id string) int64 {
+		used, err := q.Usage(id)
+		a.So(err, ShouldBeNil)
+		return used
+	}
+	markExceeded := func(id string) bool {
+		marked, err := q.MarkExceeded(id)
+		a.So(err, ShouldBeNil)
+		return marked
+	}
+
+	a.So(usage("test"), ShouldEqual, 0)
+	exceeded, err := q.Take(1, QuotaLimit{ID: "test", Limit: 2})
+	a.So(err, ShouldBeNil)
+	a.So(exceeded, ShouldBeNil)
+	exceeded, err = q.Take(1, QuotaLimit{ID: "test"}, QuotaLimit{ID: "test:dev", Limit: 1})
+	a.So(err, ShouldBeNil)
+	a.So(exceeded, ShouldBeNil)
+	a.So(usage("test"), ShouldEqual, 2)
+	a.So(usage("other"), ShouldEqual, 0)
+
+	// Nothing is added if one of the limits would be exceeded
+	exceeded, err = q.Take(1, QuotaLimit{ID: "other"}, QuotaLimit{ID: "test", Limit: 2})
+	a.So(err, ShouldBeNil)
+	a.So(exceeded, ShouldResemble, &QuotaLimit{ID: "test", Limit: 2})
+	a.So(usage("other"), ShouldEqual, 0)
+
+	a.So(q.Add(3, "test", "other"), ShouldBeNil)
+	a.So(usage("test"), ShouldEqual, 5)
+	a.So(usage("other"), ShouldEqual, 3)
+
+	a.So(markExceeded("test"), ShouldBeTrue)
+	a.So(markExceeded("test"), ShouldBeFalse)
+
+	// Deleting an entity also deletes the entities under it
+	a.So(q.Delete("test"), ShouldBeNil)
+	a.So(usage("test"), ShouldEqual, 0)
+	a.So(usage("test:dev"), ShouldEqual, 0)
+	a.So(markExceeded("test"), ShouldBeTrue)
+}
+
+func TestQuotaConcurrentTake(t *testing.T) {
+	a := New(t)
+
+	q := NewQuota(GetRedisClient(), "test-quota-concurrent", time.Hour)
+	defer q.Delete("test")
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var taken int
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				exceeded, err := q.Take(1, QuotaLimit{ID: "test", Limit: 5})
+				if err != nil {
+					continue
+				}
+				if exceeded == nil {
+					mu.Lock()
+					taken++
+					mu.Unlock()
+				}
+				return
+			}
+		}()
+	}
+	wg.Wait()
+	a.So(taken, ShouldEqual, 5)
+	used, err := q.Usage("test")
+	a.So(err, ShouldBeNil)
+	a.So(used, ShouldEqual, 5)
+}
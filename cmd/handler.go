@@ -10,12 +10,15 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	pb "github.com/TheThingsNetwork/api/handler"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/api/pool"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/geolocation"
 	"github.com/TheThingsNetwork/ttn/core/proxy"
 	"github.com/TheThingsNetwork/ttn/core/proxy/jsonpb"
 	"github.com/TheThingsNetwork/ttn/utils/parse"
@@ -68,6 +71,16 @@ var handlerCmd = &cobra.Command{
 			component.Identity.ApiAddress = fmt.Sprintf("http://%s:%d", viper.GetString("handler.server-address-announce"), viper.GetInt("handler.http-port"))
 		}
 
+		// Integrations of the types that are registered with handler.RegisterIntegration
+		var integrations []handler.Integration
+		for name := range viper.GetStringMap("handler.integrations") {
+			integration, err := handler.NewIntegration(name, viper.GetStringMapString("handler.integrations."+name))
+			if err != nil {
+				ctx.WithError(err).WithField("Integration", name).Fatal("Could not initialize integration")
+			}
+			integrations = append(integrations, integration)
+		}
+
 		// Handler
 		handler := handler.NewRedisHandler(
 			client,
@@ -113,6 +126,34 @@ var handlerCmd = &cobra.Command{
 			ctx.Warn("AMQP is not enabled in your configuration")
 		}
 
+		if viper.GetBool("handler.webhooks") {
+			if !httpActive {
+				ctx.Warn("Webhooks are enabled, but downlink through webhooks requires the HTTP server")
+			}
+			for _, network := range viper.GetStringSlice("handler.webhook-allowed-networks") {
+				_, allowed, err := net.ParseCIDR(network)
+				if err != nil {
+					ctx.WithError(err).WithField("Network", network).Fatal("Invalid webhook allowed network")
+				}
+				application.WebhookAllowedNetworks = append(application.WebhookAllowedNetworks, allowed)
+			}
+			handler = handler.WithWebhooks()
+		}
+
+		for _, integration := range integrations {
+			handler = handler.WithIntegration(integration)
+		}
+
+		handler = handler.WithSpool(viper.GetInt("handler.spool-size"), viper.GetDuration("handler.spool-age"))
+
+		if historySize := viper.GetInt("handler.history-size"); historySize > 0 {
+			handler = handler.WithHistory(historySize, viper.GetDuration("handler.history-age"))
+		}
+
+		if viper.GetBool("handler.location-solver") {
+			handler = handler.WithLocationSolver(geolocation.NewSolver())
+		}
+
 		if extraDeviceAttributes := viper.GetStringSlice("handler.extra-device-attributes"); len(extraDeviceAttributes) != 0 {
 			handler = handler.WithDeviceAttributes(extraDeviceAttributes...)
 		} else {
@@ -151,7 +192,11 @@ var handlerCmd = &cobra.Command{
 			defer cancel()
 			pb.RegisterApplicationManagerHandler(netCtx, mux, proxyConn)
 
-			prxy := proxy.WithToken(mux)
+			httpMux := http.NewServeMux()
+			httpMux.Handle("/", mux)
+			handler.RegisterHTTP(httpMux)
+
+			prxy := proxy.WithToken(httpMux)
 			prxy = proxy.WithPagination(prxy)
 			prxy = proxy.WithLogger(prxy, ctx)
 
@@ -206,6 +251,21 @@ func init() {
 	viper.BindPFlag("handler.amqp-password", handlerCmd.Flags().Lookup("amqp-password"))
 	viper.BindPFlag("handler.amqp-exchange", handlerCmd.Flags().Lookup("amqp-exchange"))
 
+	handlerCmd.Flags().Bool("webhooks", false, "Post uplink messages and events to the webhooks of applications")
+	viper.BindPFlag("handler.webhooks", handlerCmd.Flags().Lookup("webhooks"))
+	handlerCmd.Flags().StringSlice("webhook-allowed-networks", nil, "Internal networks (CIDR) that webhooks are allowed to post to")
+	viper.BindPFlag("handler.webhook-allowed-networks", handlerCmd.Flags().Lookup("webhook-allowed-networks"))
+
+	handlerCmd.Flags().Int("spool-size", 1000, "Maximum number of unpublished messages to keep per application and integration")
+	handlerCmd.Flags().Duration("spool-age", 24*time.Hour, "Maximum age of unpublished messages")
+	viper.BindPFlag("handler.spool-size", handlerCmd.Flags().Lookup("spool-size"))
+	viper.BindPFlag("handler.spool-age", handlerCmd.Flags().Lookup("spool-age"))
+
+	handlerCmd.Flags().Int("history-size", 0, "Number of uplink messages and events to keep per device. Leave 0 to disable the history")
+	handlerCmd.Flags().Duration("history-age", 7*24*time.Hour, "Maximum age of the uplink messages and events in the history")
+	viper.BindPFlag("handler.history-size", handlerCmd.Flags().Lookup("history-size"))
+	viper.BindPFlag("handler.history-age", handlerCmd.Flags().Lookup("history-age"))
+
 	handlerCmd.Flags().String("server-address", "0.0.0.0", "The IP address to listen for communication")
 	handlerCmd.Flags().String("server-address-announce", "localhost", "The public IP address to announce")
 	handlerCmd.Flags().Int("server-port", 1904, "The port for communication")
@@ -218,6 +278,9 @@ func init() {
 	viper.BindPFlag("handler.http-address", handlerCmd.Flags().Lookup("http-address"))
 	viper.BindPFlag("handler.http-port", handlerCmd.Flags().Lookup("http-port"))
 
+	handlerCmd.Flags().Bool("location-solver", false, "Estimate the location of devices without a registry location from the gateways that received them")
+	viper.BindPFlag("handler.location-solver", handlerCmd.Flags().Lookup("location-solver"))
+
 	handlerCmd.Flags().StringSlice("extra-device-attributes", nil, "Extra device attributes to be whitelisted")
 	viper.BindPFlag("handler.extra-device-attributes", handlerCmd.Flags().Lookup("extra-device-attributes"))
 }
@@ -54,6 +54,7 @@ var networkserverCmd = &cobra.Command{
 		}
 
 		// networkserver Server
+		networkserver.DefaultDevStatusInterval = viper.GetDuration("networkserver.dev-status-interval")
 		networkserver := networkserver.NewRedisNetworkServer(client, viper.GetInt("networkserver.net-id"))
 
 		// Register Prefixes
@@ -111,6 +112,9 @@ func init() {
 	networkserverCmd.Flags().Int("net-id", 19, "LoRaWAN NetID")
 	viper.BindPFlag("networkserver.net-id", networkserverCmd.Flags().Lookup("net-id"))
 
+	networkserverCmd.Flags().Duration("dev-status-interval", networkserver.DefaultDevStatusInterval, "Default interval at which the status (battery, margin) of devices is requested")
+	viper.BindPFlag("networkserver.dev-status-interval", networkserverCmd.Flags().Lookup("dev-status-interval"))
+
 	viper.SetDefault("networkserver.prefixes", map[string]string{
 		"26000000/20": "otaa,abp,world,local,private,testing",
 	})
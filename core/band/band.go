@@ -16,6 +16,22 @@ type FrequencyPlan struct {
 	lora.Band
 	ADR    *ADRConfig
 	CFList *lorawan.CFList
+	ClassB *ClassBConfig
+
+	// DefaultChannels is the number of UplinkChannels that are default channels of the band. The other channels
+	// are learned by devices from the CFList or from NewChannelReq MAC commands. It is 0 if the band does not
+	// support extra channels.
+	DefaultChannels int
+}
+
+// ClassBConfig contains the beacon and default ping slot parameters of Class B. Data rates are indexes in the
+// DataRates of the band.
+type ClassBConfig struct {
+	BeaconFrequency   int
+	BeaconDataRate    int
+	PingSlotFrequency int
+	PingSlotDataRate  int
+	TXPower           int
 }
 
 func (f *FrequencyPlan) GetDataRateStringForIndex(drIdx int) (string, error) {
@@ -71,6 +87,7 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 	switch region {
 	case pb_lorawan.FrequencyPlan_EU_863_870.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.EU_863_870, false, lorawan.DwellTimeNoLimit)
+		frequencyPlan.DefaultChannels = len(frequencyPlan.UplinkChannels)
 		// TTN uses SF9BW125 in RX2
 		frequencyPlan.RX2DataRate = 3
 		// TTN frequency plan includes extra channels next to the default channels:
@@ -88,6 +105,8 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		frequencyPlan.DownlinkChannels = frequencyPlan.UplinkChannels
 		frequencyPlan.CFList = &lorawan.CFList{867100000, 867300000, 867500000, 867700000, 867900000}
 		frequencyPlan.ADR = &ADRConfig{MinDataRate: 0, MaxDataRate: 5, MinTXPower: 2, MaxTXPower: 14}
+		// Beacons and ping slots use SF9BW125 on 869.525 MHz, which allows up to 27dBm
+		frequencyPlan.ClassB = &ClassBConfig{BeaconFrequency: 869525000, BeaconDataRate: 3, PingSlotFrequency: 869525000, PingSlotDataRate: 3, TXPower: 27}
 	case pb_lorawan.FrequencyPlan_US_902_928.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.US_902_928, false, lorawan.DwellTime400ms)
 	case pb_lorawan.FrequencyPlan_CN_779_787.String():
@@ -102,6 +121,7 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		frequencyPlan.Band, err = lora.GetConfig(lora.AS_923, false, lorawan.DwellTime400ms)
 	case pb_lorawan.FrequencyPlan_AS_920_923.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.AS_923, false, lorawan.DwellTime400ms)
+		frequencyPlan.DefaultChannels = len(frequencyPlan.UplinkChannels)
 		frequencyPlan.UplinkChannels = []lora.Channel{
 			lora.Channel{Frequency: 923200000, DataRates: []int{0, 1, 2, 3, 4, 5}},
 			lora.Channel{Frequency: 923400000, DataRates: []int{0, 1, 2, 3, 4, 5}},
@@ -118,6 +138,7 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		frequencyPlan.CFList = &lorawan.CFList{922200000, 922400000, 922600000, 922800000, 923000000}
 	case pb_lorawan.FrequencyPlan_AS_923_925.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.AS_923, false, lorawan.DwellTime400ms)
+		frequencyPlan.DefaultChannels = len(frequencyPlan.UplinkChannels)
 		frequencyPlan.UplinkChannels = []lora.Channel{
 			lora.Channel{Frequency: 923200000, DataRates: []int{0, 1, 2, 3, 4, 5}},
 			lora.Channel{Frequency: 923400000, DataRates: []int{0, 1, 2, 3, 4, 5}},
@@ -134,6 +155,7 @@ func Get(region string) (frequencyPlan FrequencyPlan, err error) {
 		frequencyPlan.CFList = &lorawan.CFList{923600000, 923800000, 924000000, 924200000, 924400000}
 	case pb_lorawan.FrequencyPlan_KR_920_923.String():
 		frequencyPlan.Band, err = lora.GetConfig(lora.KR_920_923, false, lorawan.DwellTimeNoLimit)
+		frequencyPlan.DefaultChannels = len(frequencyPlan.UplinkChannels)
 		// TTN frequency plan includes extra channels next to the default channels:
 		frequencyPlan.UplinkChannels = []lora.Channel{
 			lora.Channel{Frequency: 922100000, DataRates: []int{0, 1, 2, 3, 4, 5}},
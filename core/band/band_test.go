@@ -35,6 +35,8 @@ func TestGet(t *testing.T) {
 		a.So(err, ShouldBeNil)
 		a.So(fp.CFList, ShouldNotBeNil)
 		a.So(fp.ADR, ShouldNotBeNil)
+		a.So(fp.ClassB, ShouldNotBeNil)
+		a.So(fp.DefaultChannels, ShouldEqual, 3)
 	}
 
 	{
@@ -42,6 +44,8 @@ func TestGet(t *testing.T) {
 		a.So(err, ShouldBeNil)
 		a.So(fp.CFList, ShouldBeNil)
 		a.So(fp.ADR, ShouldBeNil)
+		a.So(fp.ClassB, ShouldBeNil)
+		a.So(fp.DefaultChannels, ShouldEqual, 0)
 	}
 
 	{
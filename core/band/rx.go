@@ -0,0 +1,24 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package band
+
+import "time"
+
+// RXSettings are the receive window settings of a device. The data rates are indexes in the data rates of the
+// frequency plan; the RX1 delay is in seconds.
+type RXSettings struct {
+	RX1DROffset  int
+	RX2DataRate  int
+	RX2Frequency int
+	RX1Delay     int
+}
+
+// DefaultRXSettings returns the receive window settings of the frequency plan
+func (f *FrequencyPlan) DefaultRXSettings() RXSettings {
+	return RXSettings{
+		RX2DataRate:  f.RX2DataRate,
+		RX2Frequency: f.RX2Frequency,
+		RX1Delay:     int(f.ReceiveDelay1 / time.Second),
+	}
+}
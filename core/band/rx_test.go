@@ -0,0 +1,17 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package band
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDefaultRXSettings(t *testing.T) {
+	a := New(t)
+	fp, err := Get("EU_863_870")
+	a.So(err, ShouldBeNil)
+	a.So(fp.DefaultRXSettings(), ShouldResemble, RXSettings{RX2DataRate: 0, RX2Frequency: 869525000, RX1Delay: 1})
+}
@@ -56,6 +56,8 @@ type broker struct {
 	*component.Component
 	routers                map[string]chan *pb.DownlinkMessage
 	routersLock            sync.RWMutex
+	gatewayRouters         map[string]string
+	gatewayRoutersLock     sync.RWMutex
 	handlers               map[string]*handler
 	handlersLock           sync.RWMutex
 	nsAddr                 string
@@ -177,6 +179,26 @@ func (b *broker) getRouter(id string) (chan<- *pb.DownlinkMessage, error) {
 	return nil, errors.NewErrInternal(fmt.Sprintf("Router %s not active", id))
 }
 
+// setGatewayRouter remembers the Router that a gateway is connected to, for downlink that is not a response to
+// an uplink
+func (b *broker) setGatewayRouter(gatewayID, routerID string) {
+	b.gatewayRoutersLock.Lock()
+	defer b.gatewayRoutersLock.Unlock()
+	if b.gatewayRouters == nil {
+		b.gatewayRouters = make(map[string]string)
+	}
+	b.gatewayRouters[gatewayID] = routerID
+}
+
+func (b *broker) getGatewayRouter(gatewayID string) (string, error) {
+	b.gatewayRoutersLock.RLock()
+	defer b.gatewayRoutersLock.RUnlock()
+	if routerID, ok := b.gatewayRouters[gatewayID]; ok {
+		return routerID, nil
+	}
+	return "", errors.NewErrNotFound(fmt.Sprintf("Router of gateway %s", gatewayID))
+}
+
 type handler struct {
 	conn   *grpc.ClientConn
 	uplink chan *pb.DeduplicatedUplinkMessage
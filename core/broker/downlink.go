@@ -44,9 +44,16 @@ func (b *broker) HandleDownlink(downlink *pb.DownlinkMessage) error {
 		return errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not handle downlink")
 	}
 
+	// The downlink options of uplinks are prefixed with the ID of the Router, downlink that is not a response to an
+	// uplink is sent to the Router that the gateway is connected to
 	var routerID string
 	if id := strings.Split(downlink.DownlinkOption.Identifier, ":"); len(id) == 2 {
 		routerID = id[0]
+	} else if downlink.DownlinkOption.GatewayID != "" {
+		routerID, err = b.getGatewayRouter(downlink.DownlinkOption.GatewayID)
+		if err != nil {
+			return err
+		}
 	} else {
 		return errors.NewErrInvalidArgument("DownlinkOption Identifier", "invalid format")
 	}
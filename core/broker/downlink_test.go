@@ -61,4 +61,25 @@ func TestDownlink(t *testing.T) {
 	})
 	a.So(err, ShouldBeNil)
 	a.So(len(dlch), ShouldEqual, 1)
+
+	// Downlink that is not a response to an uplink goes to the Router of the gateway
+	err = b.HandleDownlink(&pb.DownlinkMessage{
+		DevEUI: &devEUI,
+		AppEUI: &appEUI,
+		DownlinkOption: &pb.DownlinkOption{
+			GatewayID: "gatewayID",
+		},
+	})
+	a.So(err, ShouldNotBeNil)
+
+	b.setGatewayRouter("gatewayID", "routerID")
+	err = b.HandleDownlink(&pb.DownlinkMessage{
+		DevEUI: &devEUI,
+		AppEUI: &appEUI,
+		DownlinkOption: &pb.DownlinkOption{
+			GatewayID: "gatewayID",
+		},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(len(dlch), ShouldEqual, 2)
 }
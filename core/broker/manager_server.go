@@ -14,6 +14,7 @@ import (
 	"github.com/TheThingsNetwork/go-account-lib/claims"
 	"github.com/TheThingsNetwork/go-account-lib/rights"
 	"github.com/TheThingsNetwork/go-utils/grpc/ttnctx"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/api/ratelimit"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/gogo/protobuf/types"
@@ -23,10 +24,11 @@ import (
 )
 
 type brokerManager struct {
-	broker         *broker
-	deviceManager  pb_lorawan.DeviceManagerClient
-	devAddrManager pb_lorawan.DevAddrManagerClient
-	clientRate     *ratelimit.Registry
+	broker               *broker
+	deviceManager        pb_lorawan.DeviceManagerClient
+	devAddrManager       pb_lorawan.DevAddrManagerClient
+	networkServerManager pb_manager.NetworkServerManagerClient
+	clientRate           *ratelimit.Registry
 }
 
 func (b *brokerManager) validateClient(ctx context.Context) (*claims.Claims, error) {
@@ -76,6 +78,102 @@ func (b *brokerManager) DeleteDevice(ctx context.Context, in *lorawan.DeviceIden
 	return res, nil
 }
 
+func (b *brokerManager) GetDeviceClass(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceClass, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.GetDeviceClass(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not return device class")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) SetDeviceClass(ctx context.Context, in *pb_manager.NetworkServerDeviceClass) (*types.Empty, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.SetDeviceClass(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not set device class")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) GetRXSettings(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceRXSettings, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.GetRXSettings(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not return receive window settings")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) SetRXSettings(ctx context.Context, in *pb_manager.NetworkServerDeviceRXSettings) (*types.Empty, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.SetRXSettings(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not set receive window settings")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) GetChannels(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceChannels, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.GetChannels(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not return channels")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) SetChannels(ctx context.Context, in *pb_manager.NetworkServerDeviceChannels) (*types.Empty, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.SetChannels(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not set channels")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) GetDevStatus(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceStatus, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.GetDevStatus(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not return device status")
+	}
+	return res, nil
+}
+
+func (b *brokerManager) SetDevStatus(ctx context.Context, in *pb_manager.NetworkServerDeviceStatus) (*types.Empty, error) {
+	if _, err := b.validateClient(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	res, err := b.networkServerManager.SetDevStatus(ttnctx.OutgoingContextWithToken(ctx, token), in)
+	if err != nil {
+		return nil, errors.Wrap(errors.FromGRPCError(err), "NetworkServer did not set device status interval")
+	}
+	return res, nil
+}
+
 func (b *brokerManager) RegisterApplicationHandler(ctx context.Context, in *pb.ApplicationHandlerRegistration) (*types.Empty, error) {
 	claims, err := b.broker.Component.ValidateTTNAuthContext(ctx)
 	if err != nil {
@@ -133,9 +231,10 @@ func (b *brokerManager) GetStatus(ctx context.Context, in *pb.StatusRequest) (*p
 
 func (b *broker) RegisterManager(s *grpc.Server) {
 	server := &brokerManager{
-		broker:         b,
-		deviceManager:  pb_lorawan.NewDeviceManagerClient(b.nsConn),
-		devAddrManager: pb_lorawan.NewDevAddrManagerClient(b.nsConn),
+		broker:               b,
+		deviceManager:        pb_lorawan.NewDeviceManagerClient(b.nsConn),
+		devAddrManager:       pb_lorawan.NewDevAddrManagerClient(b.nsConn),
+		networkServerManager: pb_manager.NewNetworkServerManagerClient(b.nsConn),
 	}
 
 	server.clientRate = ratelimit.NewRegistry(5000, time.Hour)
@@ -143,4 +242,5 @@ func (b *broker) RegisterManager(s *grpc.Server) {
 	pb.RegisterBrokerManagerServer(s, server)
 	lorawan.RegisterDeviceManagerServer(s, server)
 	lorawan.RegisterDevAddrManagerServer(s, server)
+	pb_manager.RegisterNetworkServerManagerServer(s, server)
 }
@@ -47,6 +47,9 @@ func (b *brokerRPC) associateRouter(md metadata.MD) (chan *pb.UplinkMessage, <-c
 				b.broker.Ctx.WithField("RouterID", router.ID).WithField("Wait", waitTime).Warn("Router reached uplink rate limit")
 				time.Sleep(waitTime)
 			}
+			for _, gateway := range message.GatewayMetadata {
+				b.broker.setGatewayRouter(gateway.GatewayID, router.ID)
+			}
 			go b.broker.HandleUplink(message)
 		}
 	}()
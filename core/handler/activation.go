@@ -229,6 +229,8 @@ func (h *handler) HandleActivation(activation *pb_broker.DeduplicatedDeviceActiv
 	dev.DevAddr = types.DevAddr(joinAccept.DevAddr)
 	dev.AppSKey = appSKey
 	dev.NwkSKey = nwkSKey
+	dev.ConfirmedDownlinkPending = false
+	dev.FCntDown = 0
 	dev.UsedAppNonces = append(dev.UsedAppNonces, appNonce)
 	dev.UsedDevNonces = append(dev.UsedDevNonces, device.DevNonce(reqMAC.DevNonce))
 	err = h.devices.Set(dev)
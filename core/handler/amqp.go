@@ -4,126 +4,126 @@
 package handler
 
 import (
-	"sync"
-
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/amqp"
 	"github.com/TheThingsNetwork/ttn/core/types"
 )
 
-// AMQPBufferSize indicates the size for uplink channel buffers
-var AMQPBufferSize = 10
+type amqpIntegration struct {
+	username      string
+	password      string
+	host          string
+	exchange      string
+	downlinkQueue string
+
+	ctx        ttnlog.Interface
+	client     amqp.Client
+	publisher  amqp.Publisher
+	subscriber amqp.Subscriber
+}
+
+// NewAMQPIntegration returns a new Integration that publishes to the given AMQP exchange and consumes
+// downlink messages from the downlink queue. If the downlink queue is empty, a temporary queue is used.
+func NewAMQPIntegration(username, password, host, exchange, downlinkQueue string) Integration {
+	return &amqpIntegration{
+		username:      username,
+		password:      password,
+		host:          host,
+		exchange:      exchange,
+		downlinkQueue: downlinkQueue,
+	}
+}
 
-func (h *handler) assertAMQPExchange() error {
-	ch, err := h.amqpClient.(*amqp.DefaultClient).GetChannel()
+func (i *amqpIntegration) Name() string {
+	return "AMQP"
+}
+
+func (i *amqpIntegration) assertExchange() error {
+	ch, err := i.client.(*amqp.DefaultClient).GetChannel()
 	if err != nil {
 		return err
 	}
-	err = ch.ExchangeDeclarePassive(h.amqpExchange, "topic", true, false, false, false, nil)
+	err = ch.ExchangeDeclarePassive(i.exchange, "topic", true, false, false, false, nil)
 	if err != nil {
-		h.Ctx.Warnf("Could not assert presence of AMQP Exchange %s, trying to create...", h.amqpExchange)
-		ch, err := h.amqpClient.(*amqp.DefaultClient).GetChannel()
+		i.ctx.Warnf("Could not assert presence of AMQP Exchange %s, trying to create...", i.exchange)
+		ch, err := i.client.(*amqp.DefaultClient).GetChannel()
 		if err != nil {
 			return err
 		}
-		err = ch.ExchangeDeclare(h.amqpExchange, "topic", true, false, false, false, nil)
+		err = ch.ExchangeDeclare(i.exchange, "topic", true, false, false, false, nil)
 		if err != nil {
-			h.Ctx.Errorf("Could not create AMQP Exchange %s.", h.amqpExchange)
+			i.ctx.Errorf("Could not create AMQP Exchange %s.", i.exchange)
 			return err
 		}
-		h.Ctx.Infof("Created AMQP Exchange %s", h.amqpExchange)
+		i.ctx.Infof("Created AMQP Exchange %s", i.exchange)
 	}
 	return nil
 }
 
-func (h *handler) HandleAMQP(username, password, host, exchange, downlinkQueue string) error {
-	h.amqpClient = amqp.NewClient(h.Ctx, username, password, host)
+func (i *amqpIntegration) Connect(ctx ttnlog.Interface) (err error) {
+	i.ctx = ctx
+	i.client = amqp.NewClient(ctx, i.username, i.password, i.host)
 
-	err := h.amqpClient.Connect()
+	err = i.client.Connect()
 	if err != nil {
 		return err
 	}
 	defer func() {
 		if err != nil {
-			h.amqpClient.Disconnect()
+			i.client.Disconnect()
 		}
 	}()
 
-	if err := h.assertAMQPExchange(); err != nil {
+	if err := i.assertExchange(); err != nil {
 		return err
 	}
 
-	h.amqpUp = make(chan *types.UplinkMessage, AMQPBufferSize)
-	h.amqpEvent = make(chan *types.DeviceEvent, AMQPBufferSize)
-
-	subscriber := h.amqpClient.NewSubscriber(h.amqpExchange, downlinkQueue, downlinkQueue != "", downlinkQueue == "")
-	err = subscriber.Open()
+	i.publisher = i.client.NewPublisher(i.exchange)
+	err = i.publisher.Open()
 	if err != nil {
+		ctx.WithError(err).Error("Could not open publisher channel")
 		return err
 	}
-	defer func() {
-		if err != nil {
-			subscriber.Close()
-		}
-	}()
-	err = subscriber.SubscribeDownlink(func(_ amqp.Subscriber, _, _ string, req types.DownlinkMessage) {
-		h.EnqueueDownlink(&req)
-	})
+
+	return nil
+}
+
+func (i *amqpIntegration) Disconnect() {
+	if i.subscriber != nil {
+		i.subscriber.Close()
+	}
+	i.publisher.Close()
+	i.client.Disconnect()
+}
+
+func (i *amqpIntegration) Healthy() bool {
+	return i.client.IsConnected()
+}
+
+func (i *amqpIntegration) SubscribeDownlink(handler DownlinkHandler) error {
+	subscriber := i.client.NewSubscriber(i.exchange, i.downlinkQueue, i.downlinkQueue != "", i.downlinkQueue == "")
+	err := subscriber.Open()
 	if err != nil {
 		return err
 	}
-
-	ctx := h.Ctx.WithField("Protocol", "AMQP")
-	publisher := h.amqpClient.NewPublisher(h.amqpExchange)
-	err = publisher.Open()
+	err = subscriber.SubscribeDownlink(func(_ amqp.Subscriber, _, _ string, req types.DownlinkMessage) {
+		handler(&req)
+	})
 	if err != nil {
-		ctx.WithError(err).Error("Could not open publisher channel")
+		subscriber.Close()
 		return err
 	}
+	i.subscriber = subscriber
+	return nil
+}
 
-	var pubWait sync.WaitGroup
-	pubWait.Add(2)
-	defer func() {
-		go func() {
-			pubWait.Wait()
-			publisher.Close()
-		}()
-	}()
-
-	go func() {
-		defer pubWait.Done()
-		for up := range h.amqpUp {
-			ctx := ctx.WithFields(ttnlog.Fields{
-				"DevID": up.DevID,
-				"AppID": up.AppID,
-			})
-			ctx.Debug("Publish Uplink")
-			if err := publisher.PublishUplink(*up); err != nil {
-				ctx.WithError(err).Warn("Could not publish Uplink")
-			}
-		}
-	}()
-
-	go func() {
-		defer pubWait.Done()
-		for event := range h.amqpEvent {
-			ctx := ctx.WithFields(ttnlog.Fields{
-				"DevID": event.DevID,
-				"AppID": event.AppID,
-				"Event": event.Event,
-			})
-			ctx.Debug("Publish Event")
-			if event.DevID == "" {
-				if err := publisher.PublishAppEvent(event.AppID, event.Event, event.Data); err != nil {
-					ctx.WithError(err).Warn("Could not publish App Event")
-				}
-			} else {
-				if err := publisher.PublishDeviceEvent(event.AppID, event.DevID, event.Event, event.Data); err != nil {
-					ctx.WithError(err).Warn("Could not publish Device Event")
-				}
-			}
-		}
-	}()
+func (i *amqpIntegration) PublishUplink(up *types.UplinkMessage) error {
+	return i.publisher.PublishUplink(*up)
+}
 
-	return nil
+func (i *amqpIntegration) PublishEvent(event *types.DeviceEvent) error {
+	if event.DevID == "" {
+		return i.publisher.PublishAppEvent(event.AppID, event.Event, event.Data)
+	}
+	return i.publisher.PublishDeviceEvent(event.AppID, event.DevID, event.Event, event.Data)
 }
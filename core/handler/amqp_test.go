@@ -35,7 +35,6 @@ func TestHandleAMQP(t *testing.T) {
 		Component: &component.Component{Ctx: GetLogger(t, "TestHandleAMQP")},
 		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-handle-amqp"),
 	}
-	h.WithAMQP("guest", "guest", host, "amq.topic")
 	h.devices.Set(&device.Device{
 		AppID: appID,
 		DevID: devID,
@@ -43,8 +42,10 @@ func TestHandleAMQP(t *testing.T) {
 	defer func() {
 		h.devices.Delete(appID, devID)
 	}()
-	err = h.HandleAMQP("guest", "guest", host, "amq.topic", "")
+	i := &integration{Integration: NewAMQPIntegration("guest", "guest", host, "amq.topic", "")}
+	err = h.startIntegration(i)
 	a.So(err, ShouldBeNil)
+	defer i.Disconnect()
 
 	p := c.NewPublisher("amq.topic")
 	err = p.Open()
@@ -75,7 +76,7 @@ func TestHandleAMQP(t *testing.T) {
 		})
 		a.So(err, ShouldBeNil)
 
-		h.amqpUp <- &types.UplinkMessage{
+		i.up <- &types.UplinkMessage{
 			DevID:      devID,
 			AppID:      appID,
 			PayloadRaw: []byte{0xAA, 0xBC},
@@ -99,7 +100,7 @@ func TestHandleAMQP(t *testing.T) {
 		})
 		a.So(err, ShouldBeNil)
 
-		h.amqpEvent <- &types.DeviceEvent{
+		i.event <- &types.DeviceEvent{
 			DevID: devID,
 			AppID: appID,
 			Event: types.UplinkErrorEvent,
@@ -119,7 +120,7 @@ func TestHandleAMQP(t *testing.T) {
 		})
 		a.So(err, ShouldBeNil)
 
-		h.amqpEvent <- &types.DeviceEvent{
+		i.event <- &types.DeviceEvent{
 			AppID: appID,
 			Event: types.UplinkErrorEvent,
 		}
@@ -7,10 +7,11 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
 	"github.com/fatih/structs"
 )
 
-const currentDBVersion = "2.6.1"
+const currentDBVersion = "2.7.0"
 
 // PayloadFormat indicates how payload is binary formatted
 type PayloadFormat string
@@ -20,6 +21,8 @@ const (
 	PayloadFormatCustom PayloadFormat = "custom"
 	// PayloadFormatCayenneLPP indicates that the payload is formatted as CayenneLPP
 	PayloadFormatCayenneLPP PayloadFormat = "cayennelpp"
+	// PayloadFormatBinary indicates that the payload has a declarative binary format
+	PayloadFormatBinary PayloadFormat = "binary"
 )
 
 // Application contains the state of an application
@@ -45,9 +48,30 @@ type Application struct {
 	// Returns an object containing the converted values in []byte when the PayloadFormat is
 	// set to PayloadFormatCustom
 	CustomEncoder string `redis:"custom_encoder"`
+	// PortFunctions are custom payload functions for specific FPorts, that are used instead of
+	// the functions above when the PayloadFormat is set to PayloadFormatCustom
+	PortFunctions map[uint8]PortFunctions `redis:"port_functions"`
+	// FunctionSets are named sets of custom payload functions that devices can use instead of
+	// the functions of the application (see DevicePayloadFormat)
+	FunctionSets map[string]PortFunctions `redis:"function_sets"`
+	// BinaryFormat is the field layout of uplink and downlink messages when the PayloadFormat
+	// is set to PayloadFormatBinary
+	BinaryFormat *binaryformat.Format `redis:"binary_format"`
 
 	RegisterOnJoinAccessKey string `redis:"register_on_join_access_key"`
 
+	// Webhooks are the HTTP endpoints that uplink messages and events are posted to
+	Webhooks []Webhook `redis:"webhooks"`
+
+	// Groups are the named device groups of the application
+	Groups []DeviceGroup `redis:"groups"`
+
+	// Quotas limit the uplink and downlink traffic of the application and its devices
+	Quotas *Quotas `redis:"quotas"`
+
+	// Fragmentation configures the reassembly of fragmented uplink messages
+	Fragmentation *Fragmentation `redis:"fragmentation"`
+
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
 }
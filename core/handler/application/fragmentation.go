@@ -0,0 +1,51 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/fragment"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// DefaultFragmentTimeout is the time in which all fragments of a payload should be received if no timeout is set
+var DefaultFragmentTimeout = 10 * time.Minute
+
+// Fragmentation configures the reassembly of payloads that devices split over multiple uplink messages
+type Fragmentation struct {
+	// Port is the FPort of the uplink messages that contain fragments
+	Port uint8 `json:"port"`
+	// Header is the format of the fragment header (see the fragment package)
+	Header string `json:"header"`
+	// Fragments is the number of fragments of a payload, for headers that do not contain it
+	Fragments int `json:"fragments,omitempty"`
+	// Timeout is the time in which all fragments of a payload should be received, for example "5m"
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// Validate the fragmentation settings
+func (f Fragmentation) Validate() error {
+	if f.Port < 1 || f.Port > 223 {
+		return errors.NewErrInvalidArgument("Fragmentation Port", "must be between 1 and 223")
+	}
+	if err := fragment.ValidateHeader(f.Header, f.Fragments); err != nil {
+		return err
+	}
+	if f.Timeout != "" {
+		timeout, err := time.ParseDuration(f.Timeout)
+		if err != nil || timeout <= 0 {
+			return errors.NewErrInvalidArgument("Fragmentation Timeout", "must be a positive duration")
+		}
+	}
+	return nil
+}
+
+// GetTimeout returns the time in which all fragments of a payload should be received
+func (f Fragmentation) GetTimeout() time.Duration {
+	if timeout, err := time.ParseDuration(f.Timeout); err == nil && timeout > 0 {
+		return timeout
+	}
+	return DefaultFragmentTimeout
+}
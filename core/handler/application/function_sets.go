@@ -0,0 +1,71 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"strings"
+
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// FunctionSetPrefix is the prefix of device payload formats that refer to a function set of the application
+const FunctionSetPrefix = "custom:"
+
+// ParseDevicePayloadFormat parses the payload format of a device, which overrides the payload format of its
+// application. It is empty to use the payload format of the application, a built-in payload format, or
+// FunctionSetPrefix followed by the name of a function set of the application. It returns the payload format
+// and the name of the function set, if any.
+func ParseDevicePayloadFormat(format string) (PayloadFormat, string, error) {
+	if strings.HasPrefix(format, FunctionSetPrefix) {
+		name := strings.TrimPrefix(format, FunctionSetPrefix)
+		if err := api.NotEmptyAndValidID(name, "Function Set"); err != nil {
+			return "", "", err
+		}
+		return PayloadFormatCustom, name, nil
+	}
+	switch PayloadFormat(format) {
+	case "", PayloadFormatCustom, PayloadFormatCayenneLPP, PayloadFormatBinary:
+		return PayloadFormat(format), "", nil
+	}
+	return "", "", errors.NewErrInvalidArgument("Payload Format", "unknown payload format "+format)
+}
+
+// ValidateDevicePayloadFormat returns an error if the payload format can not be used by devices of the application
+func (a *Application) ValidateDevicePayloadFormat(format string) error {
+	payloadFormat, set, err := ParseDevicePayloadFormat(format)
+	if err != nil {
+		return err
+	}
+	if set != "" {
+		if _, ok := a.FunctionSets[set]; !ok {
+			return errors.NewErrNotFound("Function Set " + set)
+		}
+	}
+	if payloadFormat == PayloadFormatBinary && a.BinaryFormat == nil {
+		return errors.NewErrInvalidArgument("Payload Format", "application does not have a binary format")
+	}
+	return nil
+}
+
+// SetFunctionSet sets the named function set. If the functions are empty, the function set is removed.
+func (a *Application) SetFunctionSet(name string, functions PortFunctions) error {
+	if err := api.NotEmptyAndValidID(name, "Function Set"); err != nil {
+		return err
+	}
+	sets := make(map[string]PortFunctions, len(a.FunctionSets)+1)
+	for existingName, existing := range a.FunctionSets {
+		if existingName != name {
+			sets[existingName] = existing
+		}
+	}
+	if !functions.IsEmpty() {
+		sets[name] = functions
+	}
+	if len(sets) == 0 {
+		sets = nil
+	}
+	a.FunctionSets = sets
+	return nil
+}
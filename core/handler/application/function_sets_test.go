@@ -0,0 +1,66 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParseDevicePayloadFormat(t *testing.T) {
+	a := New(t)
+
+	for _, format := range []string{"", "custom", "cayennelpp", "binary"} {
+		parsed, set, err := ParseDevicePayloadFormat(format)
+		a.So(err, ShouldBeNil)
+		a.So(parsed, ShouldEqual, PayloadFormat(format))
+		a.So(set, ShouldBeEmpty)
+	}
+
+	parsed, set, err := ParseDevicePayloadFormat("custom:valve")
+	a.So(err, ShouldBeNil)
+	a.So(parsed, ShouldEqual, PayloadFormatCustom)
+	a.So(set, ShouldEqual, "valve")
+
+	for _, format := range []string{"unknown", "custom:", "custom:Not Valid"} {
+		_, _, err := ParseDevicePayloadFormat(format)
+		a.So(err, ShouldNotBeNil)
+	}
+}
+
+func TestFunctionSets(t *testing.T) {
+	a := New(t)
+
+	app := &Application{}
+	a.So(app.ValidateDevicePayloadFormat("cayennelpp"), ShouldBeNil)
+	a.So(app.ValidateDevicePayloadFormat("binary"), ShouldNotBeNil)
+	a.So(app.ValidateDevicePayloadFormat("custom:valve"), ShouldNotBeNil)
+
+	app.BinaryFormat = &binaryformat.Format{}
+	a.So(app.ValidateDevicePayloadFormat("binary"), ShouldBeNil)
+
+	a.So(app.SetFunctionSet("Not Valid", PortFunctions{Decoder: "decoder"}), ShouldNotBeNil)
+	a.So(app.SetFunctionSet("valve", PortFunctions{Decoder: "decoder"}), ShouldBeNil)
+	a.So(app.FunctionSets, ShouldHaveLength, 1)
+	a.So(app.ValidateDevicePayloadFormat("custom:valve"), ShouldBeNil)
+
+	a.So(app.SetFunctionSet("valve", PortFunctions{}), ShouldBeNil)
+	a.So(app.FunctionSets, ShouldBeNil)
+	a.So(app.ValidateDevicePayloadFormat("custom:valve"), ShouldNotBeNil)
+}
+
+func TestPayloadFunctionsDiffSets(t *testing.T) {
+	a := New(t)
+
+	old := PayloadFunctions{Sets: map[string]PortFunctions{
+		"meter": {Decoder: "a"},
+	}}
+	current := PayloadFunctions{Sets: map[string]PortFunctions{
+		"meter": {Decoder: "a"},
+		"valve": {Encoder: "b"},
+	}}
+	a.So(current.Diff(old), ShouldEqual, "--- set valve encoder\n+++ set valve encoder\n+b\n")
+}
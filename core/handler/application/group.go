@@ -0,0 +1,90 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// DeviceGroup is a named set of devices of an application. Devices are members if they are listed in Devices,
+// or if all attributes in Selector are set to the same values in their attributes.
+type DeviceGroup struct {
+	// ID of the group, unique within the application
+	ID string `json:"id"`
+	// Devices are the IDs of the devices that are explicitly added to the group
+	Devices []string `json:"devices,omitempty"`
+	// Selector selects the devices that have all of these attributes
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// Validate the device group
+func (g DeviceGroup) Validate() error {
+	if err := api.NotEmptyAndValidID(g.ID, "Group ID"); err != nil {
+		return err
+	}
+	if len(g.Devices) == 0 && len(g.Selector) == 0 {
+		return errors.NewErrInvalidArgument("Group", "neither devices nor selector set")
+	}
+	for _, devID := range g.Devices {
+		if err := api.NotEmptyAndValidID(devID, "Device ID"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Contains returns true if the device with the given ID and attributes is a member of the group
+func (g DeviceGroup) Contains(devID string, attributes map[string]string) bool {
+	for _, member := range g.Devices {
+		if member == devID {
+			return true
+		}
+	}
+	if len(g.Selector) == 0 {
+		return false
+	}
+	for key, value := range g.Selector {
+		if actual, ok := attributes[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// GetGroup returns the device group with the given ID, or nil if it does not exist
+func (a *Application) GetGroup(id string) *DeviceGroup {
+	for i, group := range a.Groups {
+		if group.ID == id {
+			return &a.Groups[i]
+		}
+	}
+	return nil
+}
+
+// SetGroup adds the device group to the application, replacing an existing group with the same ID
+func (a *Application) SetGroup(group DeviceGroup) {
+	groups := make([]DeviceGroup, 0, len(a.Groups)+1)
+	for _, existing := range a.Groups {
+		if existing.ID != group.ID {
+			groups = append(groups, existing)
+		}
+	}
+	a.Groups = append(groups, group)
+}
+
+// DeleteGroup removes the device group with the given ID from the application
+func (a *Application) DeleteGroup(id string) error {
+	groups := make([]DeviceGroup, 0, len(a.Groups))
+	for _, existing := range a.Groups {
+		if existing.ID != id {
+			groups = append(groups, existing)
+		}
+	}
+	if len(groups) == len(a.Groups) {
+		return errors.NewErrNotFound("Group " + id)
+	}
+	a.Groups = groups
+	return nil
+}
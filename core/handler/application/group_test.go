@@ -0,0 +1,54 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceGroupValidate(t *testing.T) {
+	a := New(t)
+
+	a.So(DeviceGroup{ID: "test", Devices: []string{"dev1"}}.Validate(), ShouldBeNil)
+	a.So(DeviceGroup{ID: "test", Selector: map[string]string{"floor": "1"}}.Validate(), ShouldBeNil)
+	a.So(DeviceGroup{Devices: []string{"dev1"}}.Validate(), ShouldNotBeNil)
+	a.So(DeviceGroup{ID: "test"}.Validate(), ShouldNotBeNil)
+	a.So(DeviceGroup{ID: "test", Devices: []string{"Dev 1"}}.Validate(), ShouldNotBeNil)
+}
+
+func TestDeviceGroupContains(t *testing.T) {
+	a := New(t)
+
+	group := DeviceGroup{ID: "test", Devices: []string{"dev1"}, Selector: map[string]string{"floor": "1", "type": "valve"}}
+	a.So(group.Contains("dev1", nil), ShouldBeTrue)
+	a.So(group.Contains("dev2", nil), ShouldBeFalse)
+	a.So(group.Contains("dev2", map[string]string{"floor": "1"}), ShouldBeFalse)
+	a.So(group.Contains("dev2", map[string]string{"floor": "1", "type": "valve", "other": "x"}), ShouldBeTrue)
+	a.So(group.Contains("dev2", map[string]string{"floor": "2", "type": "valve"}), ShouldBeFalse)
+
+	group.Selector = nil
+	a.So(group.Contains("dev2", map[string]string{"floor": "1"}), ShouldBeFalse)
+}
+
+func TestApplicationGroups(t *testing.T) {
+	a := New(t)
+	app := &Application{AppID: "test"}
+
+	app.SetGroup(DeviceGroup{ID: "one", Devices: []string{"dev1"}})
+	app.SetGroup(DeviceGroup{ID: "two", Devices: []string{"dev2"}})
+	a.So(app.Groups, ShouldHaveLength, 2)
+
+	app.StartUpdate()
+	app.SetGroup(DeviceGroup{ID: "one", Devices: []string{"dev3"}})
+	a.So(app.Groups, ShouldHaveLength, 2)
+	a.So(app.GetGroup("one").Devices, ShouldResemble, []string{"dev3"})
+	a.So(app.ChangedFields(), ShouldContain, "Groups")
+
+	a.So(app.DeleteGroup("one"), ShouldBeNil)
+	a.So(app.GetGroup("one"), ShouldBeNil)
+	a.So(app.DeleteGroup("one"), ShouldNotBeNil)
+	a.So(app.Groups, ShouldHaveLength, 1)
+}
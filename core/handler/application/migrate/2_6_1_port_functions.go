@@ -0,0 +1,21 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package migrate
+
+import (
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	redis "gopkg.in/redis.v5"
+)
+
+// AddPortFunctions migration from 2.6.1 to 2.7.0. Existing applications do not have functions per FPort, so
+// their custom functions remain the defaults for all ports.
+func AddPortFunctions(prefix string) storage.MigrateFunction {
+	return func(client *redis.Client, key string, obj map[string]string) (string, map[string]string, error) {
+		return "2.7.0", obj, nil
+	}
+}
+
+func init() {
+	applicationMigrations["2.6.1"] = AddPortFunctions
+}
@@ -0,0 +1,74 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+// PortFunctions are custom payload functions for a specific FPort or a named function set. Functions that are
+// empty are taken from the default functions of the application for FPorts, and are not used for function sets.
+type PortFunctions struct {
+	Decoder   string `json:"decoder,omitempty"`
+	Converter string `json:"converter,omitempty"`
+	Validator string `json:"validator,omitempty"`
+	Encoder   string `json:"encoder,omitempty"`
+}
+
+// IsEmpty returns true if no functions are set
+func (f PortFunctions) IsEmpty() bool {
+	return f == PortFunctions{}
+}
+
+// WithDefaults returns the functions, with the functions that are not set taken from defaults
+func (f PortFunctions) WithDefaults(defaults PortFunctions) PortFunctions {
+	if f.Decoder == "" {
+		f.Decoder = defaults.Decoder
+	}
+	if f.Converter == "" {
+		f.Converter = defaults.Converter
+	}
+	if f.Validator == "" {
+		f.Validator = defaults.Validator
+	}
+	if f.Encoder == "" {
+		f.Encoder = defaults.Encoder
+	}
+	return f
+}
+
+// DefaultFunctions returns the custom payload functions that are used for ports without their own functions
+func (a *Application) DefaultFunctions() PortFunctions {
+	return PortFunctions{
+		Decoder:   a.CustomDecoder,
+		Converter: a.CustomConverter,
+		Validator: a.CustomValidator,
+		Encoder:   a.CustomEncoder,
+	}
+}
+
+// FunctionsForPort returns the custom payload functions for the FPort
+func (a *Application) FunctionsForPort(port uint8) PortFunctions {
+	return a.PortFunctions[port].WithDefaults(a.DefaultFunctions())
+}
+
+// HasPortFunctions returns true if the application has its own functions for the FPort
+func (a *Application) HasPortFunctions(port uint8) bool {
+	_, ok := a.PortFunctions[port]
+	return ok
+}
+
+// SetPortFunctions sets the functions for the FPort. If the functions are empty, the functions for the port
+// are removed.
+func (a *Application) SetPortFunctions(port uint8, functions PortFunctions) {
+	ports := make(map[uint8]PortFunctions, len(a.PortFunctions)+1)
+	for existingPort, existing := range a.PortFunctions {
+		if existingPort != port {
+			ports[existingPort] = existing
+		}
+	}
+	if !functions.IsEmpty() {
+		ports[port] = functions
+	}
+	if len(ports) == 0 {
+		ports = nil
+	}
+	a.PortFunctions = ports
+}
@@ -0,0 +1,55 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPortFunctions(t *testing.T) {
+	a := New(t)
+
+	app := &Application{
+		CustomDecoder:   "default decoder",
+		CustomConverter: "default converter",
+	}
+
+	a.So(app.HasPortFunctions(1), ShouldBeFalse)
+	a.So(app.FunctionsForPort(1), ShouldResemble, app.DefaultFunctions())
+
+	app.SetPortFunctions(1, PortFunctions{Decoder: "port 1 decoder", Encoder: "port 1 encoder"})
+	a.So(app.HasPortFunctions(1), ShouldBeTrue)
+	a.So(app.HasPortFunctions(2), ShouldBeFalse)
+	a.So(app.FunctionsForPort(1), ShouldResemble, PortFunctions{
+		Decoder:   "port 1 decoder",
+		Converter: "default converter",
+		Encoder:   "port 1 encoder",
+	})
+	a.So(app.FunctionsForPort(2), ShouldResemble, app.DefaultFunctions())
+
+	app.SetPortFunctions(2, PortFunctions{Validator: "port 2 validator"})
+	a.So(app.PortFunctions, ShouldHaveLength, 2)
+
+	app.SetPortFunctions(1, PortFunctions{})
+	a.So(app.HasPortFunctions(1), ShouldBeFalse)
+	a.So(app.PortFunctions, ShouldHaveLength, 1)
+
+	app.SetPortFunctions(2, PortFunctions{})
+	a.So(app.PortFunctions, ShouldBeNil)
+}
+
+func TestPayloadFunctionsDiffPorts(t *testing.T) {
+	a := New(t)
+
+	old := PayloadFunctions{Decoder: "a"}
+	current := PayloadFunctions{Decoder: "a", Ports: map[uint8]PortFunctions{
+		3: {Decoder: "b"},
+	}}
+	a.So(current.Equal(old), ShouldBeFalse)
+	a.So(current.Diff(old), ShouldEqual, "--- port 3 decoder\n+++ port 3 decoder\n+b\n")
+	a.So(old.IsEmpty(), ShouldBeFalse)
+	a.So(PayloadFunctions{Ports: map[uint8]PortFunctions{}}.IsEmpty(), ShouldBeTrue)
+}
@@ -0,0 +1,41 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import "github.com/TheThingsNetwork/ttn/utils/errors"
+
+// QuotaLimits are the traffic limits of an application or device. Zero values are unlimited.
+type QuotaLimits struct {
+	// UplinksPerHour is the maximum number of uplink messages per hour
+	UplinksPerHour int64 `json:"uplinks_per_hour,omitempty"`
+	// DownlinksPerDay is the maximum number of downlink messages that can be enqueued per day
+	DownlinksPerDay int64 `json:"downlinks_per_day,omitempty"`
+	// DownlinkAirtimePerDay is the maximum total airtime of downlink messages per day, in milliseconds
+	DownlinkAirtimePerDay int64 `json:"downlink_airtime_per_day,omitempty"`
+}
+
+// Validate the quota limits
+func (q QuotaLimits) Validate() error {
+	if q.UplinksPerHour < 0 || q.DownlinksPerDay < 0 || q.DownlinkAirtimePerDay < 0 {
+		return errors.NewErrInvalidArgument("Quota", "limits can not be negative")
+	}
+	return nil
+}
+
+// Quotas are the traffic limits of an application, and of each of its devices
+type Quotas struct {
+	Application QuotaLimits `json:"application"`
+	Device      QuotaLimits `json:"device"`
+}
+
+// Validate the quotas
+func (q Quotas) Validate() error {
+	if err := q.Application.Validate(); err != nil {
+		return errors.Wrap(err, "Invalid application quota")
+	}
+	if err := q.Device.Validate(); err != nil {
+		return errors.Wrap(err, "Invalid device quota")
+	}
+	return nil
+}
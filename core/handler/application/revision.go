@@ -0,0 +1,335 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// PayloadFunctions are the payload format and functions of an application
+type PayloadFunctions struct {
+	PayloadFormat PayloadFormat `json:"payload_format,omitempty"`
+	Decoder       string        `json:"decoder,omitempty"`
+	Converter     string        `json:"converter,omitempty"`
+	Validator     string        `json:"validator,omitempty"`
+	Encoder       string        `json:"encoder,omitempty"`
+	// BinaryFormat is the JSON field layout of the binary payload format
+	BinaryFormat string `json:"binary_format,omitempty"`
+	// Ports are the functions for specific FPorts
+	Ports map[uint8]PortFunctions `json:"ports,omitempty"`
+	// Sets are the named function sets that devices can use
+	Sets map[string]PortFunctions `json:"sets,omitempty"`
+}
+
+// Equal returns true if the payload functions are equal to other
+func (f PayloadFunctions) Equal(other PayloadFunctions) bool {
+	return f.Diff(other) == ""
+}
+
+// IsEmpty returns true if no payload format or functions are set
+func (f PayloadFunctions) IsEmpty() bool {
+	return f.Equal(PayloadFunctions{})
+}
+
+// PayloadFunctions returns the payload format and functions of the application
+func (a *Application) PayloadFunctions() PayloadFunctions {
+	f := PayloadFunctions{
+		PayloadFormat: a.PayloadFormat,
+		Decoder:       a.CustomDecoder,
+		Converter:     a.CustomConverter,
+		Validator:     a.CustomValidator,
+		Encoder:       a.CustomEncoder,
+		Ports:         a.PortFunctions,
+		Sets:          a.FunctionSets,
+	}
+	if a.BinaryFormat != nil {
+		f.BinaryFormat = a.BinaryFormat.String()
+	}
+	return f
+}
+
+// SetPayloadFunctions sets the payload format and functions of the application
+func (a *Application) SetPayloadFunctions(f PayloadFunctions) error {
+	var format *binaryformat.Format
+	if f.BinaryFormat != "" {
+		var err error
+		format, err = binaryformat.Parse(f.BinaryFormat)
+		if err != nil {
+			return errors.Wrap(err, "Invalid Binary Format")
+		}
+	}
+	a.PayloadFormat = f.PayloadFormat
+	a.CustomDecoder = f.Decoder
+	a.CustomConverter = f.Converter
+	a.CustomValidator = f.Validator
+	a.CustomEncoder = f.Encoder
+	a.BinaryFormat = format
+	a.PortFunctions = f.Ports
+	a.FunctionSets = f.Sets
+	return nil
+}
+
+// Diff returns a line-based diff of the fields that changed since old, or an empty string if nothing changed
+func (f PayloadFunctions) Diff(old PayloadFunctions) string {
+	type diffField struct {
+		name     string
+		old, new string
+	}
+	fields := []diffField{
+		{"payload_format", string(old.PayloadFormat), string(f.PayloadFormat)},
+		{"decoder", old.Decoder, f.Decoder},
+		{"converter", old.Converter, f.Converter},
+		{"validator", old.Validator, f.Validator},
+		{"encoder", old.Encoder, f.Encoder},
+		{"binary_format", old.BinaryFormat, f.BinaryFormat},
+	}
+	for port := 1; port <= 255; port++ {
+		oldPort, newPort := old.Ports[uint8(port)], f.Ports[uint8(port)]
+		if oldPort == newPort {
+			continue
+		}
+		prefix := fmt.Sprintf("port %d ", port)
+		fields = append(fields,
+			diffField{prefix + "decoder", oldPort.Decoder, newPort.Decoder},
+			diffField{prefix + "converter", oldPort.Converter, newPort.Converter},
+			diffField{prefix + "validator", oldPort.Validator, newPort.Validator},
+			diffField{prefix + "encoder", oldPort.Encoder, newPort.Encoder},
+		)
+	}
+	var sets []string
+	for name := range old.Sets {
+		sets = append(sets, name)
+	}
+	for name := range f.Sets {
+		if _, ok := old.Sets[name]; !ok {
+			sets = append(sets, name)
+		}
+	}
+	sort.Strings(sets)
+	for _, name := range sets {
+		oldSet, newSet := old.Sets[name], f.Sets[name]
+		if oldSet == newSet {
+			continue
+		}
+		prefix := fmt.Sprintf("set %s ", name)
+		fields = append(fields,
+			diffField{prefix + "decoder", oldSet.Decoder, newSet.Decoder},
+			diffField{prefix + "converter", oldSet.Converter, newSet.Converter},
+			diffField{prefix + "validator", oldSet.Validator, newSet.Validator},
+			diffField{prefix + "encoder", oldSet.Encoder, newSet.Encoder},
+		)
+	}
+	var diff []string
+	for _, field := range fields {
+		if field.old == field.new {
+			continue
+		}
+		diff = append(diff, "--- "+field.name, "+++ "+field.name)
+		diff = append(diff, diffLines(field.old, field.new)...)
+	}
+	if len(diff) == 0 {
+		return ""
+	}
+	return strings.Join(diff, "\n") + "\n"
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the lines of a and b prefixed with "-" if they were removed, "+" if they were added and
+// " " if they were kept, based on the longest common subsequence of lines
+func diffLines(a, b string) []string {
+	aLines, bLines := splitLines(a), splitLines(b)
+	// lcs[i][j] is the length of the longest common subsequence of aLines[i:] and bLines[j:]
+	lcs := make([][]int, len(aLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bLines)+1)
+	}
+	for i := len(aLines) - 1; i >= 0; i-- {
+		for j := len(bLines) - 1; j >= 0; j-- {
+			switch {
+			case aLines[i] == bLines[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var diff []string
+	i, j := 0, 0
+	for i < len(aLines) && j < len(bLines) {
+		switch {
+		case aLines[i] == bLines[j]:
+			diff = append(diff, " "+aLines[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, "-"+aLines[i])
+			i++
+		default:
+			diff = append(diff, "+"+bLines[j])
+			j++
+		}
+	}
+	for ; i < len(aLines); i++ {
+		diff = append(diff, "-"+aLines[i])
+	}
+	for ; j < len(bLines); j++ {
+		diff = append(diff, "+"+bLines[j])
+	}
+	return diff
+}
+
+// Revision is a version of the payload functions of an application
+type Revision struct {
+	// Number is assigned by the RevisionStore, starting at 1
+	Number    int       `json:"number"`
+	Author    string    `json:"author,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// RollbackOf is the number of the revision that this revision restored
+	RollbackOf int              `json:"rollback_of,omitempty"`
+	Functions  PayloadFunctions `json:"functions"`
+	// Diff is the change since the previous revision; it is not stored, but filled when revisions are listed
+	Diff string `json:"diff,omitempty"`
+}
+
+// RevisionStore stores the revisions of the payload functions of applications
+type RevisionStore interface {
+	// Add a revision and assign its number
+	Add(appID string, revision *Revision) error
+	// List the revisions of an application, oldest first
+	List(appID string) ([]*Revision, error)
+	// Get a revision of an application
+	Get(appID string, number int) (*Revision, error)
+	// Remove a revision of an application
+	Remove(appID string, number int) error
+	// Delete all revisions of an application
+	Delete(appID string) error
+}
+
+const redisRevisionPrefix = "payload-functions"
+
+// DefaultMaxRevisions is the number of revisions that are kept per application
+var DefaultMaxRevisions = 100
+
+// NewRedisRevisionStore creates a new Redis-based revision store
+func NewRedisRevisionStore(client *redis.Client, prefix string) *RedisRevisionStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	return &RedisRevisionStore{
+		client: client,
+		prefix: prefix + ":" + redisRevisionPrefix,
+	}
+}
+
+// RedisRevisionStore stores the revisions in Redis.
+// - Revisions are stored as JSON in a List per application, of which the last DefaultMaxRevisions are kept
+// - The last revision number is stored in a separate key per application
+type RedisRevisionStore struct {
+	client *redis.Client
+	prefix string
+}
+
+func (s *RedisRevisionStore) key(appID string) string {
+	return fmt.Sprintf("%s:%s", s.prefix, appID)
+}
+
+func (s *RedisRevisionStore) numberKey(appID string) string {
+	return fmt.Sprintf("%s:%s:number", s.prefix, appID)
+}
+
+// Add a revision
+func (s *RedisRevisionStore) Add(appID string, revision *Revision) error {
+	number, err := s.client.Incr(s.numberKey(appID)).Result()
+	if err != nil {
+		return err
+	}
+	revision.Number = int(number)
+	diff := revision.Diff
+	revision.Diff = ""
+	data, err := json.Marshal(revision)
+	revision.Diff = diff
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Pipelined(func(pipe *redis.Pipeline) error {
+		pipe.RPush(s.key(appID), string(data))
+		pipe.LTrim(s.key(appID), int64(-DefaultMaxRevisions), -1)
+		return nil
+	})
+	return err
+}
+
+// List the revisions of an application
+func (s *RedisRevisionStore) List(appID string) ([]*Revision, error) {
+	res, err := s.client.LRange(s.key(appID), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*Revision, 0, len(res))
+	for _, data := range res {
+		revision := new(Revision)
+		if err := json.Unmarshal([]byte(data), revision); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// Get a revision of an application
+func (s *RedisRevisionStore) Get(appID string, number int) (*Revision, error) {
+	revisions, err := s.List(appID)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		if revision.Number == number {
+			return revision, nil
+		}
+	}
+	return nil, errors.NewErrNotFound(fmt.Sprintf("Revision %d", number))
+}
+
+// Remove a revision of an application
+func (s *RedisRevisionStore) Remove(appID string, number int) error {
+	res, err := s.client.LRange(s.key(appID), 0, -1).Result()
+	if err != nil {
+		return err
+	}
+	for _, data := range res {
+		revision := new(Revision)
+		if err := json.Unmarshal([]byte(data), revision); err != nil || revision.Number != number {
+			continue
+		}
+		removed, err := s.client.LRem(s.key(appID), 1, data).Result()
+		if err != nil {
+			return err
+		}
+		if removed > 0 {
+			return nil
+		}
+	}
+	return errors.NewErrNotFound(fmt.Sprintf("Revision %d", number))
+}
+
+// Delete all revisions of an application
+func (s *RedisRevisionStore) Delete(appID string) error {
+	return s.client.Del(s.key(appID), s.numberKey(appID)).Err()
+}
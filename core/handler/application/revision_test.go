@@ -0,0 +1,117 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPayloadFunctions(t *testing.T) {
+	a := New(t)
+
+	app := &Application{
+		PayloadFormat: PayloadFormatCustom,
+		CustomDecoder: "function Decoder(bytes) { return {}; }",
+	}
+	functions := app.PayloadFunctions()
+	a.So(functions.Decoder, ShouldEqual, app.CustomDecoder)
+
+	a.So(app.SetPayloadFunctions(PayloadFunctions{BinaryFormat: "not json"}), ShouldNotBeNil)
+	a.So(app.CustomDecoder, ShouldEqual, functions.Decoder)
+
+	a.So(app.SetPayloadFunctions(PayloadFunctions{Encoder: "function Encoder(object) { return []; }"}), ShouldBeNil)
+	a.So(app.CustomDecoder, ShouldBeEmpty)
+	a.So(app.CustomEncoder, ShouldNotBeEmpty)
+
+	a.So(app.SetPayloadFunctions(functions), ShouldBeNil)
+	a.So(app.PayloadFunctions(), ShouldResemble, functions)
+}
+
+func TestPayloadFunctionsDiff(t *testing.T) {
+	a := New(t)
+
+	old := PayloadFunctions{
+		PayloadFormat: PayloadFormatCustom,
+		Decoder:       "function Decoder(bytes) {\n  var temp = bytes[0];\n  return {temp: temp};\n}",
+	}
+	a.So(old.Diff(old), ShouldBeEmpty)
+
+	new := old
+	new.Decoder = "function Decoder(bytes) {\n  var temp = bytes[0] / 10;\n  return {temp: temp};\n}"
+	a.So(new.Diff(old), ShouldEqual, `--- decoder
++++ decoder
+ function Decoder(bytes) {
+-  var temp = bytes[0];
++  var temp = bytes[0] / 10;
+   return {temp: temp};
+ }
+`)
+
+	a.So(old.Diff(PayloadFunctions{}), ShouldEqual, `--- payload_format
++++ payload_format
++custom
+--- decoder
++++ decoder
++function Decoder(bytes) {
++  var temp = bytes[0];
++  return {temp: temp};
++}
+`)
+}
+
+func TestRevisionStore(t *testing.T) {
+	a := New(t)
+
+	NewRedisRevisionStore(GetRedisClient(), "")
+
+	s := NewRedisRevisionStore(GetRedisClient(), "handler-test-revision-store")
+	appID := "AppID-1"
+	defer s.Delete(appID)
+
+	revisions, err := s.List(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldBeEmpty)
+
+	_, err = s.Get(appID, 1)
+	a.So(err, ShouldNotBeNil)
+
+	defer func(max int) { DefaultMaxRevisions = max }(DefaultMaxRevisions)
+	DefaultMaxRevisions = 2
+
+	for i, decoder := range []string{"v1", "v2", "v3"} {
+		revision := &Revision{Author: "alice", CreatedAt: time.Now(), Functions: PayloadFunctions{Decoder: decoder}, Diff: "diff"}
+		a.So(s.Add(appID, revision), ShouldBeNil)
+		a.So(revision.Number, ShouldEqual, i+1)
+		a.So(revision.Diff, ShouldEqual, "diff")
+	}
+
+	revisions, err = s.List(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldHaveLength, 2)
+	a.So(revisions[0].Number, ShouldEqual, 2)
+	a.So(revisions[1].Number, ShouldEqual, 3)
+	a.So(revisions[1].Diff, ShouldBeEmpty)
+
+	revision, err := s.Get(appID, 3)
+	a.So(err, ShouldBeNil)
+	a.So(revision.Author, ShouldEqual, "alice")
+	a.So(revision.Functions.Decoder, ShouldEqual, "v3")
+
+	_, err = s.Get(appID, 1)
+	a.So(err, ShouldNotBeNil)
+
+	a.So(s.Remove(appID, 3), ShouldBeNil)
+	a.So(s.Remove(appID, 3), ShouldNotBeNil)
+	revisions, _ = s.List(appID)
+	a.So(revisions, ShouldHaveLength, 1)
+	a.So(revisions[0].Number, ShouldEqual, 2)
+
+	a.So(s.Delete(appID), ShouldBeNil)
+	revisions, _ = s.List(appID)
+	a.So(revisions, ShouldBeEmpty)
+}
@@ -0,0 +1,144 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// WebhookAllowedNetworks are the internal networks that webhooks are allowed to post to anyway. Webhooks are not
+// allowed to post to loopback, link-local, private and other internal addresses, unless the operator of the
+// Handler allows them here.
+var WebhookAllowedNetworks []*net.IPNet
+
+// internalNetworks are the networks that are not reachable from the internet, or that reach the Handler itself
+var internalNetworks = mustParseNetworks(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16",
+	"::/128", "::1/128", "fc00::/7", "fe80::/10",
+)
+
+func mustParseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// WebhookAddressAllowed returns false if webhooks are not allowed to post to the IP address, because it is an
+// internal or multicast address that is not in WebhookAllowedNetworks
+func WebhookAddressAllowed(ip net.IP) bool {
+	for _, network := range WebhookAllowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	if ip.IsMulticast() {
+		return false
+	}
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// webhookHostAllowed returns false if the host of a webhook URL is an internal address or localhost
+func webhookHostAllowed(host string) bool {
+	host = strings.ToLower(host)
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return WebhookAddressAllowed(net.IPv4(127, 0, 0, 1))
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return WebhookAddressAllowed(ip)
+	}
+	return true
+}
+
+// Webhook is an HTTP endpoint that receives the uplink messages and events of an application
+type Webhook struct {
+	// ID of the webhook, unique within the application
+	ID string `json:"id"`
+	// UplinkURL is the URL that uplink messages are posted to. Uplink messages are not posted if empty.
+	UplinkURL string `json:"uplink_url,omitempty"`
+	// EventURL is the URL that device and application events are posted to. Events are not posted if empty.
+	EventURL string `json:"event_url,omitempty"`
+	// Headers are added to each request, for example for authentication
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Validate the webhook. Host names that resolve to internal addresses are refused when the webhook is used.
+func (w Webhook) Validate() error {
+	if err := api.NotEmptyAndValidID(w.ID, "Webhook ID"); err != nil {
+		return err
+	}
+	if w.UplinkURL == "" && w.EventURL == "" {
+		return errors.NewErrInvalidArgument("Webhook", "neither uplink URL nor event URL set")
+	}
+	for _, u := range []string{w.UplinkURL, w.EventURL} {
+		if u == "" {
+			continue
+		}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return errors.NewErrInvalidArgument("Webhook URL", err.Error())
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return errors.NewErrInvalidArgument("Webhook URL", "scheme must be http or https")
+		}
+		if parsed.Host == "" {
+			return errors.NewErrInvalidArgument("Webhook URL", "host is empty")
+		}
+		if !webhookHostAllowed(parsed.Hostname()) {
+			return errors.NewErrInvalidArgument("Webhook URL", "host is an internal address")
+		}
+	}
+	return nil
+}
+
+// GetWebhook returns the webhook with the given ID, or nil if it does not exist
+func (a *Application) GetWebhook(id string) *Webhook {
+	for i, webhook := range a.Webhooks {
+		if webhook.ID == id {
+			return &a.Webhooks[i]
+		}
+	}
+	return nil
+}
+
+// SetWebhook adds the webhook to the application, replacing an existing webhook with the same ID
+func (a *Application) SetWebhook(webhook Webhook) {
+	webhooks := make([]Webhook, 0, len(a.Webhooks)+1)
+	for _, existing := range a.Webhooks {
+		if existing.ID != webhook.ID {
+			webhooks = append(webhooks, existing)
+		}
+	}
+	a.Webhooks = append(webhooks, webhook)
+}
+
+// DeleteWebhook removes the webhook with the given ID from the application
+func (a *Application) DeleteWebhook(id string) error {
+	webhooks := make([]Webhook, 0, len(a.Webhooks))
+	for _, existing := range a.Webhooks {
+		if existing.ID != id {
+			webhooks = append(webhooks, existing)
+		}
+	}
+	if len(webhooks) == len(a.Webhooks) {
+		return errors.NewErrNotFound("Webhook " + id)
+	}
+	a.Webhooks = webhooks
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package application
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestWebhookValidate(t *testing.T) {
+	a := New(t)
+
+	a.So(Webhook{ID: "test", UplinkURL: "https://example.com/up"}.Validate(), ShouldBeNil)
+	a.So(Webhook{ID: "test", EventURL: "http://example.com/events"}.Validate(), ShouldBeNil)
+	a.So(Webhook{UplinkURL: "https://example.com/up"}.Validate(), ShouldNotBeNil)
+	a.So(Webhook{ID: "test"}.Validate(), ShouldNotBeNil)
+	a.So(Webhook{ID: "test", UplinkURL: "ftp://example.com/up"}.Validate(), ShouldNotBeNil)
+	a.So(Webhook{ID: "test", UplinkURL: "https:///up"}.Validate(), ShouldNotBeNil)
+
+	// Internal addresses are refused
+	for _, u := range []string{
+		"http://localhost/up",
+		"http://127.0.0.1:8080/up",
+		"http://10.1.2.3/up",
+		"http://172.16.0.1/up",
+		"http://192.168.1.1/up",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0/up",
+		"http://[::1]/up",
+		"http://[fd00::1]/up",
+		"http://[fe80::1]/up",
+		"http://[::ffff:127.0.0.1]/up",
+	} {
+		a.So(Webhook{ID: "test", UplinkURL: u}.Validate(), ShouldNotBeNil)
+	}
+	a.So(Webhook{ID: "test", UplinkURL: "http://8.8.8.8/up"}.Validate(), ShouldBeNil)
+	a.So(Webhook{ID: "test", UplinkURL: "http://[2001:4860:4860::8888]/up"}.Validate(), ShouldBeNil)
+
+	// Unless the operator allows them
+	defer func(allowed []*net.IPNet) { WebhookAllowedNetworks = allowed }(WebhookAllowedNetworks)
+	_, private, _ := net.ParseCIDR("10.0.0.0/8")
+	WebhookAllowedNetworks = []*net.IPNet{private}
+	a.So(Webhook{ID: "test", UplinkURL: "http://10.1.2.3/up"}.Validate(), ShouldBeNil)
+	a.So(Webhook{ID: "test", UplinkURL: "http://192.168.1.1/up"}.Validate(), ShouldNotBeNil)
+}
+
+func TestApplicationWebhooks(t *testing.T) {
+	a := New(t)
+	app := &Application{AppID: "test"}
+
+	app.SetWebhook(Webhook{ID: "one", UplinkURL: "https://example.com/one"})
+	app.SetWebhook(Webhook{ID: "two", UplinkURL: "https://example.com/two"})
+	a.So(app.Webhooks, ShouldHaveLength, 2)
+
+	app.StartUpdate()
+	app.SetWebhook(Webhook{ID: "one", UplinkURL: "https://example.com/new"})
+	a.So(app.Webhooks, ShouldHaveLength, 2)
+	a.So(app.GetWebhook("one").UplinkURL, ShouldEqual, "https://example.com/new")
+	a.So(app.ChangedFields(), ShouldContain, "Webhooks")
+
+	a.So(app.DeleteWebhook("one"), ShouldBeNil)
+	a.So(app.GetWebhook("one"), ShouldBeNil)
+	a.So(app.DeleteWebhook("one"), ShouldNotBeNil)
+	a.So(app.Webhooks, ShouldHaveLength, 1)
+}
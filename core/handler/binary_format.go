@@ -0,0 +1,65 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// setBinaryFormat sets the binary format of the application, and records a revision. A nil format removes the
+// binary format, which is not possible while the application uses the binary payload format.
+func (h *handler) setBinaryFormat(appID string, format *binaryformat.Format, author string) error {
+	return h.updateFunctions(appID, author, func(app *application.Application) error {
+		if format == nil && app.PayloadFormat == application.PayloadFormatBinary {
+			return errors.NewErrInvalidArgument("Binary Format", "can not be removed while the application uses the binary payload format")
+		}
+		app.BinaryFormat = format
+		return nil
+	})
+}
+
+func (h *handlerManager) GetBinaryFormat(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.BinaryFormat, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.BinaryFormat{AppID: in.AppID}
+	if app.BinaryFormat != nil {
+		res.Format = app.BinaryFormat.String()
+	}
+	return res, nil
+}
+
+func (h *handlerManager) SetBinaryFormat(ctx context.Context, in *pb_manager.BinaryFormat) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	_, claims, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	var format *binaryformat.Format
+	if in.Format != "" {
+		format, err = binaryformat.Parse(in.Format)
+		if err != nil {
+			return nil, errors.Wrap(err, "Invalid Binary Format")
+		}
+	}
+	if err := h.handler.setBinaryFormat(in.AppID, format, revisionAuthor(claims)); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestSetBinaryFormat(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	h := &handler{
+		Component:         &component.Component{Ctx: GetLogger(t, "TestSetBinaryFormat")},
+		applications:      application.NewRedisApplicationStore(GetRedisClient(), "handler-test-binary-format"),
+		functionRevisions: application.NewRedisRevisionStore(GetRedisClient(), "handler-test-binary-format"),
+		scripts:           functions.NewCache(),
+	}
+	defer h.functionRevisions.Delete(appID)
+	h.applications.Set(&application.Application{AppID: appID})
+	defer h.applications.Delete(appID)
+
+	format, err := binaryformat.Parse(`{"uplink":{"1":[{"name":"on","offset":0,"width":1}]}}`)
+	a.So(err, ShouldBeNil)
+	a.So(h.setBinaryFormat(appID, format, "alice"), ShouldBeNil)
+
+	app, _ := h.applications.Get(appID)
+	a.So(app.BinaryFormat, ShouldResemble, format)
+	a.So(app.CustomDecoder, ShouldBeEmpty)
+	revisions, err := h.listRevisions(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldHaveLength, 2)
+	a.So(revisions[1].Functions.BinaryFormat, ShouldEqual, format.String())
+
+	// The binary format can not be removed while it is used
+	app.StartUpdate()
+	app.PayloadFormat = application.PayloadFormatBinary
+	h.applications.Set(app)
+	a.So(h.setBinaryFormat(appID, nil, "alice"), ShouldNotBeNil)
+}
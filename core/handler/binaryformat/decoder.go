@@ -0,0 +1,78 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package binaryformat
+
+import (
+	"fmt"
+
+	pb_handler "github.com/TheThingsNetwork/api/handler"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Decoder is a PayloadDecoder that decodes payload using the uplink layouts of a Format
+type Decoder struct {
+	Format *Format
+}
+
+// Decode decodes the payload to fields. If there is no layout for the FPort, no fields are returned.
+func (d *Decoder) Decode(payload []byte, fPort uint8) (map[string]interface{}, bool, error) {
+	if d.Format == nil {
+		return nil, true, nil
+	}
+	layout, ok := d.Format.Uplink[fPort]
+	if !ok {
+		return nil, true, nil
+	}
+	if len(payload) < layout.Size() {
+		return nil, false, errors.NewErrInvalidArgument("Payload", fmt.Sprintf("expected at least %d bytes, got %d", layout.Size(), len(payload)))
+	}
+	fields := make(map[string]interface{})
+	for _, field := range layout {
+		value, err := field.decode(payload)
+		if err != nil {
+			return nil, false, err
+		}
+		fields[field.Name] = value
+	}
+	return fields, true, nil
+}
+
+// Log returns the log
+func (d *Decoder) Log() []*pb_handler.LogEntry {
+	return nil
+}
+
+func (f Field) decode(payload []byte) (interface{}, error) {
+	raw := (f.read(payload) >> uint(f.BitOffset)) & f.mask()
+
+	var value int64
+	if f.Signed {
+		// Sign-extend the raw value
+		shift := 64 - f.bits()
+		value = int64(raw<<shift) >> shift
+	} else {
+		value = int64(raw)
+	}
+
+	if len(f.Enum) > 0 {
+		for name, enum := range f.Enum {
+			if enum == value {
+				return name, nil
+			}
+		}
+		return nil, errors.NewErrInvalidArgument(fmt.Sprintf("Field %s", f.Name), fmt.Sprintf("unknown enum value %d", value))
+	}
+
+	if f.Scale != 0 || f.ValueOffset != 0 {
+		if f.Signed {
+			return float64(value)*f.scale() + f.ValueOffset, nil
+		}
+		return float64(raw)*f.scale() + f.ValueOffset, nil
+	}
+
+	if f.Signed {
+		return value, nil
+	}
+	return raw, nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package binaryformat
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+var testFormat = &Format{
+	Uplink: map[uint8]Layout{
+		1: {
+			{Name: "temperature", Offset: 0, Width: 2, Signed: true, Scale: 0.01},
+			{Name: "humidity", Offset: 2, Width: 1, Scale: 0.5},
+			{Name: "counter", Offset: 3, Width: 2, Endian: LittleEndian},
+			{Name: "battery", Offset: 5, Width: 1, Bits: 4},
+			{Name: "mode", Offset: 5, Width: 1, BitOffset: 4, Bits: 2, Enum: map[string]int64{"off": 0, "eco": 1, "boost": 2}},
+			{Name: "delta", Offset: 5, Width: 1, BitOffset: 6, Bits: 2, Signed: true},
+		},
+	},
+	Downlink: map[uint8]Layout{
+		2: {
+			{Name: "setpoint", Offset: 0, Width: 2, Signed: true, Scale: 0.1, ValueOffset: 10},
+			{Name: "mode", Offset: 2, Width: 1, Bits: 2, Enum: map[string]int64{"off": 0, "eco": 1, "boost": 2}},
+			{Name: "led", Offset: 2, Width: 1, BitOffset: 7, Bits: 1},
+			{Name: "interval", Offset: 3, Width: 2, Endian: LittleEndian},
+		},
+	},
+}
+
+func TestDecode(t *testing.T) {
+	a := New(t)
+
+	decoder := &Decoder{Format: testFormat}
+
+	fields, valid, err := decoder.Decode([]byte{0xF7, 0x9E, 0x55, 0x34, 0x12, 0xE9}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields["temperature"], ShouldAlmostEqual, -21.46)
+	a.So(fields["humidity"], ShouldEqual, 42.5)
+	a.So(fields["counter"], ShouldEqual, 0x1234)
+	a.So(fields["battery"], ShouldEqual, 9)
+	a.So(fields["mode"], ShouldEqual, "boost")
+	a.So(fields["delta"], ShouldEqual, -1)
+
+	// Too short
+	_, _, err = decoder.Decode([]byte{0xF7, 0x9E}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	// Unknown enum value
+	_, _, err = decoder.Decode([]byte{0xF7, 0x9E, 0x55, 0x34, 0x12, 0x39}, 1)
+	a.So(err, ShouldNotBeNil)
+
+	// No layout for port
+	fields, valid, err = decoder.Decode([]byte{0x01}, 2)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldBeNil)
+}
@@ -0,0 +1,93 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package binaryformat
+
+import (
+	"fmt"
+	"math"
+
+	pb_handler "github.com/TheThingsNetwork/api/handler"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Encoder is a PayloadEncoder that encodes fields using the downlink layouts of a Format
+type Encoder struct {
+	Format *Format
+}
+
+// Encode encodes the fields to payload. All fields of the layout for the FPort are required.
+func (e *Encoder) Encode(fields map[string]interface{}, fPort uint8) ([]byte, bool, error) {
+	if e.Format == nil {
+		return nil, false, errors.NewErrInvalidArgument("Downlink Payload", "fields supplied, but no binary format set")
+	}
+	layout, ok := e.Format.Downlink[fPort]
+	if !ok {
+		return nil, false, errors.NewErrInvalidArgument("Downlink Payload", fmt.Sprintf("no binary format for port %d", fPort))
+	}
+	payload := make([]byte, layout.Size())
+	for _, field := range layout {
+		value, ok := fields[field.Name]
+		if !ok {
+			return nil, false, errors.NewErrInvalidArgument("Downlink Payload", fmt.Sprintf("missing field %s", field.Name))
+		}
+		raw, err := field.encode(value)
+		if err != nil {
+			return nil, false, err
+		}
+		field.write(payload, raw<<uint(field.BitOffset))
+	}
+	return payload, true, nil
+}
+
+// Log returns the log
+func (e *Encoder) Log() []*pb_handler.LogEntry {
+	return nil
+}
+
+func (f Field) encode(value interface{}) (uint64, error) {
+	arg := fmt.Sprintf("Field %s", f.Name)
+
+	var number float64
+	switch v := value.(type) {
+	case string:
+		enum, ok := f.Enum[v]
+		if !ok {
+			return 0, errors.NewErrInvalidArgument(arg, fmt.Sprintf("unknown enum value %s", v))
+		}
+		number = float64(enum)
+	case bool:
+		if v {
+			number = 1
+		}
+	case float64:
+		number = (v - f.ValueOffset) / f.scale()
+	case float32:
+		number = (float64(v) - f.ValueOffset) / f.scale()
+	case int:
+		number = (float64(v) - f.ValueOffset) / f.scale()
+	case int64:
+		number = (float64(v) - f.ValueOffset) / f.scale()
+	case uint64:
+		number = (float64(v) - f.ValueOffset) / f.scale()
+	default:
+		return 0, errors.NewErrInvalidArgument(arg, "should be a number, boolean or enum value")
+	}
+	number = math.Floor(number + 0.5)
+
+	bits := f.bits()
+	var min, max float64
+	if f.Signed {
+		min, max = -math.Pow(2, float64(bits-1)), math.Pow(2, float64(bits-1))-1
+	} else {
+		min, max = 0, math.Pow(2, float64(bits))-1
+	}
+	if number < min || number > max {
+		return 0, errors.NewErrInvalidArgument(arg, fmt.Sprintf("value does not fit in %d bits", bits))
+	}
+
+	if f.Signed {
+		return uint64(int64(number)) & f.mask(), nil
+	}
+	return uint64(number) & f.mask(), nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package binaryformat
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestEncode(t *testing.T) {
+	a := New(t)
+
+	encoder := &Encoder{Format: testFormat}
+
+	payload, valid, err := encoder.Encode(map[string]interface{}{
+		"setpoint": 21.5,
+		"mode":     "eco",
+		"led":      true,
+		"interval": 600.0,
+	}, 2)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(payload, ShouldResemble, []byte{0x00, 0x73, 0x81, 0x58, 0x02})
+
+	// Missing field
+	_, _, err = encoder.Encode(map[string]interface{}{
+		"setpoint": 21.5,
+	}, 2)
+	a.So(err, ShouldNotBeNil)
+
+	// Out of range
+	_, _, err = encoder.Encode(map[string]interface{}{
+		"setpoint": 21.5,
+		"mode":     "eco",
+		"led":      2,
+		"interval": 600.0,
+	}, 2)
+	a.So(err, ShouldNotBeNil)
+
+	// Unknown enum value
+	_, _, err = encoder.Encode(map[string]interface{}{
+		"setpoint": 21.5,
+		"mode":     "turbo",
+		"led":      true,
+		"interval": 600.0,
+	}, 2)
+	a.So(err, ShouldNotBeNil)
+
+	// No layout for port
+	_, _, err = encoder.Encode(map[string]interface{}{}, 1)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestEncodeDecode(t *testing.T) {
+	a := New(t)
+
+	format := &Format{
+		Uplink: map[uint8]Layout{
+			1: {
+				{Name: "a", Offset: 0, Width: 3, Signed: true},
+				{Name: "b", Offset: 3, Width: 1, Bits: 3, Signed: true},
+			},
+		},
+	}
+	format.Downlink = format.Uplink
+
+	fields := map[string]interface{}{"a": -100000.0, "b": -4.0}
+	payload, _, err := (&Encoder{Format: format}).Encode(fields, 1)
+	a.So(err, ShouldBeNil)
+
+	decoded, _, err := (&Decoder{Format: format}).Decode(payload, 1)
+	a.So(err, ShouldBeNil)
+	a.So(decoded["a"], ShouldEqual, -100000)
+	a.So(decoded["b"], ShouldEqual, -4)
+}
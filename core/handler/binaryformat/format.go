@@ -0,0 +1,189 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package binaryformat
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Endianness of a field
+const (
+	BigEndian    = "big"
+	LittleEndian = "little"
+)
+
+// Format is a declarative binary payload format, with a field layout per FPort for uplink and downlink
+type Format struct {
+	Uplink   map[uint8]Layout `json:"uplink,omitempty"`
+	Downlink map[uint8]Layout `json:"downlink,omitempty"`
+}
+
+// Layout is the list of fields in a payload
+type Layout []Field
+
+// Field is a numeric field in a payload. The raw value is read from Width bytes at Offset. If Bits is set,
+// the value is taken from Bits bits starting at BitOffset (counted from the least significant bit). The value
+// of the field is Enum name of the raw value, or the raw value multiplied by Scale plus ValueOffset.
+type Field struct {
+	Name        string           `json:"name"`
+	Offset      int              `json:"offset"`
+	Width       int              `json:"width"`
+	Signed      bool             `json:"signed,omitempty"`
+	Endian      string           `json:"endian,omitempty"`
+	BitOffset   int              `json:"bit_offset,omitempty"`
+	Bits        int              `json:"bits,omitempty"`
+	Scale       float64          `json:"scale,omitempty"`
+	ValueOffset float64          `json:"value_offset,omitempty"`
+	Enum        map[string]int64 `json:"enum,omitempty"`
+}
+
+// Parse parses and validates the JSON representation of a Format
+func Parse(data string) (*Format, error) {
+	format := new(Format)
+	if err := json.Unmarshal([]byte(data), format); err != nil {
+		return nil, errors.NewErrInvalidArgument("Binary Format", err.Error())
+	}
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	return format, nil
+}
+
+// String returns the JSON representation of the Format
+func (f Format) String() string {
+	data, _ := json.Marshal(f)
+	return string(data)
+}
+
+// Validate the Format
+func (f Format) Validate() error {
+	for port, layout := range f.Uplink {
+		if err := layout.Validate(); err != nil {
+			return errors.Wrapf(err, "Invalid uplink layout for port %d", port)
+		}
+	}
+	for port, layout := range f.Downlink {
+		if err := layout.Validate(); err != nil {
+			return errors.Wrapf(err, "Invalid downlink layout for port %d", port)
+		}
+	}
+	return nil
+}
+
+// Validate the Layout
+func (l Layout) Validate() error {
+	names := make(map[string]bool)
+	for _, field := range l {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+		if names[field.Name] {
+			return errors.NewErrInvalidArgument(fmt.Sprintf("Field %s", field.Name), "duplicate name")
+		}
+		names[field.Name] = true
+	}
+	return nil
+}
+
+// Size returns the number of bytes that are needed for the fields in the Layout
+func (l Layout) Size() int {
+	var size int
+	for _, field := range l {
+		if end := field.Offset + field.Width; end > size {
+			size = end
+		}
+	}
+	return size
+}
+
+// Validate the Field
+func (f Field) Validate() error {
+	if f.Name == "" {
+		return errors.NewErrInvalidArgument("Field", "name can not be empty")
+	}
+	arg := fmt.Sprintf("Field %s", f.Name)
+	if f.Offset < 0 {
+		return errors.NewErrInvalidArgument(arg, "offset can not be negative")
+	}
+	if f.Width < 1 || f.Width > 8 {
+		return errors.NewErrInvalidArgument(arg, "width should be between 1 and 8 bytes")
+	}
+	switch f.Endian {
+	case "", BigEndian, LittleEndian:
+	default:
+		return errors.NewErrInvalidArgument(arg, fmt.Sprintf("unknown endianness %s", f.Endian))
+	}
+	if f.BitOffset < 0 || f.Bits < 0 || f.BitOffset+f.Bits > f.Width*8 {
+		return errors.NewErrInvalidArgument(arg, "bits do not fit in width")
+	}
+	if f.BitOffset != 0 && f.Bits == 0 {
+		return errors.NewErrInvalidArgument(arg, "bit offset set without bits")
+	}
+	if len(f.Enum) > 0 && (f.Scale != 0 || f.ValueOffset != 0) {
+		return errors.NewErrInvalidArgument(arg, "enum can not be combined with scale or value offset")
+	}
+	values := make(map[int64]string, len(f.Enum))
+	for name, value := range f.Enum {
+		if other, ok := values[value]; ok {
+			return errors.NewErrInvalidArgument(arg, fmt.Sprintf("enum names %s and %s have the same value %d", other, name, value))
+		}
+		values[value] = name
+	}
+	return nil
+}
+
+// bits returns the number of bits of the raw value
+func (f Field) bits() uint {
+	if f.Bits != 0 {
+		return uint(f.Bits)
+	}
+	return uint(f.Width * 8)
+}
+
+// scale returns the scale of the value, which defaults to 1
+func (f Field) scale() float64 {
+	if f.Scale == 0 {
+		return 1
+	}
+	return f.Scale
+}
+
+// read reads the unsigned integer of the field from the payload
+func (f Field) read(payload []byte) uint64 {
+	var raw uint64
+	for i := 0; i < f.Width; i++ {
+		b := payload[f.Offset+i]
+		if f.Endian == LittleEndian {
+			raw |= uint64(b) << uint(8*i)
+		} else {
+			raw = raw<<8 | uint64(b)
+		}
+	}
+	return raw
+}
+
+// write ORs the unsigned integer of the field into the payload
+func (f Field) write(payload []byte, raw uint64) {
+	for i := 0; i < f.Width; i++ {
+		var shift uint
+		if f.Endian == LittleEndian {
+			shift = uint(8 * i)
+		} else {
+			shift = uint(8 * (f.Width - 1 - i))
+		}
+		payload[f.Offset+i] |= byte(raw >> shift)
+	}
+}
+
+// mask returns the mask for the raw value
+func (f Field) mask() uint64 {
+	bits := f.bits()
+	if bits == 64 {
+		return ^uint64(0)
+	}
+	return 1<<bits - 1
+}
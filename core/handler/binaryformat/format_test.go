@@ -0,0 +1,46 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package binaryformat
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParse(t *testing.T) {
+	a := New(t)
+
+	format, err := Parse(`{"uplink":{"1":[{"name":"temperature","offset":0,"width":2,"signed":true,"scale":0.01}]}}`)
+	a.So(err, ShouldBeNil)
+	a.So(format.Uplink[1], ShouldHaveLength, 1)
+	a.So(format.Uplink[1][0].Scale, ShouldEqual, 0.01)
+
+	parsed, err := Parse(format.String())
+	a.So(err, ShouldBeNil)
+	a.So(parsed, ShouldResemble, format)
+
+	_, err = Parse(`{"uplink":`)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestFieldValidate(t *testing.T) {
+	a := New(t)
+
+	a.So(Field{Name: "a", Width: 1}.Validate(), ShouldBeNil)
+	a.So(Field{Name: "a", Width: 2, BitOffset: 4, Bits: 12}.Validate(), ShouldBeNil)
+	a.So(Field{Width: 1}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 0}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 9}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 1, Offset: -1}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 1, Endian: "middle"}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 1, BitOffset: 4, Bits: 5}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 1, BitOffset: 4}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 1, Scale: 2, Enum: map[string]int64{"on": 1}}.Validate(), ShouldNotBeNil)
+	a.So(Field{Name: "a", Width: 1, Enum: map[string]int64{"off": 0, "on": 1}}.Validate(), ShouldBeNil)
+	a.So(Field{Name: "a", Width: 1, Enum: map[string]int64{"on": 1, "enabled": 1}}.Validate(), ShouldNotBeNil)
+
+	a.So(Layout{{Name: "a", Width: 1}, {Name: "a", Offset: 1, Width: 1}}.Validate(), ShouldNotBeNil)
+	a.So(Layout{{Name: "a", Width: 1}, {Name: "b", Offset: 2, Width: 2}}.Size(), ShouldEqual, 4)
+}
@@ -4,10 +4,10 @@
 package cayennelpp
 
 import (
-	"bytes"
+	"fmt"
 
 	pb_handler "github.com/TheThingsNetwork/api/handler"
-	protocol "github.com/TheThingsNetwork/go-cayenne-lib/cayennelpp"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
 // Decoder is a CayenneLPP PayloadDecoder
@@ -15,77 +15,43 @@ type Decoder struct {
 	result map[string]interface{}
 }
 
-// Decodes decodes the CayenneLPP payload to fields
+// Decode decodes the CayenneLPP payload to fields. Channels that occur multiple times in the payload
+// are decoded to an array of values.
 func (d *Decoder) Decode(payload []byte, fPort uint8) (map[string]interface{}, bool, error) {
-	decoder := protocol.NewDecoder(bytes.NewBuffer(payload))
 	d.result = make(map[string]interface{})
-	if err := decoder.DecodeUplink(d); err != nil {
-		return nil, false, err
+	for len(payload) > 0 {
+		if len(payload) < 2 {
+			return nil, false, errors.NewErrInvalidArgument("Payload", "unexpected end of payload")
+		}
+		channel, id := payload[0], payload[1]
+		t, ok := dataTypes[id]
+		if !ok {
+			return nil, false, errors.NewErrInvalidArgument("Payload", fmt.Sprintf("unknown data type %d on channel %d", id, channel))
+		}
+		payload = payload[2:]
+		if len(payload) < t.length() {
+			return nil, false, errors.NewErrInvalidArgument("Payload", fmt.Sprintf("expected %d bytes for %s on channel %d, got %d", t.length(), t.key, channel, len(payload)))
+		}
+		d.add(formatName(t.key, channel), t.decode(payload[:t.length()]))
+		payload = payload[t.length():]
 	}
 	return d.result, true, nil
 }
 
-// Log returns the log
-func (d *Decoder) Log() []*pb_handler.LogEntry {
-	return nil
-}
-
-func (d *Decoder) DigitalInput(channel, value uint8) {
-	d.result[formatName(digitalInputKey, channel)] = value
-}
-
-func (d *Decoder) DigitalOutput(channel, value uint8) {
-	d.result[formatName(digitalOutputKey, channel)] = value
-}
-
-func (d *Decoder) AnalogInput(channel uint8, value float32) {
-	d.result[formatName(analogInputKey, channel)] = value
-}
-
-func (d *Decoder) AnalogOutput(channel uint8, value float32) {
-	d.result[formatName(analogOutputKey, channel)] = value
-}
-
-func (d *Decoder) Luminosity(channel uint8, value uint16) {
-	d.result[formatName(luminosityKey, channel)] = value
-}
-
-func (d *Decoder) Presence(channel, value uint8) {
-	d.result[formatName(presenceKey, channel)] = value
-}
-
-func (d *Decoder) Temperature(channel uint8, celcius float32) {
-	d.result[formatName(temperatureKey, channel)] = celcius
-}
-
-func (d *Decoder) RelativeHumidity(channel uint8, rh float32) {
-	d.result[formatName(relativeHumidityKey, channel)] = rh
-}
-
-func (d *Decoder) Accelerometer(channel uint8, x, y, z float32) {
-	d.result[formatName(accelerometerKey, channel)] = map[string]float32{
-		"x": x,
-		"y": y,
-		"z": z,
+func (d *Decoder) add(name string, value interface{}) {
+	existing, ok := d.result[name]
+	if !ok {
+		d.result[name] = value
+		return
 	}
-}
-
-func (d *Decoder) BarometricPressure(channel uint8, hpa float32) {
-	d.result[formatName(barometricPressureKey, channel)] = hpa
-}
-
-func (d *Decoder) Gyrometer(channel uint8, x, y, z float32) {
-	d.result[formatName(gyrometerKey, channel)] = map[string]float32{
-		"x": x,
-		"y": y,
-		"z": z,
+	if values, ok := existing.([]interface{}); ok {
+		d.result[name] = append(values, value)
+		return
 	}
+	d.result[name] = []interface{}{existing, value}
 }
 
-func (d *Decoder) GPS(channel uint8, latitude, longitude, altitude float32) {
-	d.result[formatName(gpsKey, channel)] = map[string]float32{
-		"latitude":  latitude,
-		"longitude": longitude,
-		"altitude":  altitude,
-	}
+// Log returns the log
+func (d *Decoder) Log() []*pb_handler.LogEntry {
+	return nil
 }
@@ -58,3 +58,88 @@ func TestDecode(t *testing.T) {
 		"altitude":  21.54,
 	})
 }
+
+func TestDecodeExtended(t *testing.T) {
+	a := New(t)
+
+	buf := []byte{
+		1, 133, 89, 104, 47, 0,
+		2, 116, 1, 74,
+		3, 117, 3, 232,
+		4, 118, 0, 0, 3, 232,
+		5, 120, 75,
+		6, 121, 255, 156,
+		7, 122, 0, 48, 57,
+		8, 128, 1, 244,
+		9, 130, 0, 0, 48, 57,
+		10, 131, 0, 0, 4, 210,
+		11, 132, 1, 14,
+		12, 142, 1,
+		13, 125, 1, 144,
+		14, 135, 255, 128, 0,
+	}
+
+	decoder := new(Decoder)
+	fields, valid, err := decoder.Decode(buf, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldHaveLength, 14)
+	a.So(fields["unix_time_1"], ShouldEqual, uint32(1500000000))
+	a.So(fields["voltage_2"], ShouldEqual, float32(3.3))
+	a.So(fields["current_3"], ShouldEqual, float32(1))
+	a.So(fields["frequency_4"], ShouldEqual, uint32(1000))
+	a.So(fields["percentage_5"], ShouldEqual, uint8(75))
+	a.So(fields["altitude_6"], ShouldEqual, int16(-100))
+	a.So(fields["load_7"], ShouldEqual, float32(12.345))
+	a.So(fields["power_8"], ShouldEqual, uint16(500))
+	a.So(fields["distance_9"], ShouldEqual, float32(12.345))
+	a.So(fields["energy_10"], ShouldEqual, float32(1.234))
+	a.So(fields["direction_11"], ShouldEqual, uint16(270))
+	a.So(fields["switch_12"], ShouldEqual, uint8(1))
+	a.So(fields["concentration_13"], ShouldEqual, uint16(400))
+	a.So(fields["colour_14"], ShouldResemble, map[string]interface{}{
+		"r": uint8(255),
+		"g": uint8(128),
+		"b": uint8(0),
+	})
+}
+
+func TestDecodeRepeated(t *testing.T) {
+	a := New(t)
+
+	buf := []byte{
+		1, protocol.Temperature, 0, 215,
+		2, protocol.DigitalInput, 1,
+		1, protocol.Temperature, 0, 220,
+		1, protocol.Temperature, 0, 225,
+	}
+
+	decoder := new(Decoder)
+	fields, valid, err := decoder.Decode(buf, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(fields, ShouldHaveLength, 2)
+	a.So(fields["temperature_1"], ShouldResemble, []interface{}{float32(21.5), float32(22), float32(22.5)})
+	a.So(fields["digital_in_2"], ShouldEqual, uint8(1))
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	a := New(t)
+
+	decoder := new(Decoder)
+
+	// Unknown data type
+	_, valid, err := decoder.Decode([]byte{1, 99, 0}, 1)
+	a.So(err, ShouldNotBeNil)
+	a.So(valid, ShouldBeFalse)
+
+	// Truncated value
+	_, valid, err = decoder.Decode([]byte{1, protocol.Temperature, 0}, 1)
+	a.So(err, ShouldNotBeNil)
+	a.So(valid, ShouldBeFalse)
+
+	// Missing data type
+	_, valid, err = decoder.Decode([]byte{1}, 1)
+	a.So(err, ShouldNotBeNil)
+	a.So(valid, ShouldBeFalse)
+}
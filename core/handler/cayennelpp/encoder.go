@@ -4,32 +4,57 @@
 package cayennelpp
 
 import (
+	"sort"
+
 	pb_handler "github.com/TheThingsNetwork/api/handler"
-	protocol "github.com/TheThingsNetwork/go-cayenne-lib/cayennelpp"
 )
 
 // Encoder is a CayenneLPP PayloadEncoder
 type Encoder struct {
 }
 
-// Encode encodes the fields to CayenneLPP
+// Encode encodes the fields to CayenneLPP. Fields with a value key are encoded as a channel with a
+// signed value (0.01), other known keys are encoded with their data type. Arrays of values are encoded
+// as repeated channels. Unknown fields and invalid values are ignored.
 func (e *Encoder) Encode(fields map[string]interface{}, fPort uint8) ([]byte, bool, error) {
-	encoder := protocol.NewEncoder()
-	for name, value := range fields {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	payload := make([]byte, 0)
+	for _, name := range names {
 		key, channel, err := parseName(name)
 		if err != nil {
 			continue
 		}
-		switch key {
-		case valueKey:
-			if val, ok := value.(float64); ok {
-				encoder.AddPort(channel, float32(val))
+		values, ok := fields[name].([]interface{})
+		if !ok {
+			values = []interface{}{fields[name]}
+		}
+		for _, value := range values {
+			if key == valueKey {
+				if data, ok := valueType.encodeValue(value, valueType.divisor); ok {
+					payload = append(append(payload, channel), data...)
+				}
+				continue
+			}
+			id, ok := dataTypeIDs[key]
+			if !ok {
+				continue
+			}
+			if data, ok := dataTypes[id].encode(value); ok {
+				payload = append(append(payload, channel, id), data...)
 			}
 		}
 	}
-	return encoder.Bytes(), true, nil
+	return payload, true, nil
 }
 
+// valueType is the type of value fields, which have no data type in the payload
+var valueType = dataType{key: valueKey, size: 2, signed: true, divisor: 100}
+
 // Log returns the log
 func (e *Encoder) Log() []*pb_handler.LogEntry {
 	return nil
@@ -41,3 +41,39 @@ func TestEncode(t *testing.T) {
 		a.So(payload, ShouldBeEmpty)
 	}
 }
+
+func TestEncodeTypes(t *testing.T) {
+	a := New(t)
+
+	encoder := new(Encoder)
+
+	fields := map[string]interface{}{
+		"digital_out_1": float64(1),
+		"voltage_2":     float64(3.3),
+		"altitude_3":    float64(-100),
+		"colour_4": map[string]interface{}{
+			"r": float64(255),
+			"g": float64(128),
+			"b": float64(0),
+		},
+		"switch_5":      []interface{}{true, false},
+		"temperature_6": float64(10000), // Does not fit
+	}
+	payload, valid, err := encoder.Encode(fields, 1)
+	a.So(err, ShouldBeNil)
+	a.So(valid, ShouldBeTrue)
+	a.So(payload, ShouldResemble, []byte{
+		3, 121, 255, 156,
+		4, 135, 255, 128, 0,
+		1, 1, 1,
+		5, 142, 1,
+		5, 142, 0,
+		2, 116, 1, 74,
+	})
+
+	decoder := new(Decoder)
+	decoded, _, err := decoder.Decode(payload, 1)
+	a.So(err, ShouldBeNil)
+	a.So(decoded["switch_5"], ShouldResemble, []interface{}{uint8(1), uint8(0)})
+	a.So(decoded["voltage_2"], ShouldEqual, float32(3.3))
+}
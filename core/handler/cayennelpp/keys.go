@@ -24,6 +24,20 @@ const (
 	barometricPressureKey = "barometric_pressure"
 	gyrometerKey          = "gyrometer"
 	gpsKey                = "gps"
+	unixTimeKey           = "unix_time"
+	voltageKey            = "voltage"
+	currentKey            = "current"
+	frequencyKey          = "frequency"
+	percentageKey         = "percentage"
+	altitudeKey           = "altitude"
+	loadKey               = "load"
+	powerKey              = "power"
+	distanceKey           = "distance"
+	energyKey             = "energy"
+	directionKey          = "direction"
+	switchKey             = "switch"
+	concentrationKey      = "concentration"
+	colourKey             = "colour"
 )
 
 func formatName(key string, channel uint8) string {
@@ -0,0 +1,217 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cayennelpp
+
+import (
+	"math"
+)
+
+// dataType is a Cayenne LPP data type. Each value is size bytes (big endian) and, if divisor is set,
+// the value is divided by divisor. Types with names consist of multiple values with these names; divisors
+// overrides the divisor per value.
+type dataType struct {
+	key      string
+	size     int
+	signed   bool
+	divisor  float32
+	names    []string
+	divisors []float32
+}
+
+// dataTypes contains the IPSO data types and the extended data types that are commonly used in Cayenne LPP
+var dataTypes = map[uint8]dataType{
+	0:   {key: digitalInputKey, size: 1},
+	1:   {key: digitalOutputKey, size: 1},
+	2:   {key: analogInputKey, size: 2, signed: true, divisor: 100},
+	3:   {key: analogOutputKey, size: 2, signed: true, divisor: 100},
+	101: {key: luminosityKey, size: 2},
+	102: {key: presenceKey, size: 1},
+	103: {key: temperatureKey, size: 2, signed: true, divisor: 10},
+	104: {key: relativeHumidityKey, size: 1, divisor: 2},
+	113: {key: accelerometerKey, size: 2, signed: true, divisor: 1000, names: []string{"x", "y", "z"}},
+	115: {key: barometricPressureKey, size: 2, divisor: 10},
+	116: {key: voltageKey, size: 2, divisor: 100},
+	117: {key: currentKey, size: 2, divisor: 1000},
+	118: {key: frequencyKey, size: 4},
+	120: {key: percentageKey, size: 1},
+	121: {key: altitudeKey, size: 2, signed: true},
+	122: {key: loadKey, size: 3, signed: true, divisor: 1000},
+	125: {key: concentrationKey, size: 2},
+	128: {key: powerKey, size: 2},
+	130: {key: distanceKey, size: 4, divisor: 1000},
+	131: {key: energyKey, size: 4, divisor: 1000},
+	132: {key: directionKey, size: 2},
+	133: {key: unixTimeKey, size: 4},
+	134: {key: gyrometerKey, size: 2, signed: true, divisor: 100, names: []string{"x", "y", "z"}},
+	135: {key: colourKey, size: 1, names: []string{"r", "g", "b"}},
+	136: {key: gpsKey, size: 3, signed: true, divisor: 10000, names: []string{"latitude", "longitude", "altitude"}, divisors: []float32{10000, 10000, 100}},
+	142: {key: switchKey, size: 1},
+}
+
+// dataTypeIDs contains the IDs of the dataTypes by key
+var dataTypeIDs = make(map[string]uint8)
+
+func init() {
+	for id, t := range dataTypes {
+		dataTypeIDs[t.key] = id
+	}
+}
+
+// length returns the number of bytes of the data
+func (t dataType) length() int {
+	if len(t.names) > 0 {
+		return t.size * len(t.names)
+	}
+	return t.size
+}
+
+// decode decodes the data, which should have the length of the data type
+func (t dataType) decode(data []byte) interface{} {
+	if len(t.names) == 0 {
+		return t.decodeValue(data, t.divisor)
+	}
+	if t.divisor != 0 {
+		values := make(map[string]float32, len(t.names))
+		for i, name := range t.names {
+			values[name] = t.decodeValue(data[i*t.size:], t.divisorOf(i)).(float32)
+		}
+		return values
+	}
+	values := make(map[string]interface{}, len(t.names))
+	for i, name := range t.names {
+		values[name] = t.decodeValue(data[i*t.size:], t.divisor)
+	}
+	return values
+}
+
+// divisorOf returns the divisor of the i-th value
+func (t dataType) divisorOf(i int) float32 {
+	if i < len(t.divisors) {
+		return t.divisors[i]
+	}
+	return t.divisor
+}
+
+func (t dataType) decodeValue(data []byte, divisor float32) interface{} {
+	var raw uint64
+	for i := 0; i < t.size; i++ {
+		raw = raw<<8 | uint64(data[i])
+	}
+	if t.signed {
+		shift := uint(64 - 8*t.size)
+		value := int64(raw<<shift) >> shift
+		if divisor != 0 {
+			return float32(value) / divisor
+		}
+		switch t.size {
+		case 1:
+			return int8(value)
+		case 2:
+			return int16(value)
+		default:
+			return int32(value)
+		}
+	}
+	if divisor != 0 {
+		return float32(raw) / divisor
+	}
+	switch t.size {
+	case 1:
+		return uint8(raw)
+	case 2:
+		return uint16(raw)
+	default:
+		return uint32(raw)
+	}
+}
+
+// encode encodes the value to data. It returns false if the value can not be encoded
+func (t dataType) encode(value interface{}) ([]byte, bool) {
+	if len(t.names) == 0 {
+		return t.encodeValue(value, t.divisor)
+	}
+	var values map[string]interface{}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		values = v
+	case map[string]float32:
+		values = make(map[string]interface{}, len(v))
+		for name, value := range v {
+			values[name] = value
+		}
+	default:
+		return nil, false
+	}
+	data := make([]byte, 0, t.length())
+	for i, name := range t.names {
+		encoded, ok := t.encodeValue(values[name], t.divisorOf(i))
+		if !ok {
+			return nil, false
+		}
+		data = append(data, encoded...)
+	}
+	return data, true
+}
+
+func (t dataType) encodeValue(value interface{}, divisor float32) ([]byte, bool) {
+	number, ok := toFloat(value)
+	if !ok {
+		return nil, false
+	}
+	if divisor != 0 {
+		number *= float64(divisor)
+	}
+	number = math.Floor(number + 0.5)
+
+	bits := float64(8 * t.size)
+	var min, max float64
+	if t.signed {
+		min, max = -math.Pow(2, bits-1), math.Pow(2, bits-1)-1
+	} else {
+		min, max = 0, math.Pow(2, bits)-1
+	}
+	if number < min || number > max {
+		return nil, false
+	}
+
+	raw := uint64(int64(number))
+	data := make([]byte, t.size)
+	for i := range data {
+		data[i] = byte(raw >> uint(8*(t.size-1-i)))
+	}
+	return data, true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
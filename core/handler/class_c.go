@@ -0,0 +1,179 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	"github.com/TheThingsNetwork/go-utils/grpc/ttnctx"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// lockDevice serializes the changes to the pending downlink of a device, which are made by uplinks, enqueued
+// downlinks and downlink that is sent without uplink. It returns the function that unlocks the device.
+func (h *handler) lockDevice(appID, devID string) (unlock func()) {
+	return h.deviceLocks.Lock(appID + ":" + devID)
+}
+
+// classCDownlinkTemplate builds the template for downlink that is not a response to an uplink. The downlink
+// option has no identifier, so that the NetworkServer sends it through the gateway that last received an uplink
+// from the device: on RX2 for Class C devices, or in the next ping slot for Class B devices. The template has no
+// FCnt from the NetworkServer, so the payload is encrypted with the FCnt that the Handler expects it to use.
+func classCDownlinkTemplate(dev *device.Device) (*pb_broker.DownlinkMessage, error) {
+	downlink := &pb_broker.DownlinkMessage{
+		Message:        new(pb_protocol.Message),
+		AppEUI:         &dev.AppEUI,
+		DevEUI:         &dev.DevEUI,
+		AppID:          dev.AppID,
+		DevID:          dev.DevID,
+		DownlinkOption: new(pb_broker.DownlinkOption),
+	}
+	lorawanDownlinkMsg := downlink.Message.InitLoRaWAN()
+	lorawanDownlinkMAC := lorawanDownlinkMsg.InitDownlink()
+	lorawanDownlinkMAC.DevAddr = dev.DevAddr
+	lorawanDownlinkMAC.FCnt = dev.FCntDown
+	var err error
+	downlink.Payload, err = lorawanDownlinkMsg.PHYPayload().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return downlink, nil
+}
+
+// sendClassCDownlink sends the queued downlink of a Class B or C device without waiting for an uplink. Unconfirmed
+// downlinks are sent until the queue is empty; a confirmed downlink stays pending until it is acknowledged in an
+// uplink, and is retransmitted in response to uplinks.
+func (h *handler) sendClassCDownlink(appID, devID string) (err error) {
+	ctx := h.Ctx.WithFields(ttnlog.Fields{
+		"AppID": appID,
+		"DevID": devID,
+	})
+	unlock := h.lockDevice(appID, devID)
+	defer unlock()
+	defer func() {
+		if err != nil {
+			ctx.WithError(err).Warn("Could not send downlink without uplink")
+		}
+	}()
+	queue, err := h.devices.DownlinkQueue(appID, devID)
+	if err != nil {
+		return err
+	}
+	for {
+		dev, err := h.devices.Get(appID, devID)
+		if err != nil {
+			return err
+		}
+		if !dev.Class.ReceivesDownlinkWithoutUplink() || dev.CurrentDownlink != nil || dev.DevAddr.IsEmpty() {
+			return nil
+		}
+		next, err := h.nextDownlink(queue, appID, devID)
+		if err != nil || next == nil {
+			return err
+		}
+		dev.StartUpdate()
+		dev.CurrentDownlink = next
+		dev.CurrentDownlinkAttempts = 0
+		if err := h.devices.Set(dev); err != nil {
+			return err
+		}
+
+		downlink, err := classCDownlinkTemplate(dev)
+		if err != nil {
+			return err
+		}
+		appDownlink := *next
+		appDownlink.AppID = appID
+		appDownlink.DevID = devID
+		if err := h.HandleDownlink(&appDownlink, downlink); err != nil {
+			return err
+		}
+
+		if next.Confirmed {
+			return nil
+		}
+		// An unconfirmed downlink is done when it is sent
+		dev, err = h.devices.Get(appID, devID)
+		if err != nil {
+			return err
+		}
+		dev.StartUpdate()
+		dev.CurrentDownlink = nil
+		dev.CurrentDownlinkAttempts = 0
+		if err := h.devices.Set(dev); err != nil {
+			return err
+		}
+	}
+}
+
+// setDeviceClass sets the class of the device in the NetworkServer and the Handler, and sends its queued downlink
+// if it is a Class B or C device. The context carries the token for the NetworkServer.
+func (h *handler) setDeviceClass(ctx context.Context, appID, devID string, class types.DeviceClass) error {
+	unlock := h.lockDevice(appID, devID)
+	dev, err := h.devices.Get(appID, devID)
+	if err != nil {
+		unlock()
+		return err
+	}
+	_, err = h.ttnNetworkServerManager.SetDeviceClass(ctx, &pb_manager.NetworkServerDeviceClass{
+		AppEUI: dev.AppEUI.Bytes(),
+		DevEUI: dev.DevEUI.Bytes(),
+		Class:  string(class),
+	})
+	if err != nil {
+		unlock()
+		return errors.Wrap(errors.FromGRPCError(err), "Broker did not set device class")
+	}
+	dev.StartUpdate()
+	dev.Class = class
+	err = h.devices.Set(dev)
+	unlock()
+	if err != nil {
+		return err
+	}
+	if class.ReceivesDownlinkWithoutUplink() {
+		go h.sendClassCDownlink(appID, devID)
+	}
+	return nil
+}
+
+func (h *handlerManager) GetDeviceClass(ctx context.Context, in *pb_manager.DeviceIdentifier) (*pb_manager.DeviceClass, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	dev, err := h.handler.devices.Get(in.AppID, in.DevID)
+	if err != nil {
+		return nil, err
+	}
+	class, _ := types.ParseDeviceClass(string(dev.Class))
+	return &pb_manager.DeviceClass{AppID: in.AppID, DevID: in.DevID, Class: string(class)}, nil
+}
+
+func (h *handlerManager) SetDeviceClass(ctx context.Context, in *pb_manager.DeviceClass) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Class")
+	}
+	class, err := types.ParseDeviceClass(in.Class)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+	if err := h.handler.setDeviceClass(ttnctx.OutgoingContextWithToken(ctx, token), in.AppID, in.DevID, class); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
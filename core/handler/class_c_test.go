@@ -0,0 +1,95 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestSendClassCDownlink(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestSendClassCDownlink")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-send-class-c-downlink"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-send-class-c-downlink"),
+		downlink:     make(chan *pb_broker.DownlinkMessage, 10),
+		qEvent:       make(chan *types.DeviceEvent, 10),
+	}
+	h.InitStatus()
+	h.applications.Set(&application.Application{AppID: appID})
+	defer h.applications.Delete(appID)
+	h.devices.Set(&device.Device{
+		AppID:   appID,
+		DevID:   devID,
+		AppEUI:  types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8},
+		DevEUI:  types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8},
+		DevAddr: types.DevAddr{1, 2, 3, 4},
+	})
+	defer h.devices.Delete(appID, devID)
+
+	queue, _ := h.devices.DownlinkQueue(appID, devID)
+	queue.PushLast(&types.DownlinkMessage{FPort: 1, PayloadRaw: []byte{0x01}})
+	queue.PushLast(&types.DownlinkMessage{FPort: 1, PayloadRaw: []byte{0x02}, Confirmed: true})
+	queue.PushLast(&types.DownlinkMessage{FPort: 1, PayloadRaw: []byte{0x03}})
+
+	// Class A devices wait for an uplink
+	a.So(h.sendClassCDownlink(appID, devID), ShouldBeNil)
+	a.So(h.downlink, ShouldHaveLength, 0)
+
+	dev, _ := h.devices.Get(appID, devID)
+	dev.StartUpdate()
+	dev.Class = types.ClassC
+	h.devices.Set(dev)
+	a.So(h.sendClassCDownlink(appID, devID), ShouldBeNil)
+
+	// The unconfirmed downlink and the confirmed downlink are sent; the last downlink waits for the ACK
+	a.So(h.downlink, ShouldHaveLength, 2)
+	downlink := <-h.downlink
+	a.So(downlink.DownlinkOption, ShouldNotBeNil)
+	a.So(downlink.DownlinkOption.Identifier, ShouldBeEmpty)
+	a.So(downlink.Message.GetLoRaWAN().GetMACPayload().DevAddr, ShouldEqual, types.DevAddr{1, 2, 3, 4})
+	<-h.downlink
+
+	dev, _ = h.devices.Get(appID, devID)
+	a.So(dev.CurrentDownlink, ShouldNotBeNil)
+	a.So(dev.CurrentDownlink.Confirmed, ShouldBeTrue)
+
+	// The unconfirmed downlink used FCnt 0, the confirmed downlink keeps FCnt 1 until it is acknowledged
+	a.So(dev.FCntDown, ShouldEqual, 1)
+	length, _ := queue.Length()
+	a.So(length, ShouldEqual, 1)
+}
+
+func TestClassBDownlinkTemplate(t *testing.T) {
+	a := New(t)
+	dev := &device.Device{
+		AppID:   "app1",
+		DevID:   "dev1",
+		DevAddr: types.DevAddr{1, 2, 3, 4},
+		Class:   types.ClassC,
+	}
+
+	dev.FCntDown = 5
+	downlink, err := classCDownlinkTemplate(dev)
+	a.So(err, ShouldBeNil)
+	a.So(downlink.DownlinkOption.Identifier, ShouldBeEmpty)
+	downlink.UnmarshalPayload()
+	a.So(downlink.Message.GetLoRaWAN().GetMACPayload().FCnt, ShouldEqual, 5)
+
+	// The NetworkServer sends the downlink of Class B devices in a ping slot
+	dev.Class = types.ClassB
+	downlink, err = classCDownlinkTemplate(dev)
+	a.So(err, ShouldBeNil)
+	a.So(downlink.DownlinkOption.Identifier, ShouldBeEmpty)
+}
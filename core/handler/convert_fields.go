@@ -6,11 +6,13 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	pb_handler "github.com/TheThingsNetwork/api/handler"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
 	"github.com/TheThingsNetwork/ttn/core/handler/cayennelpp"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/handler/functions"
@@ -30,7 +32,33 @@ type PayloadEncoder interface {
 	Log() []*pb_handler.LogEntry
 }
 
-// ConvertFieldsUp converts the payload to fields using the application's payload formatter
+// functionsCacheKey returns the key of the compiled payload functions of the application for the FPort. Ports
+// that do not have their own functions share the default functions of the application.
+func functionsCacheKey(app *application.Application, port uint8) string {
+	if app.HasPortFunctions(port) {
+		return fmt.Sprintf("%s:%d", app.AppID, port)
+	}
+	return app.AppID
+}
+
+// payloadFunctions returns the payload format for messages of the device on the FPort, along with the custom
+// payload functions and their key in the script cache. The payload format of the device overrides the payload
+// format of the application. If it refers to a function set that no longer exists, the application's is used.
+func payloadFunctions(app *application.Application, dev *device.Device, port uint8) (application.PayloadFormat, application.PortFunctions, string) {
+	format := app.PayloadFormat
+	if dev != nil && dev.PayloadFormat != "" {
+		if devFormat, set, err := application.ParseDevicePayloadFormat(dev.PayloadFormat); err == nil {
+			if set == "" {
+				format = devFormat
+			} else if functions, ok := app.FunctionSets[set]; ok {
+				return application.PayloadFormatCustom, functions, fmt.Sprintf("%s:set:%s", app.AppID, set)
+			}
+		}
+	}
+	return format, app.FunctionsForPort(port), functionsCacheKey(app, port)
+}
+
+// ConvertFieldsUp converts the payload to fields using the payload formatter of the device or application
 func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
 	// Find Application
 	app, err := h.applications.Get(appUp.AppID)
@@ -38,22 +66,35 @@ func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.Deduplicate
 		return nil // Do not process if application not found
 	}
 
+	format, customFunctions, cacheKey := payloadFunctions(app, dev, appUp.FPort)
+
 	var decoder PayloadDecoder
-	switch app.PayloadFormat {
+	var deviceContext *DeviceContext
+	switch format {
 	case application.PayloadFormatCustom:
+		deviceContext = uplinkDeviceContext(dev, appUp)
 		decoder = &CustomUplinkFunctions{
-			Decoder:   app.CustomDecoder,
-			Converter: app.CustomConverter,
-			Validator: app.CustomValidator,
+			Decoder:   customFunctions.Decoder,
+			Converter: customFunctions.Converter,
+			Validator: customFunctions.Validator,
 			Logger:    functions.Ignore,
+			Cache:     h.scripts,
+			CacheKey:  cacheKey,
+			Device:    deviceContext,
 		}
 	case application.PayloadFormatCayenneLPP:
 		decoder = &cayennelpp.Decoder{}
+	case application.PayloadFormatBinary:
+		decoder = &binaryformat.Decoder{Format: app.BinaryFormat}
 	default:
 		return nil
 	}
 
+	start := time.Now()
 	fields, valid, err := decoder.Decode(appUp.PayloadRaw, appUp.FPort)
+	if format == application.PayloadFormatCustom {
+		h.observeFunctions(appUp.AppID, "uplink", time.Since(start))
+	}
 	if err != nil {
 		// Emit the error
 		h.qEvent <- &types.DeviceEvent{
@@ -88,6 +129,18 @@ func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.Deduplicate
 		return nil
 	}
 
+	if deviceContext != nil {
+		if err := deviceContext.saveState(dev); err != nil {
+			// Emit the error, but continue with the fields
+			h.qEvent <- &types.DeviceEvent{
+				AppID: appUp.AppID,
+				DevID: appUp.DevID,
+				Event: types.UplinkErrorEvent,
+				Data:  types.ErrorEventData{Error: fmt.Sprintf("Unable to store device state: %s", err)},
+			}
+		}
+	}
+
 	appUp.PayloadFields = fields
 	appUp.Attributes = dev.Attributes
 
@@ -95,7 +148,7 @@ func (h *handler) ConvertFieldsUp(ctx ttnlog.Interface, _ *pb_broker.Deduplicate
 }
 
 // ConvertFieldsDown converts the fields into a payload
-func (h *handler) ConvertFieldsDown(ctx ttnlog.Interface, appDown *types.DownlinkMessage, ttnDown *pb_broker.DownlinkMessage, _ *device.Device) error {
+func (h *handler) ConvertFieldsDown(ctx ttnlog.Interface, appDown *types.DownlinkMessage, ttnDown *pb_broker.DownlinkMessage, dev *device.Device) error {
 	if appDown.PayloadFields == nil || len(appDown.PayloadFields) == 0 {
 		return nil
 	}
@@ -109,24 +162,43 @@ func (h *handler) ConvertFieldsDown(ctx ttnlog.Interface, appDown *types.Downlin
 		return nil
 	}
 
+	format, customFunctions, cacheKey := payloadFunctions(app, dev, appDown.FPort)
+
 	var encoder PayloadEncoder
-	switch app.PayloadFormat {
+	var deviceContext *DeviceContext
+	switch format {
 	case application.PayloadFormatCustom:
+		deviceContext = downlinkDeviceContext(dev, appDown)
 		encoder = &CustomDownlinkFunctions{
-			Encoder: app.CustomEncoder,
-			Logger:  functions.Ignore,
+			Encoder:  customFunctions.Encoder,
+			Logger:   functions.Ignore,
+			Cache:    h.scripts,
+			CacheKey: cacheKey,
+			Device:   deviceContext,
 		}
 	case application.PayloadFormatCayenneLPP:
 		encoder = &cayennelpp.Encoder{}
+	case application.PayloadFormatBinary:
+		encoder = &binaryformat.Encoder{Format: app.BinaryFormat}
 	default:
 		return nil
 	}
 
+	start := time.Now()
 	raw, _, err := encoder.Encode(appDown.PayloadFields, appDown.FPort)
+	if format == application.PayloadFormatCustom {
+		h.observeFunctions(appDown.AppID, "downlink", time.Since(start))
+	}
 	if err != nil {
 		return err
 	}
 
+	if deviceContext != nil {
+		if err := deviceContext.saveState(dev); err != nil {
+			return err
+		}
+	}
+
 	appDown.PayloadRaw = raw
 
 	return nil
@@ -27,11 +27,37 @@ type CustomUplinkFunctions struct {
 
 	// Logger is the logger that will be used to store logs
 	Logger functions.Logger
+
+	// Cache is used to cache the compiled functions. If it is nil, the functions are compiled on every call
+	Cache *functions.Cache
+	// CacheKey identifies the functions in the Cache
+	CacheKey string
+
+	// Device is the context of the device that is passed to the Decoder and Converter. If it is nil, they
+	// receive undefined.
+	Device *DeviceContext
 }
 
 // timeOut is the maximum allowed time a payload function is allowed to run
 var timeOut = 100 * time.Millisecond
 
+// runFunction runs the call expression after the source of a payload function, using the compiled function
+// from the cache if possible
+func runFunction(cache *functions.Cache, cacheKey, name, source, call string, env map[string]interface{}, logger functions.Logger) (interface{}, error) {
+	if cache == nil {
+		code := fmt.Sprintf(`
+		%s;
+		%s
+	`, source, call)
+		return functions.RunCode(name, code, env, timeOut, logger)
+	}
+	script, err := cache.Get(fmt.Sprintf("%s:%s", cacheKey, name), name, source, call, timeOut)
+	if err != nil {
+		return nil, err
+	}
+	return script.Run(env, timeOut, logger)
+}
+
 // decode decodes the payload using the Decoder function into a map
 func (f *CustomUplinkFunctions) decode(payload []byte, port uint8) (map[string]interface{}, error) {
 	if f.Decoder == "" {
@@ -41,13 +67,9 @@ func (f *CustomUplinkFunctions) decode(payload []byte, port uint8) (map[string]i
 	env := map[string]interface{}{
 		"payload": payload,
 		"port":    port,
+		"device":  f.Device.env(),
 	}
-	code := fmt.Sprintf(`
-		%s;
-		Decoder(payload.slice(0), port);
-	`, f.Decoder)
-
-	value, err := functions.RunCode("Decoder", code, env, timeOut, f.Logger)
+	value, err := runFunction(f.Cache, f.CacheKey, "Decoder", f.Decoder, "Decoder(payload.slice(0), port, device);", env, f.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -70,14 +92,10 @@ func (f *CustomUplinkFunctions) convert(fields map[string]interface{}, port uint
 	env := map[string]interface{}{
 		"fields": fields,
 		"port":   port,
+		"device": f.Device.env(),
 	}
 
-	code := fmt.Sprintf(`
-		%s;
-		Converter(fields, port)
-	`, f.Converter)
-
-	value, err := functions.RunCode("Converter", code, env, timeOut, f.Logger)
+	value, err := runFunction(f.Cache, f.CacheKey, "Converter", f.Converter, "Converter(fields, port, device)", env, f.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -101,12 +119,7 @@ func (f *CustomUplinkFunctions) validate(fields map[string]interface{}, port uin
 		"fields": fields,
 		"port":   port,
 	}
-	code := fmt.Sprintf(`
-		%s;
-		Validator(fields, port)
-	`, f.Validator)
-
-	value, err := functions.RunCode("Validator", code, env, timeOut, f.Logger)
+	value, err := runFunction(f.Cache, f.CacheKey, "Validator", f.Validator, "Validator(fields, port)", env, f.Logger)
 	if err != nil {
 		return false, err
 	}
@@ -131,7 +144,15 @@ func (f *CustomUplinkFunctions) Decode(payload []byte, port uint8) (map[string]i
 	}
 
 	valid, err := f.validate(converted, port)
-	return converted, valid, err
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := f.Device.updateState(); err != nil {
+		return nil, false, err
+	}
+
+	return converted, valid, nil
 }
 
 // Log returns the log
@@ -147,6 +168,14 @@ type CustomDownlinkFunctions struct {
 
 	// Logger is the logger that will be used to store logs
 	Logger functions.Logger
+
+	// Cache is used to cache the compiled functions. If it is nil, the functions are compiled on every call
+	Cache *functions.Cache
+	// CacheKey identifies the functions in the Cache
+	CacheKey string
+
+	// Device is the context of the device that is passed to the Encoder. If it is nil, it receives undefined.
+	Device *DeviceContext
 }
 
 // encode encodes the map into a byte slice using the encoder payload function
@@ -159,13 +188,9 @@ func (f *CustomDownlinkFunctions) encode(payload map[string]interface{}, port ui
 	env := map[string]interface{}{
 		"payload": payload,
 		"port":    port,
+		"device":  f.Device.env(),
 	}
-	code := fmt.Sprintf(`
-		%s;
-		Encoder(payload, port)
-	`, f.Encoder)
-
-	value, err := functions.RunCode("Encoder", code, env, timeOut, f.Logger)
+	value, err := runFunction(f.Cache, f.CacheKey, "Encoder", f.Encoder, "Encoder(payload, port, device)", env, f.Logger)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +260,10 @@ func (f *CustomDownlinkFunctions) Encode(payload map[string]interface{}, port ui
 		return nil, false, err
 	}
 
+	if err := f.Device.updateState(); err != nil {
+		return nil, false, err
+	}
+
 	return encoded, true, nil
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
@@ -40,7 +41,9 @@ func TestConvertFieldsUpCustom(t *testing.T) {
 	h := &handler{
 		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-fields-up"),
 		qEvent:       make(chan *types.DeviceEvent, 1),
+		scripts:      functions.NewCache(),
 	}
+	h.InitStatus()
 
 	dev := new(device.Device)
 	dev.Attributes = attributes
@@ -116,6 +119,117 @@ func TestConvertFieldsUpCustom(t *testing.T) {
 		a.So(ok, ShouldBeTrue)
 		a.So(errEvt.Error, ShouldContainSubstring, "cannot be marshaled")
 	}
+
+	functions := h.functionsStatus()
+	a.So(functions, ShouldHaveLength, 1)
+	a.So(functions[0].AppID, ShouldEqual, appID)
+	a.So(functions[0].Direction, ShouldEqual, "uplink")
+	a.So(functions[0].Count, ShouldEqual, 4)
+}
+
+func TestConvertFieldsUpCustomPort(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+	ctx := GetLogger(t, "TestConvertFieldsUpCustomPort")
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-fields-up"),
+		qEvent:       make(chan *types.DeviceEvent, 1),
+		scripts:      functions.NewCache(),
+	}
+	h.InitStatus()
+
+	app := &application.Application{
+		AppID:           appID,
+		PayloadFormat:   application.PayloadFormatCustom,
+		CustomDecoder:   `function Decoder (data) { return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`,
+		CustomConverter: `function Converter (fields) { fields.converted = true; return fields; }`,
+	}
+	app.SetPortFunctions(2, application.PortFunctions{
+		Decoder: `function Decoder (data) { return { humidity: data[0] }; }`,
+	})
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	// Default functions
+	{
+		ttnUp, appUp := buildCustomUplink(appID)
+		err := h.ConvertFieldsUp(ctx, ttnUp, appUp, new(device.Device))
+		a.So(err, ShouldBeNil)
+		a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+			"temperature": 21.6,
+			"converted":   true,
+		})
+	}
+
+	// Functions of the port, with the default converter
+	{
+		ttnUp, appUp := buildCustomUplink(appID)
+		appUp.FPort = 2
+		err := h.ConvertFieldsUp(ctx, ttnUp, appUp, new(device.Device))
+		a.So(err, ShouldBeNil)
+		a.So(appUp.PayloadFields, ShouldHaveLength, 2)
+		a.So(appUp.PayloadFields["humidity"], ShouldEqual, 8)
+		a.So(appUp.PayloadFields["converted"], ShouldEqual, true)
+	}
+}
+
+func TestConvertFieldsUpDevicePayloadFormat(t *testing.T) {
+	a := New(t)
+	appID := "AppID-1"
+	ctx := GetLogger(t, "TestConvertFieldsUpDevicePayloadFormat")
+
+	h := &handler{
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-convert-fields-up"),
+		qEvent:       make(chan *types.DeviceEvent, 1),
+		scripts:      functions.NewCache(),
+	}
+	h.InitStatus()
+
+	app := &application.Application{
+		AppID:         appID,
+		PayloadFormat: application.PayloadFormatCustom,
+		CustomDecoder: `function Decoder (data) { return { temperature: ((data[0] << 8) | data[1]) / 100 }; }`,
+	}
+	app.SetFunctionSet("valve", application.PortFunctions{
+		Decoder: `function Decoder (data) { return { open: data[0] > 0 }; }`,
+	})
+	a.So(h.applications.Set(app), ShouldBeNil)
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	// Function set of the device
+	{
+		ttnUp, appUp := buildCustomUplink(appID)
+		err := h.ConvertFieldsUp(ctx, ttnUp, appUp, &device.Device{PayloadFormat: "custom:valve"})
+		a.So(err, ShouldBeNil)
+		a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+			"open": true,
+		})
+	}
+
+	// Built-in payload format of the device
+	{
+		ttnUp, appUp := buildCayenneLPPUplink(appID)
+		err := h.ConvertFieldsUp(ctx, ttnUp, appUp, &device.Device{PayloadFormat: "cayennelpp"})
+		a.So(err, ShouldBeNil)
+		a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+			"barometric_pressure_10": float32(1073.5),
+		})
+	}
+
+	// Function set that no longer exists falls back to the application
+	{
+		ttnUp, appUp := buildCustomUplink(appID)
+		err := h.ConvertFieldsUp(ctx, ttnUp, appUp, &device.Device{PayloadFormat: "custom:meter"})
+		a.So(err, ShouldBeNil)
+		a.So(appUp.PayloadFields, ShouldResemble, map[string]interface{}{
+			"temperature": 21.6,
+		})
+	}
 }
 
 func buildCayenneLPPUplink(appID string) (*pb_broker.DeduplicatedUplinkMessage, *types.UplinkMessage) {
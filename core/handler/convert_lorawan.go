@@ -4,11 +4,14 @@
 package handler
 
 import (
+	"fmt"
+
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/api/trace"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/group"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
@@ -53,6 +56,14 @@ func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.Dedu
 		appUp.PayloadRaw = macPayload.FRMPayload
 	}
 
+	// The NetworkServer uses the next FCnt after a confirmed downlink was acknowledged
+	if macPayload.Ack {
+		if dev.ConfirmedDownlinkPending {
+			dev.FCntDown = dev.ConfirmedDownlinkFCnt + 1
+		}
+		dev.ConfirmedDownlinkPending = false
+	}
+
 	if dev.CurrentDownlink != nil && !appUp.IsRetry {
 		// We have a downlink pending
 		if dev.CurrentDownlink.Confirmed {
@@ -67,7 +78,24 @@ func (h *handler) ConvertFromLoRaWAN(ctx ttnlog.Interface, ttnUp *pb_broker.Dedu
 						Message: dev.CurrentDownlink,
 					},
 				}
+				h.countGroupDownlink(appUp.AppID, dev.CurrentDownlink, group.Acked)
 				dev.CurrentDownlink = nil
+				dev.CurrentDownlinkAttempts = 0
+			} else if maxRetries := downlinkMaxRetries(dev.CurrentDownlink); dev.CurrentDownlinkAttempts > maxRetries {
+				// The retries ran out, so we drop it
+				h.qEvent <- &types.DeviceEvent{
+					AppID: appUp.AppID,
+					DevID: appUp.DevID,
+					Event: types.DownlinkNackEvent,
+					Data: types.DownlinkEventData{
+						ErrorEventData: types.ErrorEventData{
+							Error: fmt.Sprintf("No ACK after %d transmissions", dev.CurrentDownlinkAttempts),
+						},
+						Message: dev.CurrentDownlink,
+					},
+				}
+				dev.CurrentDownlink = nil
+				dev.CurrentDownlinkAttempts = 0
 			}
 		} else {
 			// If it's unconfirmed, we can unset it.
@@ -97,6 +125,20 @@ func (h *handler) ConvertToLoRaWAN(ctx ttnlog.Interface, appDown *types.Downlink
 		return ErrNotNeeded
 	}
 
+	// A downlink that is not a retransmission of the pending confirmed downlink uses the next FCnt, which tells
+	// the NetworkServer that the pending confirmed downlink was given up
+	retransmission := appDown.Confirmed && dev.CurrentDownlink != nil && dev.CurrentDownlinkAttempts > 0
+	if dev.ConfirmedDownlinkPending && macPayload.FCnt == dev.ConfirmedDownlinkFCnt && !retransmission {
+		macPayload.FCnt++
+	}
+	dev.ConfirmedDownlinkPending = appDown.Confirmed
+	dev.ConfirmedDownlinkFCnt = macPayload.FCnt
+	if appDown.Confirmed {
+		dev.FCntDown = macPayload.FCnt
+	} else {
+		dev.FCntDown = macPayload.FCnt + 1
+	}
+
 	if appDown.FPort > 0 {
 		macPayload.FPort = int32(appDown.FPort)
 	}
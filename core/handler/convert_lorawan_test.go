@@ -87,6 +87,29 @@ func TestConvertFromLoRaWAN(t *testing.T) {
 	a.So(appUp.Confirmed, ShouldBeTrue)
 
 	wg.Wait()
+
+	maxRetries := 1
+	device.CurrentDownlink = &types.DownlinkMessage{Confirmed: true, MaxRetries: &maxRetries}
+
+	for _, attempts := range []int{1, 2} {
+		device.CurrentDownlinkAttempts = attempts
+
+		ttnUp.UnmarshalPayload()
+		ttnUp.Message.GetLoRaWAN().GetMACPayload().FCnt++
+		ttnUp.GetProtocolMetadata().GetLoRaWAN().FCnt = ttnUp.Message.GetLoRaWAN().GetMACPayload().FCnt
+		ttnUp.Message.GetLoRaWAN().GetMACPayload().Ack = false
+		ttnUp.Message.GetLoRaWAN().SetMIC(device.NwkSKey)
+		ttnUp.Payload = ttnUp.Message.GetLoRaWAN().PHYPayloadBytes()
+
+		err = h.ConvertFromLoRaWAN(h.Ctx, ttnUp, appUp, device)
+		a.So(err, ShouldBeNil)
+	}
+
+	// The retries ran out after the second transmission
+	a.So(device.CurrentDownlink, ShouldBeNil)
+	a.So(device.CurrentDownlinkAttempts, ShouldEqual, 0)
+	event := <-h.qEvent
+	a.So(event.Event, ShouldEqual, types.DownlinkNackEvent)
 }
 
 func buildLoRaWANDownlink(payload []byte) (*types.DownlinkMessage, *pb_broker.DownlinkMessage) {
@@ -139,3 +162,37 @@ func TestConvertToLoRaWAN(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(ttnDown.Payload, ShouldResemble, []byte{0x60, 0x04, 0x03, 0x02, 0x01, 0x20, 0x01, 0x00, 0x94, 0xf8, 0xcf, 0x0d})
 }
+
+func TestConvertToLoRaWANConfirmedFCnt(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestConvertToLoRaWANConfirmedFCnt")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-convert-to-lorawan-confirmed-fcnt"),
+	}
+	device := &device.Device{
+		DevID:           "devid",
+		AppID:           "appid",
+		CurrentDownlink: &types.DownlinkMessage{PayloadRaw: []byte{0xaa, 0xbc}, Confirmed: true},
+	}
+
+	// The confirmed downlink and its retransmission use the FCnt of the NetworkServer
+	for i := 0; i < 2; i++ {
+		appDown, ttnDown := buildLoRaWANDownlink([]byte{0xaa, 0xbc})
+		appDown.Confirmed = true
+		err := h.ConvertToLoRaWAN(h.Ctx, appDown, ttnDown, device)
+		a.So(err, ShouldBeNil)
+		a.So(ttnDown.GetMessage().GetLoRaWAN().GetMACPayload().FCnt, ShouldEqual, 1)
+		a.So(device.ConfirmedDownlinkPending, ShouldBeTrue)
+		device.CurrentDownlinkAttempts++
+	}
+
+	// After a NACK, the NetworkServer still uses the FCnt of the confirmed downlink, so the next downlink
+	// uses the FCnt after it
+	device.CurrentDownlink = nil
+	device.CurrentDownlinkAttempts = 0
+	appDown, ttnDown := buildLoRaWANDownlink([]byte{0xaa, 0xbc})
+	err := h.ConvertToLoRaWAN(h.Ctx, appDown, ttnDown, device)
+	a.So(err, ShouldBeNil)
+	a.So(ttnDown.GetMessage().GetLoRaWAN().GetMACPayload().FCnt, ShouldEqual, 2)
+	a.So(device.ConfirmedDownlinkPending, ShouldBeFalse)
+}
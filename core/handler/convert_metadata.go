@@ -5,12 +5,14 @@ package handler
 
 import (
 	"strings"
+	"time"
 
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	pb_gateway "github.com/TheThingsNetwork/api/gateway"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/brocaar/lorawan"
 )
 
 // ConvertMetadata converts the protobuf matadata to application metadata
@@ -62,6 +64,7 @@ func (h *handler) ConvertMetadata(ctx ttnlog.Interface, ttnUp *pb_broker.Dedupli
 				gatewayMetadata.Channel = antenna.Channel
 				gatewayMetadata.RSSI = antenna.RSSI
 				gatewayMetadata.SNR = antenna.SNR
+				gatewayMetadata.FineTimestamp = antenna.FineTime
 				appUp.Metadata.Gateways = append(appUp.Metadata.Gateways, gatewayMetadata)
 			}
 		} else {
@@ -69,12 +72,36 @@ func (h *handler) ConvertMetadata(ctx ttnlog.Interface, ttnUp *pb_broker.Dedupli
 		}
 	}
 
+	// Device Status, that the device sends in response to a DevStatusReq of the NetworkServer. The NetworkServer
+	// parses it as well, but the LoRaWAN metadata of the uplink, which is defined in the TheThingsNetwork/api protos,
+	// has no fields to pass it on. Until it does, the Handler reads it from the FOpts of the uplink.
+	if macPayload := ttnUp.GetMessage().GetLoRaWAN().GetMACPayload(); macPayload != nil {
+		for _, cmd := range macPayload.FOpts {
+			if cmd.CID != uint32(lorawan.DevStatusAns) {
+				continue
+			}
+			status, err := types.ParseDeviceStatus(cmd.Payload)
+			if err != nil {
+				ctx.WithError(err).Warn("Invalid device status")
+				continue
+			}
+			appUp.Metadata.DeviceStatus = status
+			dev.DeviceStatus = status
+			dev.DeviceStatusTime = time.Now()
+		}
+	}
+
 	// Inject Device Metadata
 	if dev.Latitude != 0 || dev.Longitude != 0 {
 		appUp.Metadata.LocationMetadata.Latitude = dev.Latitude
 		appUp.Metadata.LocationMetadata.Longitude = dev.Longitude
 		appUp.Metadata.LocationMetadata.Altitude = dev.Altitude
 		appUp.Metadata.LocationMetadata.Source = "registry"
+	} else if h.locationSolver != nil {
+		if location := h.locationSolver.Solve(appUp.Metadata.Gateways); location != nil {
+			ctx.WithField("Source", location.Source).Debug("Solved device location")
+			appUp.Metadata.LocationMetadata = *location
+		}
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package handler
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/geolocation"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
@@ -71,5 +73,65 @@ func TestConvertMetadata(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(appUp.Metadata.Gateways[0].Latitude, ShouldEqual, 42)
 	a.So(time.Time(appUp.Metadata.Gateways[0].Time).UTC(), ShouldResemble, time.Date(2016, 06, 13, 15, 28, 56, 0, time.UTC))
+}
+
+func TestConvertMetadataLocationSolver(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component:      &component.Component{Ctx: GetLogger(t, "TestConvertMetadataLocationSolver")},
+		locationSolver: geolocation.NewSolver(),
+	}
+
+	ttnUp := &pb_broker.DeduplicatedUplinkMessage{}
+	for i, location := range []*pb_gateway.LocationMetadata{
+		{Latitude: 52.37, Longitude: 4.88},
+		{Latitude: 52.37, Longitude: 4.90},
+		{Latitude: 52.38, Longitude: 4.89},
+	} {
+		ttnUp.GatewayMetadata = append(ttnUp.GatewayMetadata, &pb_gateway.RxMetadata{
+			GatewayID: fmt.Sprintf("gtw-%d", i),
+			RSSI:      -100,
+			Location:  location,
+		})
+	}
+
+	appUp := &types.UplinkMessage{}
+	err := h.ConvertMetadata(h.Ctx, ttnUp, appUp, &device.Device{})
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.Source, ShouldEqual, geolocation.SourceRSSI)
+	a.So(appUp.Metadata.Latitude, ShouldBeBetween, 52.37, 52.38)
+	a.So(appUp.Metadata.Accuracy, ShouldBeGreaterThan, 0)
+
+	// The registry location takes precedence
+	appUp = &types.UplinkMessage{}
+	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, &device.Device{Latitude: 12.34})
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.Source, ShouldEqual, "registry")
+}
+
+func TestConvertMetadataDeviceStatus(t *testing.T) {
+	a := New(t)
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestConvertMetadataDeviceStatus")},
+	}
 
+	ttnUp := &pb_broker.DeduplicatedUplinkMessage{Message: new(pb_protocol.Message)}
+	macPayload := ttnUp.Message.InitLoRaWAN().InitUplink()
+	dev := &device.Device{}
+
+	appUp := &types.UplinkMessage{}
+	err := h.ConvertMetadata(h.Ctx, ttnUp, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.DeviceStatus, ShouldBeNil)
+	a.So(dev.DeviceStatus, ShouldBeNil)
+
+	macPayload.FOpts = []pb_lorawan.MACCommand{
+		pb_lorawan.MACCommand{CID: 0x06, Payload: []byte{128, 10}},
+	}
+	appUp = &types.UplinkMessage{}
+	err = h.ConvertMetadata(h.Ctx, ttnUp, appUp, dev)
+	a.So(err, ShouldBeNil)
+	a.So(appUp.Metadata.DeviceStatus, ShouldResemble, &types.DeviceStatus{Battery: 128, Margin: 10})
+	a.So(dev.DeviceStatus, ShouldResemble, &types.DeviceStatus{Battery: 128, Margin: 10})
+	a.So(dev.DeviceStatusTime, ShouldHappenWithin, time.Second, time.Now())
 }
@@ -0,0 +1,419 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb_handler "github.com/TheThingsNetwork/api/handler"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Formats for bulk import and export of devices
+const (
+	FormatCSV       = "csv"
+	FormatJSONLines = "jsonl"
+)
+
+// Record is a device in a bulk import or export
+type Record struct {
+	DevID                 string            `json:"dev_id"`
+	Description           string            `json:"description,omitempty"`
+	AppEUI                types.AppEUI      `json:"app_eui"`
+	DevEUI                types.DevEUI      `json:"dev_eui"`
+	AppKey                types.AppKey      `json:"app_key"`
+	DevAddr               types.DevAddr     `json:"dev_addr"`
+	NwkSKey               types.NwkSKey     `json:"nwk_s_key"`
+	AppSKey               types.AppSKey     `json:"app_s_key"`
+	Latitude              float32           `json:"latitude,omitempty"`
+	Longitude             float32           `json:"longitude,omitempty"`
+	Altitude              int32             `json:"altitude,omitempty"`
+	ActivationConstraints string            `json:"activation_constraints,omitempty"`
+	DisableFCntCheck      bool              `json:"disable_fcnt_check,omitempty"`
+	Uses32BitFCnt         bool              `json:"uses_32_bit_fcnt,omitempty"`
+	Attributes            map[string]string `json:"attributes,omitempty"`
+}
+
+// RecordFromDevice returns the record of the device
+func RecordFromDevice(dev *Device) *Record {
+	return &Record{
+		DevID:                 dev.DevID,
+		Description:           dev.Description,
+		AppEUI:                dev.AppEUI,
+		DevEUI:                dev.DevEUI,
+		AppKey:                dev.AppKey,
+		DevAddr:               dev.DevAddr,
+		NwkSKey:               dev.NwkSKey,
+		AppSKey:               dev.AppSKey,
+		Latitude:              dev.Latitude,
+		Longitude:             dev.Longitude,
+		Altitude:              dev.Altitude,
+		ActivationConstraints: dev.Options.ActivationConstraints,
+		DisableFCntCheck:      dev.Options.DisableFCntCheck,
+		Uses32BitFCnt:         dev.Options.Uses32BitFCnt,
+		Attributes:            dev.Attributes,
+	}
+}
+
+// ToPb converts the record to a device proto for the application. Keys that are empty in the record are
+// left out, so that they are not changed for existing devices.
+func (r *Record) ToPb(appID string) *pb_handler.Device {
+	lorawan := &pb_lorawan.Device{
+		AppID:                 appID,
+		DevID:                 r.DevID,
+		AppEUI:                &r.AppEUI,
+		DevEUI:                &r.DevEUI,
+		ActivationConstraints: r.ActivationConstraints,
+		DisableFCntCheck:      r.DisableFCntCheck,
+		Uses32BitFCnt:         r.Uses32BitFCnt,
+	}
+	if !r.AppKey.IsEmpty() {
+		lorawan.AppKey = &r.AppKey
+	}
+	if !r.DevAddr.IsEmpty() {
+		lorawan.DevAddr = &r.DevAddr
+	}
+	if !r.NwkSKey.IsEmpty() {
+		lorawan.NwkSKey = &r.NwkSKey
+	}
+	if !r.AppSKey.IsEmpty() {
+		lorawan.AppSKey = &r.AppSKey
+	}
+	return &pb_handler.Device{
+		AppID:       appID,
+		DevID:       r.DevID,
+		Description: r.Description,
+		Device:      &pb_handler.Device_LoRaWANDevice{LoRaWANDevice: lorawan},
+		Latitude:    r.Latitude,
+		Longitude:   r.Longitude,
+		Altitude:    r.Altitude,
+		Attributes:  r.Attributes,
+	}
+}
+
+// ImportResult is the result of a bulk import of devices
+type ImportResult struct {
+	DryRun  bool          `json:"dry_run,omitempty"`
+	Rows    int           `json:"rows"`
+	Created int           `json:"created"`
+	Updated int           `json:"updated"`
+	Failed  int           `json:"failed"`
+	Errors  []ImportError `json:"errors,omitempty"`
+}
+
+// ImportError is the error for a row of a bulk import. Rows are numbered from 1, not counting the CSV header.
+type ImportError struct {
+	Row   int    `json:"row"`
+	DevID string `json:"dev_id,omitempty"`
+	Error string `json:"error"`
+}
+
+// attributeColumnPrefix is the prefix of CSV columns that contain attributes
+const attributeColumnPrefix = "attributes."
+
+// recordColumns are the CSV columns of a record, in the order in which they are exported
+var recordColumns = []string{
+	"dev_id", "description", "app_eui", "dev_eui", "app_key", "dev_addr", "nwk_s_key", "app_s_key",
+	"latitude", "longitude", "altitude", "activation_constraints", "disable_fcnt_check", "uses_32_bit_fcnt",
+}
+
+func (r *Record) textField(column string) encoding.TextUnmarshaler {
+	switch column {
+	case "app_eui":
+		return &r.AppEUI
+	case "dev_eui":
+		return &r.DevEUI
+	case "app_key":
+		return &r.AppKey
+	case "dev_addr":
+		return &r.DevAddr
+	case "nwk_s_key":
+		return &r.NwkSKey
+	case "app_s_key":
+		return &r.AppSKey
+	}
+	return nil
+}
+
+func (r *Record) set(column, value string) error {
+	if strings.HasPrefix(column, attributeColumnPrefix) {
+		if value != "" {
+			if r.Attributes == nil {
+				r.Attributes = make(map[string]string)
+			}
+			r.Attributes[strings.TrimPrefix(column, attributeColumnPrefix)] = value
+		}
+		return nil
+	}
+	if field := r.textField(column); field != nil {
+		return field.UnmarshalText([]byte(value))
+	}
+	switch column {
+	case "dev_id":
+		r.DevID = value
+	case "description":
+		r.Description = value
+	case "activation_constraints":
+		r.ActivationConstraints = value
+	case "latitude", "longitude":
+		if value == "" {
+			return nil
+		}
+		f, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return err
+		}
+		if column == "latitude" {
+			r.Latitude = float32(f)
+		} else {
+			r.Longitude = float32(f)
+		}
+	case "altitude":
+		if value == "" {
+			return nil
+		}
+		i, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return err
+		}
+		r.Altitude = int32(i)
+	case "disable_fcnt_check", "uses_32_bit_fcnt":
+		if value == "" {
+			return nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		if column == "disable_fcnt_check" {
+			r.DisableFCntCheck = b
+		} else {
+			r.Uses32BitFCnt = b
+		}
+	}
+	return nil
+}
+
+func (r *Record) get(column string) string {
+	if strings.HasPrefix(column, attributeColumnPrefix) {
+		return r.Attributes[strings.TrimPrefix(column, attributeColumnPrefix)]
+	}
+	switch column {
+	case "dev_id":
+		return r.DevID
+	case "description":
+		return r.Description
+	case "app_eui":
+		return r.AppEUI.String()
+	case "dev_eui":
+		return r.DevEUI.String()
+	case "app_key":
+		return r.AppKey.String()
+	case "dev_addr":
+		return r.DevAddr.String()
+	case "nwk_s_key":
+		return r.NwkSKey.String()
+	case "app_s_key":
+		return r.AppSKey.String()
+	case "latitude":
+		if r.Latitude != 0 {
+			return strconv.FormatFloat(float64(r.Latitude), 'f', -1, 32)
+		}
+	case "longitude":
+		if r.Longitude != 0 {
+			return strconv.FormatFloat(float64(r.Longitude), 'f', -1, 32)
+		}
+	case "altitude":
+		if r.Altitude != 0 {
+			return strconv.Itoa(int(r.Altitude))
+		}
+	case "activation_constraints":
+		return r.ActivationConstraints
+	case "disable_fcnt_check":
+		if r.DisableFCntCheck {
+			return "true"
+		}
+	case "uses_32_bit_fcnt":
+		if r.Uses32BitFCnt {
+			return "true"
+		}
+	}
+	return ""
+}
+
+// RecordReader reads device records
+type RecordReader interface {
+	// Read returns the next record, or io.EOF if there are no more records. An InvalidArgument error is
+	// returned for a record that could not be parsed; reading can continue after that.
+	Read() (*Record, error)
+}
+
+// NewRecordReader returns a RecordReader for the format. CSV input must start with a header that names the
+// columns of the records.
+func NewRecordReader(r io.Reader, format string) (RecordReader, error) {
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		header, err := reader.Read()
+		if err == io.EOF {
+			return nil, errors.NewErrInvalidArgument("CSV", "no header")
+		}
+		if err != nil {
+			return nil, errors.NewErrInvalidArgument("CSV header", err.Error())
+		}
+		for i, column := range header {
+			column = strings.TrimSpace(column)
+			if !isRecordColumn(column) {
+				return nil, errors.NewErrInvalidArgument("CSV header", fmt.Sprintf("unknown column %s", column))
+			}
+			header[i] = column
+		}
+		return &csvRecordReader{reader: reader, header: header}, nil
+	case FormatJSONLines:
+		return &jsonRecordReader{scanner: bufio.NewScanner(r)}, nil
+	}
+	return nil, errors.NewErrInvalidArgument("Format", fmt.Sprintf("%s is not supported", format))
+}
+
+func isRecordColumn(column string) bool {
+	if strings.HasPrefix(column, attributeColumnPrefix) {
+		return len(column) > len(attributeColumnPrefix)
+	}
+	for _, recordColumn := range recordColumns {
+		if column == recordColumn {
+			return true
+		}
+	}
+	return false
+}
+
+type csvRecordReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+func (r *csvRecordReader) Read() (*Record, error) {
+	fields, err := r.reader.Read()
+	if err != nil {
+		if err, ok := err.(*csv.ParseError); ok {
+			return nil, errors.NewErrInvalidArgument("Row", err.Err.Error())
+		}
+		return nil, err
+	}
+	if len(fields) != len(r.header) {
+		return nil, errors.NewErrInvalidArgument("Row", fmt.Sprintf("has %d fields instead of %d", len(fields), len(r.header)))
+	}
+	record := new(Record)
+	for i, value := range fields {
+		if err := record.set(r.header[i], strings.TrimSpace(value)); err != nil {
+			return nil, errors.NewErrInvalidArgument(r.header[i], err.Error())
+		}
+	}
+	return record, nil
+}
+
+type jsonRecordReader struct {
+	scanner *bufio.Scanner
+}
+
+func (r *jsonRecordReader) Read() (*Record, error) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		record := new(Record)
+		if err := json.Unmarshal(line, record); err != nil {
+			return nil, errors.NewErrInvalidArgument("Row", err.Error())
+		}
+		return record, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// RecordWriter writes device records
+type RecordWriter interface {
+	Write(record *Record) error
+	// Flush writes any buffered data; it must be called after the last record
+	Flush() error
+}
+
+// NewRecordWriter returns a RecordWriter for the format. The CSV output has a column for each of the given
+// attribute keys.
+func NewRecordWriter(w io.Writer, format string, attributes []string) (RecordWriter, error) {
+	switch format {
+	case FormatCSV:
+		header := append([]string{}, recordColumns...)
+		attributes = append([]string{}, attributes...)
+		sort.Strings(attributes)
+		for _, attribute := range attributes {
+			header = append(header, attributeColumnPrefix+attribute)
+		}
+		return &csvRecordWriter{writer: csv.NewWriter(w), header: header}, nil
+	case FormatJSONLines:
+		return &jsonRecordWriter{encoder: json.NewEncoder(w)}, nil
+	}
+	return nil, errors.NewErrInvalidArgument("Format", fmt.Sprintf("%s is not supported", format))
+}
+
+type csvRecordWriter struct {
+	writer        *csv.Writer
+	header        []string
+	headerWritten bool
+}
+
+func (w *csvRecordWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writer.Write(w.header)
+}
+
+func (w *csvRecordWriter) Write(record *Record) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	fields := make([]string, len(w.header))
+	for i, column := range w.header {
+		fields[i] = record.get(column)
+	}
+	return w.writer.Write(fields)
+}
+
+func (w *csvRecordWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonRecordWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonRecordWriter) Write(record *Record) error {
+	return w.encoder.Encode(record)
+}
+
+func (w *jsonRecordWriter) Flush() error {
+	return nil
+}
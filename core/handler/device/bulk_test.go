@@ -0,0 +1,116 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package device
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRecordReaderCSV(t *testing.T) {
+	a := New(t)
+
+	_, err := NewRecordReader(strings.NewReader("dev_id,unknown\n"), FormatCSV)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = NewRecordReader(strings.NewReader(""), "xml")
+	a.So(err, ShouldNotBeNil)
+
+	reader, err := NewRecordReader(strings.NewReader(`dev_id,app_eui,dev_eui,app_key,latitude,attributes.type
+dev1,70B3D57EF0000001,0001020304050607,000102030405060708090A0B0C0D0E0F,52.37,valve
+dev2,70B3D57EF0000001,not-hex,,,
+dev3,70B3D57EF0000001
+`), FormatCSV)
+	a.So(err, ShouldBeNil)
+
+	record, err := reader.Read()
+	a.So(err, ShouldBeNil)
+	a.So(record.DevID, ShouldEqual, "dev1")
+	a.So(record.AppEUI, ShouldEqual, types.AppEUI{0x70, 0xB3, 0xD5, 0x7E, 0xF0, 0x00, 0x00, 0x01})
+	a.So(record.DevEUI, ShouldEqual, types.DevEUI{0, 1, 2, 3, 4, 5, 6, 7})
+	a.So(record.AppKey, ShouldEqual, types.AppKey{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15})
+	a.So(record.Latitude, ShouldEqual, float32(52.37))
+	a.So(record.Attributes, ShouldResemble, map[string]string{"type": "valve"})
+
+	_, err = reader.Read()
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+
+	_, err = reader.Read()
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+
+	_, err = reader.Read()
+	a.So(err, ShouldEqual, io.EOF)
+}
+
+func TestRecordReaderJSONLines(t *testing.T) {
+	a := New(t)
+
+	reader, err := NewRecordReader(strings.NewReader(`{"dev_id":"dev1","dev_addr":"26001ADA","attributes":{"type":"meter"}}
+
+{"dev_id":
+{"dev_id":"dev3"}
+`), FormatJSONLines)
+	a.So(err, ShouldBeNil)
+
+	record, err := reader.Read()
+	a.So(err, ShouldBeNil)
+	a.So(record.DevID, ShouldEqual, "dev1")
+	a.So(record.DevAddr, ShouldEqual, types.DevAddr{0x26, 0x00, 0x1A, 0xDA})
+	a.So(record.Attributes, ShouldResemble, map[string]string{"type": "meter"})
+
+	_, err = reader.Read()
+	a.So(errors.GetErrType(err), ShouldEqual, errors.InvalidArgument)
+
+	record, err = reader.Read()
+	a.So(err, ShouldBeNil)
+	a.So(record.DevID, ShouldEqual, "dev3")
+
+	_, err = reader.Read()
+	a.So(err, ShouldEqual, io.EOF)
+}
+
+func TestRecordRoundTrip(t *testing.T) {
+	a := New(t)
+
+	dev := &Device{
+		AppID:       "app1",
+		DevID:       "dev1",
+		Description: "Valve, north side",
+		AppEUI:      types.AppEUI{0x70, 0xB3, 0xD5, 0x7E, 0xF0, 0x00, 0x00, 0x01},
+		DevEUI:      types.DevEUI{0, 1, 2, 3, 4, 5, 6, 7},
+		DevAddr:     types.DevAddr{0x26, 0x00, 0x1A, 0xDA},
+		NwkSKey:     types.NwkSKey{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		AppSKey:     types.AppSKey{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+		Latitude:    52.37,
+		Longitude:   4.89,
+		Altitude:    12,
+		Options:     Options{ActivationConstraints: "local", DisableFCntCheck: true},
+		Attributes:  map[string]string{"type": "valve"},
+	}
+
+	for _, format := range []string{FormatCSV, FormatJSONLines} {
+		var buf bytes.Buffer
+		writer, err := NewRecordWriter(&buf, format, []string{"type"})
+		a.So(err, ShouldBeNil)
+		a.So(writer.Write(RecordFromDevice(dev)), ShouldBeNil)
+		a.So(writer.Flush(), ShouldBeNil)
+
+		reader, err := NewRecordReader(&buf, format)
+		a.So(err, ShouldBeNil)
+		record, err := reader.Read()
+		a.So(err, ShouldBeNil)
+		a.So(record, ShouldResemble, RecordFromDevice(dev))
+
+		imported := FromPb(record.ToPb("app1"))
+		a.So(imported.DevAddr, ShouldEqual, dev.DevAddr)
+		a.So(imported.Options, ShouldResemble, dev.Options)
+		a.So(imported.Attributes, ShouldResemble, dev.Attributes)
+	}
+}
@@ -82,6 +82,7 @@ func (d *Device) FromLoRaWANPb(lorawan *pb_lorawan.Device) {
 		d.NwkSKey = *lorawan.NwkSKey
 	}
 	d.FCntUp = lorawan.FCntUp
+	d.FCntDown = lorawan.FCntDown
 	d.Options = Options{
 		DisableFCntCheck:      lorawan.DisableFCntCheck,
 		Uses32BitFCnt:         lorawan.Uses32BitFCnt,
@@ -50,11 +50,38 @@ type Device struct {
 	FCntUp  uint32        `redis:"f_cnt_up"` // Only used to detect retries
 
 	CurrentDownlink *types.DownlinkMessage `redis:"current_downlink"`
+	// CurrentDownlinkAttempts is the number of times the current (confirmed) downlink was sent without ACK
+	CurrentDownlinkAttempts int `redis:"current_downlink_attempts"`
+
+	// ConfirmedDownlinkPending indicates that a confirmed downlink with ConfirmedDownlinkFCnt was sent, but not
+	// acknowledged yet. The NetworkServer keeps that FCnt for retransmissions, so any other downlink uses the next.
+	ConfirmedDownlinkPending bool   `redis:"confirmed_downlink_pending"`
+	ConfirmedDownlinkFCnt    uint32 `redis:"confirmed_downlink_f_cnt"`
+	// FCntDown is the FCnt that the NetworkServer uses for the next downlink, as far as the Handler knows. It is used
+	// for downlink that is not a response to an uplink, which does not get an FCnt from the NetworkServer.
+	FCntDown uint32 `redis:"f_cnt_down"`
 
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
 
 	Attributes map[string]string `redis:"attributes"`
+
+	// PayloadFormat overrides the payload format of the application for this device. It is empty to use the
+	// payload format of the application, a built-in payload format, or "custom:" followed by the name of a
+	// function set of the application.
+	PayloadFormat string `redis:"payload_format"`
+
+	// FunctionState is the JSON encoded state that the payload functions keep for this device
+	FunctionState string `redis:"function_state"`
+
+	// Class is the LoRaWAN class of the device. The queued downlink of Class C devices is sent without waiting
+	// for an uplink.
+	Class types.DeviceClass `redis:"class"`
+
+	// DeviceStatus is the status that the device reported at DeviceStatusTime, in response to a DevStatusReq of the
+	// NetworkServer
+	DeviceStatus     *types.DeviceStatus `redis:"device_status"`
+	DeviceStatusTime time.Time           `redis:"device_status_time"`
 }
 
 // StartUpdate stores the state of the device
@@ -72,6 +99,10 @@ func (d *Device) Clone() *Device {
 		n.CurrentDownlink = new(types.DownlinkMessage)
 		*n.CurrentDownlink = *d.CurrentDownlink
 	}
+	if d.DeviceStatus != nil {
+		n.DeviceStatus = new(types.DeviceStatus)
+		*n.DeviceStatus = *d.DeviceStatus
+	}
 	return n
 }
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/TheThingsNetwork/ttn/core/storage"
 	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
 )
 
 // DownlinkQueue stores the Downlink queue
@@ -18,6 +20,9 @@ type DownlinkQueue interface {
 	Replace(msg *types.DownlinkMessage) error
 	PushFirst(msg *types.DownlinkMessage) error
 	PushLast(msg *types.DownlinkMessage) error
+	List() ([]*types.DownlinkMessage, error)
+	Delete(id string) error
+	Flush() error
 }
 
 // RedisDownlinkQueue implements the downlink queue in Redis
@@ -60,20 +65,73 @@ func (s *RedisDownlinkQueue) Replace(msg *types.DownlinkMessage) error {
 	return s.PushFirst(msg)
 }
 
+// marshal the message, giving it an ID if it does not have one yet
+func (s *RedisDownlinkQueue) marshal(msg *types.DownlinkMessage) (string, error) {
+	if msg.ID == "" {
+		msg.ID = fmt.Sprintf("%x", random.Bytes(8))
+	}
+	qd, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(qd), nil
+}
+
 // PushFirst message to the downlink queue
 func (s *RedisDownlinkQueue) PushFirst(msg *types.DownlinkMessage) error {
-	qd, err := json.Marshal(msg)
+	qd, err := s.marshal(msg)
 	if err != nil {
 		return err
 	}
-	return s.queues.AddFront(s.key(), string(qd))
+	return s.queues.AddFront(s.key(), qd)
 }
 
 // PushLast message to the downlink queue
 func (s *RedisDownlinkQueue) PushLast(msg *types.DownlinkMessage) error {
-	qd, err := json.Marshal(msg)
+	qd, err := s.marshal(msg)
+	if err != nil {
+		return err
+	}
+	return s.queues.AddEnd(s.key(), qd)
+}
+
+// List the items in the downlink queue, without removing them
+func (s *RedisDownlinkQueue) List() ([]*types.DownlinkMessage, error) {
+	qd, err := s.queues.Get(s.key())
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]*types.DownlinkMessage, 0, len(qd))
+	for _, item := range qd {
+		msg := new(types.DownlinkMessage)
+		if err := json.Unmarshal([]byte(item), msg); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// Delete the item with the given ID from the downlink queue. It returns a NotFound error if there is no such item,
+// for example because it was sent in the meantime.
+func (s *RedisDownlinkQueue) Delete(id string) error {
+	qd, err := s.queues.Get(s.key())
 	if err != nil {
 		return err
 	}
-	return s.queues.AddEnd(s.key(), string(qd))
+	for _, item := range qd {
+		msg := new(types.DownlinkMessage)
+		if err := json.Unmarshal([]byte(item), msg); err != nil {
+			return err
+		}
+		if msg.ID == id {
+			return s.queues.Remove(s.key(), item)
+		}
+	}
+	return errors.NewErrNotFound(fmt.Sprintf("Downlink %s", id))
+}
+
+// Flush removes all items from the downlink queue
+func (s *RedisDownlinkQueue) Flush() error {
+	return s.queues.Delete(s.key())
 }
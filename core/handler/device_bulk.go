@@ -0,0 +1,251 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	"github.com/TheThingsNetwork/go-utils/grpc/ttnctx"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// deviceImport keeps the state of a bulk import of devices
+type deviceImport struct {
+	appID    string
+	dryRun   bool
+	existing map[string]bool   // DevIDs of devices that existed before the import
+	euis     map[string]string // DevIDs indexed by AppEUI and DevEUI
+	seen     map[string]bool   // DevIDs that were already in the import
+}
+
+// check returns an error if the device can not be imported
+func (i *deviceImport) check(in *device.Record) error {
+	if i.seen[in.DevID] {
+		return errors.NewErrAlreadyExists(fmt.Sprintf("Device %s earlier in the import", in.DevID))
+	}
+	if devID, ok := i.euis[euiKey(in.AppEUI, in.DevEUI)]; ok && devID != in.DevID {
+		return errors.NewErrAlreadyExists(fmt.Sprintf("Device %s with AppEUI and DevEUI", devID))
+	}
+	return nil
+}
+
+// importDevices reads the device records and, unless it is a dry run, creates or updates the devices. The
+// rights of the request must have been checked before.
+func (h *handlerManager) importDevices(ctx context.Context, appID string, reader device.RecordReader, dryRun bool) (*device.ImportResult, error) {
+	if _, err := h.handler.applications.Get(appID); err != nil {
+		return nil, errors.Wrap(err, "Application not registered to this Handler")
+	}
+	euis, err := h.deviceEUIs(appID)
+	if err != nil {
+		return nil, err
+	}
+	imp := &deviceImport{
+		appID:    appID,
+		dryRun:   dryRun,
+		existing: make(map[string]bool, len(euis)),
+		euis:     euis,
+		seen:     make(map[string]bool),
+	}
+	for _, devID := range euis {
+		imp.existing[devID] = true
+	}
+	token, _ := ttnctx.TokenFromIncomingContext(ctx)
+
+	result := &device.ImportResult{DryRun: dryRun}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil && errors.GetErrType(err) != errors.InvalidArgument {
+			return nil, err
+		}
+		result.Rows++
+		if err == nil {
+			err = h.importDevice(ctx, token, imp, record)
+		}
+		if err != nil {
+			result.Failed++
+			importErr := device.ImportError{Row: result.Rows, Error: err.Error()}
+			if record != nil {
+				importErr.DevID = record.DevID
+			}
+			result.Errors = append(result.Errors, importErr)
+			continue
+		}
+		if imp.existing[record.DevID] {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+	}
+
+	h.handler.Ctx.WithFields(ttnlog.Fields{
+		"AppID":   appID,
+		"DryRun":  dryRun,
+		"Created": result.Created,
+		"Updated": result.Updated,
+		"Failed":  result.Failed,
+	}).Info("Imported devices")
+
+	return result, nil
+}
+
+func (h *handlerManager) importDevice(ctx context.Context, token string, imp *deviceImport, record *device.Record) error {
+	in := record.ToPb(imp.appID)
+	if err := in.Validate(); err != nil {
+		return errors.Wrap(err, "Invalid Device")
+	}
+	if err := imp.check(record); err != nil {
+		return err
+	}
+	imp.seen[record.DevID] = true
+	if imp.dryRun {
+		imp.euis[euiKey(record.AppEUI, record.DevEUI)] = record.DevID
+		return nil
+	}
+	return h.setDevice(ctx, token, in, imp.euis)
+}
+
+// importStreamReader reads the data of an ImportDevices stream
+type importStreamReader struct {
+	stream pb_manager.ApplicationManager_ImportDevicesServer
+	data   []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = req.Data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// importResultToPb converts an import result to its proto
+func importResultToPb(result *device.ImportResult) *pb_manager.ImportDevicesResult {
+	pb := &pb_manager.ImportDevicesResult{
+		DryRun:  result.DryRun,
+		Rows:    uint32(result.Rows),
+		Created: uint32(result.Created),
+		Updated: uint32(result.Updated),
+		Failed:  uint32(result.Failed),
+	}
+	for _, importErr := range result.Errors {
+		pb.Errors = append(pb.Errors, &pb_manager.ImportDevicesError{
+			Row:   uint32(importErr.Row),
+			DevID: importErr.DevID,
+			Error: importErr.Error,
+		})
+	}
+	return pb
+}
+
+// bulkFormat returns the requested bulk format, which defaults to JSON Lines
+func bulkFormat(format string) string {
+	if format == "" {
+		return device.FormatJSONLines
+	}
+	return format
+}
+
+func (h *handlerManager) ImportDevices(stream pb_manager.ApplicationManager_ImportDevicesServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return errors.NewErrInvalidArgument("Import", "no devices")
+	}
+	if err != nil {
+		return err
+	}
+	if err := api.NotEmptyAndValidID(first.AppID, "AppID"); err != nil {
+		return errors.Wrap(err, "Invalid Import Devices Request")
+	}
+	ctx, _, err := h.validateAppRights(stream.Context(), first.AppID, rights.Devices)
+	if err != nil {
+		return err
+	}
+	reader, err := device.NewRecordReader(&importStreamReader{stream: stream, data: first.Data}, bulkFormat(first.Format))
+	if err != nil {
+		return err
+	}
+	result, err := h.importDevices(ctx, first.AppID, reader, first.DryRun)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(importResultToPb(result))
+}
+
+// exportStreamWriter writes to an ExportDevices stream
+type exportStreamWriter struct {
+	stream pb_manager.ApplicationManager_ExportDevicesServer
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	// The stream may still hold on to the message, so it gets a copy of the buffer
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.stream.Send(&pb_manager.ExportDevicesData{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// attributeKeys returns the sorted keys of the attributes of the devices, so that exports have stable columns
+func attributeKeys(devices []*device.Device) []string {
+	attributes := make(map[string]bool)
+	for _, dev := range devices {
+		for key := range dev.Attributes {
+			attributes[key] = true
+		}
+	}
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// exportBufferSize is the maximum size of the data in a message of an ExportDevices stream
+const exportBufferSize = 32 * 1024
+
+func (h *handlerManager) ExportDevices(in *pb_manager.ExportDevicesRequest, stream pb_manager.ApplicationManager_ExportDevicesServer) error {
+	if err := api.NotEmptyAndValidID(in.AppID, "AppID"); err != nil {
+		return errors.Wrap(err, "Invalid Export Devices Request")
+	}
+	if _, _, err := h.validateAppRights(stream.Context(), in.AppID, rights.Devices); err != nil {
+		return err
+	}
+	devices, err := h.handler.devices.ListForApp(in.AppID, nil)
+	if err != nil {
+		return err
+	}
+	buf := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportBufferSize)
+	writer, err := device.NewRecordWriter(buf, bulkFormat(in.Format), attributeKeys(devices))
+	if err != nil {
+		return err
+	}
+	for _, dev := range devices {
+		if err := writer.Write(device.RecordFromDevice(dev)); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
@@ -0,0 +1,79 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+	"golang.org/x/net/context"
+)
+
+func TestImportDevicesDryRun(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	h := &handlerManager{handler: &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestImportDevicesDryRun")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-import-devices"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-import-devices"),
+	}}
+
+	reader, _ := device.NewRecordReader(strings.NewReader(""), device.FormatJSONLines)
+	_, err := h.importDevices(context.Background(), appID, reader, true)
+	a.So(err, ShouldNotBeNil) // Application not registered
+
+	h.handler.applications.Set(&application.Application{AppID: appID})
+	defer h.handler.applications.Delete(appID)
+	h.handler.devices.Set(&device.Device{
+		AppID:  appID,
+		DevID:  "dev1",
+		AppEUI: types.AppEUI{1, 2, 3, 4, 5, 6, 7, 8},
+		DevEUI: types.DevEUI{1, 2, 3, 4, 5, 6, 7, 8},
+	})
+	defer h.handler.devices.Delete(appID, "dev1")
+
+	reader, err = device.NewRecordReader(strings.NewReader(`dev_id,app_eui,dev_eui,attributes.type
+dev1,0102030405060708,0102030405060708,valve
+dev2,0102030405060708,0102030405060709,valve
+dev3,0102030405060708,0102030405060708,valve
+dev2,0102030405060708,0102030405060710,meter
+Invalid ID,0102030405060708,0102030405060711,
+dev4,0102030405060708,not-hex,
+`), device.FormatCSV)
+	a.So(err, ShouldBeNil)
+
+	result, err := h.importDevices(context.Background(), appID, reader, true)
+	a.So(err, ShouldBeNil)
+	a.So(result.DryRun, ShouldBeTrue)
+	a.So(result.Rows, ShouldEqual, 6)
+	a.So(result.Updated, ShouldEqual, 1)
+	a.So(result.Created, ShouldEqual, 1)
+	a.So(result.Failed, ShouldEqual, 4)
+	a.So(result.Errors, ShouldHaveLength, 4)
+	a.So(result.Errors[0].Row, ShouldEqual, 3)
+	a.So(result.Errors[0].DevID, ShouldEqual, "dev3")
+	a.So(result.Errors[1].Row, ShouldEqual, 4)
+	a.So(result.Errors[2].Row, ShouldEqual, 5)
+	a.So(result.Errors[3].Row, ShouldEqual, 6)
+
+	// Nothing was stored
+	devices, _ := h.handler.devices.ListForApp(appID, nil)
+	a.So(devices, ShouldHaveLength, 1)
+}
+
+func TestAttributeKeys(t *testing.T) {
+	a := New(t)
+	a.So(attributeKeys(nil), ShouldBeEmpty)
+	a.So(attributeKeys([]*device.Device{
+		{Attributes: map[string]string{"type": "sensor", "floor": "2"}},
+		{Attributes: map[string]string{"room": "kitchen", "floor": "1"}},
+		{},
+	}), ShouldResemble, []string{"floor", "room", "type"})
+}
@@ -0,0 +1,138 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// MaxDeviceStateSize is the maximum size of the JSON encoded state that payload functions can keep per device
+var MaxDeviceStateSize = 1024
+
+// DeviceContext is the context of the device that payload functions receive as the device argument. The
+// functions can update the State, which is persisted for the next message of the device; changes to the other
+// fields are discarded.
+type DeviceContext struct {
+	AppID      string
+	DevID      string
+	Attributes map[string]string
+	// FCnt and Confirmed are those of the message; FCnt is not known for downlink messages
+	FCnt      uint32
+	Confirmed bool
+	// Metadata is the metadata of an uplink message
+	Metadata *types.Metadata
+	State    map[string]interface{}
+
+	object map[string]interface{}
+}
+
+// uplinkDeviceContext returns the device context for the payload functions of an uplink message
+func uplinkDeviceContext(dev *device.Device, appUp *types.UplinkMessage) *DeviceContext {
+	context := newDeviceContext(dev, appUp.AppID, appUp.DevID)
+	context.FCnt = appUp.FCnt
+	context.Confirmed = appUp.Confirmed
+	context.Metadata = &appUp.Metadata
+	return context
+}
+
+// downlinkDeviceContext returns the device context for the payload functions of a downlink message
+func downlinkDeviceContext(dev *device.Device, appDown *types.DownlinkMessage) *DeviceContext {
+	context := newDeviceContext(dev, appDown.AppID, appDown.DevID)
+	context.Confirmed = appDown.Confirmed
+	return context
+}
+
+func newDeviceContext(dev *device.Device, appID, devID string) *DeviceContext {
+	context := &DeviceContext{
+		AppID: appID,
+		DevID: devID,
+		State: make(map[string]interface{}),
+	}
+	if dev == nil {
+		return context
+	}
+	context.Attributes = dev.Attributes
+	if dev.FunctionState != "" {
+		if err := json.Unmarshal([]byte(dev.FunctionState), &context.State); err != nil {
+			// A state that can not be decoded is reset
+			context.State = make(map[string]interface{})
+		}
+	}
+	return context
+}
+
+// env returns the device argument of the payload functions, which is undefined if there is no context. The same
+// object is returned until the state is read with updateState, so that all functions that handle a message share
+// the state.
+func (c *DeviceContext) env() interface{} {
+	if c == nil {
+		return nil
+	}
+	if c.object != nil {
+		return c.object
+	}
+	attributes := make(map[string]interface{}, len(c.Attributes))
+	for key, value := range c.Attributes {
+		attributes[key] = value
+	}
+	c.object = map[string]interface{}{
+		"app_id":     c.AppID,
+		"dev_id":     c.DevID,
+		"attributes": attributes,
+		"counter":    c.FCnt,
+		"confirmed":  c.Confirmed,
+		"state":      c.State,
+	}
+	if c.Metadata != nil {
+		var metadata map[string]interface{}
+		if data, err := json.Marshal(c.Metadata); err == nil {
+			json.Unmarshal(data, &metadata)
+		}
+		c.object["metadata"] = metadata
+	}
+	return c.object
+}
+
+// updateState reads the state from the device argument after the payload functions ran
+func (c *DeviceContext) updateState() error {
+	if c == nil || c.object == nil {
+		return nil
+	}
+	state := c.object["state"]
+	c.object = nil
+	switch state := state.(type) {
+	case map[string]interface{}:
+		c.State = state
+	case nil:
+		c.State = make(map[string]interface{})
+	default:
+		return errors.NewErrInvalidArgument("Device State", "must be an object")
+	}
+	return nil
+}
+
+// saveState stores the state in the device. The device is saved by the caller.
+func (c *DeviceContext) saveState(dev *device.Device) error {
+	if dev == nil {
+		return nil
+	}
+	var encoded string
+	if len(c.State) > 0 {
+		data, err := json.Marshal(c.State)
+		if err != nil {
+			return errors.NewErrInvalidArgument("Device State", err.Error())
+		}
+		if len(data) > MaxDeviceStateSize {
+			return errors.NewErrInvalidArgument("Device State", fmt.Sprintf("exceeds maximum size of %d bytes", MaxDeviceStateSize))
+		}
+		encoded = string(data)
+	}
+	dev.FunctionState = encoded
+	return nil
+}
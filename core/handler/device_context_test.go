@@ -0,0 +1,98 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeviceContextDecode(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{
+		AppID:      "app",
+		DevID:      "dev",
+		Attributes: map[string]string{"model": "meter"},
+	}
+	decoder := `function Decoder (payload, port, device) {
+  var value = (device.state.last || 0) + payload[0];
+  device.state.last = value;
+  device.attributes.model = "changed";
+  return { value: value, dev_id: device.dev_id, model: device.attributes.model, counter: device.counter };
+}`
+
+	for i, expected := range []int{5, 12} {
+		appUp := &types.UplinkMessage{AppID: "app", DevID: "dev", FCnt: uint32(i + 1)}
+		context := uplinkDeviceContext(dev, appUp)
+		functions := &CustomUplinkFunctions{Decoder: decoder, Device: context}
+		fields, valid, err := functions.Decode([]byte{byte(5 + 2*i)}, 1)
+		a.So(err, ShouldBeNil)
+		a.So(valid, ShouldBeTrue)
+		a.So(fields["value"], ShouldEqual, expected)
+		a.So(fields["dev_id"], ShouldEqual, "dev")
+		a.So(fields["counter"], ShouldEqual, i+1)
+		a.So(context.saveState(dev), ShouldBeNil)
+	}
+
+	a.So(dev.FunctionState, ShouldEqual, `{"last":12}`)
+	a.So(dev.Attributes["model"], ShouldEqual, "meter")
+}
+
+func TestDeviceContextEncode(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{AppID: "app", DevID: "dev", FunctionState: `{"sequence":1}`}
+	context := downlinkDeviceContext(dev, &types.DownlinkMessage{AppID: "app", DevID: "dev", Confirmed: true})
+	functions := &CustomDownlinkFunctions{
+		Encoder: `function Encoder (payload, port, device) {
+  device.state.sequence++;
+  return [device.state.sequence, device.confirmed ? 1 : 0];
+}`,
+		Device: context,
+	}
+	payload, _, err := functions.Encode(map[string]interface{}{}, 1)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{2, 1})
+	a.So(context.saveState(dev), ShouldBeNil)
+	a.So(dev.FunctionState, ShouldEqual, `{"sequence":2}`)
+}
+
+func TestDeviceContextState(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{AppID: "app", DevID: "dev"}
+
+	// State must be an object
+	{
+		functions := &CustomUplinkFunctions{
+			Decoder: `function Decoder (payload, port, device) { device.state = 1; return {}; }`,
+			Device:  uplinkDeviceContext(dev, &types.UplinkMessage{AppID: "app", DevID: "dev"}),
+		}
+		_, _, err := functions.Decode([]byte{}, 1)
+		a.So(err, ShouldNotBeNil)
+	}
+
+	// State is limited in size
+	{
+		context := uplinkDeviceContext(dev, &types.UplinkMessage{AppID: "app", DevID: "dev"})
+		context.State["data"] = strings.Repeat("x", MaxDeviceStateSize)
+		a.So(context.saveState(dev), ShouldNotBeNil)
+		a.So(dev.FunctionState, ShouldBeEmpty)
+	}
+
+	// Functions without device context
+	{
+		functions := &CustomUplinkFunctions{
+			Decoder: `function Decoder (payload, port, device) { return { device: device }; }`,
+		}
+		fields, _, err := functions.Decode([]byte{}, 1)
+		a.So(err, ShouldBeNil)
+		a.So(fields["device"], ShouldBeNil)
+	}
+}
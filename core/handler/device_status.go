@@ -0,0 +1,36 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+func (h *handlerManager) GetDeviceStatus(ctx context.Context, in *pb_manager.DeviceIdentifier) (*pb_manager.DeviceStatus, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	dev, err := h.handler.devices.Get(in.AppID, in.DevID)
+	if err != nil {
+		return nil, err
+	}
+	if dev.DeviceStatus == nil {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Status of device %s", in.DevID))
+	}
+	return &pb_manager.DeviceStatus{
+		AppID:   in.AppID,
+		DevID:   in.DevID,
+		Battery: uint32(dev.DeviceStatus.Battery),
+		Margin:  int32(dev.DeviceStatus.Margin),
+		Time:    dev.DeviceStatusTime.UnixNano(),
+	}, nil
+}
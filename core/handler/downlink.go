@@ -4,15 +4,31 @@
 package handler
 
 import (
+	"fmt"
 	"time"
 
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	"github.com/TheThingsNetwork/api/trace"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/group"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
+// DownlinkMaxRetries is the default maximum number of retransmissions of a confirmed downlink
+var DownlinkMaxRetries = 8
+
+// DownlinkMaxRetriesLimit is the highest maximum number of retransmissions that can be set on a downlink
+var DownlinkMaxRetriesLimit = 32
+
+// downlinkMaxRetries returns the maximum number of retransmissions of the downlink
+func downlinkMaxRetries(msg *types.DownlinkMessage) int {
+	if msg.MaxRetries != nil {
+		return *msg.MaxRetries
+	}
+	return DownlinkMaxRetries
+}
+
 func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error) {
 	appID, devID := appDownlink.AppID, appDownlink.DevID
 	ctx := h.Ctx.WithFields(ttnlog.Fields{
@@ -29,6 +45,9 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 		}
 	}()
 
+	unlock := h.lockDevice(appID, devID)
+	defer unlock()
+
 	// Check if device exists
 	dev, err := h.devices.Get(appID, devID)
 	if err != nil {
@@ -58,6 +77,26 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 	appDownlink.AppID = ""
 	appDownlink.DevID = ""
 
+	if appDownlink.TTL != "" {
+		ttl, parseErr := time.ParseDuration(appDownlink.TTL)
+		if parseErr != nil || ttl <= 0 {
+			return errors.NewErrInvalidArgument("TTL", "should be a positive duration")
+		}
+		expiresAt := time.Now().Add(ttl)
+		appDownlink.ExpiresAt = &expiresAt
+		appDownlink.TTL = ""
+	}
+	if appDownlink.Expired(time.Now()) {
+		return errors.NewErrInvalidArgument("Downlink", "already expired")
+	}
+	if appDownlink.MaxRetries != nil && (*appDownlink.MaxRetries < 0 || *appDownlink.MaxRetries > DownlinkMaxRetriesLimit) {
+		return errors.NewErrInvalidArgument("MaxRetries", fmt.Sprintf("should be between 0 and %d", DownlinkMaxRetriesLimit))
+	}
+
+	if err := h.takeDownlinkQuota(appID, devID, appDownlink); err != nil {
+		return err
+	}
+
 	queue, err := h.devices.DownlinkQueue(appID, devID)
 	if err != nil {
 		return err
@@ -69,6 +108,7 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 	switch schedule {
 	case types.ScheduleReplace, "": // Empty string for default
 		dev.CurrentDownlink = nil
+		dev.CurrentDownlinkAttempts = 0
 		err = queue.Replace(appDownlink)
 	case types.ScheduleFirst:
 		err = queue.PushFirst(appDownlink)
@@ -94,6 +134,10 @@ func (h *handler) EnqueueDownlink(appDownlink *types.DownlinkMessage) (err error
 			Message: appDownlink,
 		},
 	}
+
+	if dev.Class.ReceivesDownlinkWithoutUplink() {
+		go h.sendClassCDownlink(appID, devID)
+	}
 	return nil
 }
 
@@ -169,6 +213,18 @@ func (h *handler) HandleDownlink(appDownlink *types.DownlinkMessage, downlink *p
 
 	h.downlink <- downlink
 
+	// The airtime of the first transmission of an enqueued message is counted when it is enqueued
+	if dev.CurrentDownlinkAttempts > 0 || len(appDownlink.PayloadRaw) == 0 {
+		h.addDownlinkAirtime(appID, devID, downlink)
+	}
+
+	if dev.CurrentDownlinkAttempts == 0 {
+		h.countGroupDownlink(appID, appDownlink, group.Sent)
+	}
+	if appDownlink.Confirmed && dev.CurrentDownlink != nil {
+		dev.CurrentDownlinkAttempts++
+	}
+
 	downlinkConfig := types.DownlinkEventConfigInfo{}
 
 	if downlink.DownlinkOption.ProtocolConfiguration != nil {
@@ -0,0 +1,219 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// DownlinkQueue is the downlink state of a device: the downlink that is currently being sent, the number of times
+// it was sent without ACK, and the queue
+type DownlinkQueue struct {
+	Current  *types.DownlinkMessage   `json:"current,omitempty"`
+	Attempts int                      `json:"attempts,omitempty"`
+	Queue    []*types.DownlinkMessage `json:"queue"`
+}
+
+// nextDownlink returns the next downlink from the queue, dropping downlinks that expired
+func (h *handler) nextDownlink(queue device.DownlinkQueue, appID, devID string) (*types.DownlinkMessage, error) {
+	for {
+		next, err := queue.Next()
+		if err != nil || next == nil {
+			return nil, err
+		}
+		if !next.Expired(time.Now()) {
+			return next, nil
+		}
+		h.expireDownlink(appID, devID, next)
+	}
+}
+
+// expireDownlink publishes the event for a downlink that expired before it could be sent
+func (h *handler) expireDownlink(appID, devID string, msg *types.DownlinkMessage) {
+	h.Ctx.WithFields(ttnlog.Fields{
+		"AppID": appID,
+		"DevID": devID,
+	}).Debug("Dropped expired downlink")
+	h.qEvent <- &types.DeviceEvent{
+		AppID: appID,
+		DevID: devID,
+		Event: types.DownlinkExpiredEvent,
+		Data: types.DownlinkEventData{
+			Message: msg,
+		},
+	}
+}
+
+func (h *handler) getDownlinkQueue(appID, devID string) (*DownlinkQueue, error) {
+	dev, err := h.devices.Get(appID, devID)
+	if err != nil {
+		return nil, err
+	}
+	queue, err := h.devices.DownlinkQueue(appID, devID)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := queue.List()
+	if err != nil {
+		return nil, err
+	}
+	return &DownlinkQueue{
+		Current:  dev.CurrentDownlink,
+		Attempts: dev.CurrentDownlinkAttempts,
+		Queue:    msgs,
+	}, nil
+}
+
+// deleteQueuedDownlink removes the queued downlink message with the given ID
+func (h *handler) deleteQueuedDownlink(appID, devID string, id string) error {
+	unlock := h.lockDevice(appID, devID)
+	defer unlock()
+	if _, err := h.devices.Get(appID, devID); err != nil {
+		return err
+	}
+	queue, err := h.devices.DownlinkQueue(appID, devID)
+	if err != nil {
+		return err
+	}
+	return queue.Delete(id)
+}
+
+// flushDownlinkQueue removes all queued downlink messages and the current downlink of the device
+func (h *handler) flushDownlinkQueue(appID, devID string) error {
+	unlock := h.lockDevice(appID, devID)
+	defer unlock()
+	dev, err := h.devices.Get(appID, devID)
+	if err != nil {
+		return err
+	}
+	dev.StartUpdate()
+	queue, err := h.devices.DownlinkQueue(appID, devID)
+	if err != nil {
+		return err
+	}
+	if err := queue.Flush(); err != nil {
+		return err
+	}
+	dev.CurrentDownlink = nil
+	dev.CurrentDownlinkAttempts = 0
+	return h.devices.Set(dev)
+}
+
+// downlinkMessageToPb converts a downlink message to its proto
+func downlinkMessageToPb(msg *types.DownlinkMessage) (*pb_manager.DownlinkMessage, error) {
+	pb := &pb_manager.DownlinkMessage{
+		ID:              msg.ID,
+		FPort:           uint32(msg.FPort),
+		Confirmed:       msg.Confirmed,
+		Schedule:        string(msg.Schedule),
+		PayloadRaw:      msg.PayloadRaw,
+		TTL:             msg.TTL,
+		GroupDownlinkID: msg.GroupDownlinkID,
+	}
+	if len(msg.PayloadFields) > 0 {
+		fields, err := json.Marshal(msg.PayloadFields)
+		if err != nil {
+			return nil, err
+		}
+		pb.PayloadFields = string(fields)
+	}
+	if msg.ExpiresAt != nil {
+		pb.ExpiresAt = msg.ExpiresAt.UnixNano()
+	}
+	if msg.MaxRetries != nil {
+		pb.MaxRetries = &gogo.Int32Value{Value: int32(*msg.MaxRetries)}
+	}
+	return pb, nil
+}
+
+// downlinkMessageFromPb converts a downlink message proto to a downlink message
+func downlinkMessageFromPb(pb *pb_manager.DownlinkMessage) (*types.DownlinkMessage, error) {
+	if pb.FPort > 255 {
+		return nil, errors.NewErrInvalidArgument("FPort", "must be at most 255")
+	}
+	msg := &types.DownlinkMessage{
+		FPort:      uint8(pb.FPort),
+		Confirmed:  pb.Confirmed,
+		Schedule:   types.ScheduleType(pb.Schedule),
+		PayloadRaw: pb.PayloadRaw,
+		TTL:        pb.TTL,
+	}
+	if pb.PayloadFields != "" {
+		if err := json.Unmarshal([]byte(pb.PayloadFields), &msg.PayloadFields); err != nil {
+			return nil, errors.NewErrInvalidArgument("PayloadFields", "must be a JSON object")
+		}
+	}
+	if pb.ExpiresAt != 0 {
+		expiresAt := time.Unix(0, pb.ExpiresAt)
+		msg.ExpiresAt = &expiresAt
+	}
+	if pb.MaxRetries != nil {
+		maxRetries := int(pb.MaxRetries.Value)
+		msg.MaxRetries = &maxRetries
+	}
+	return msg, nil
+}
+
+func (h *handlerManager) GetDownlinkQueue(ctx context.Context, in *pb_manager.DeviceIdentifier) (*pb_manager.DownlinkQueue, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	queue, err := h.handler.getDownlinkQueue(in.AppID, in.DevID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.DownlinkQueue{Attempts: uint32(queue.Attempts)}
+	if queue.Current != nil {
+		if res.Current, err = downlinkMessageToPb(queue.Current); err != nil {
+			return nil, err
+		}
+	}
+	for _, msg := range queue.Queue {
+		pb, err := downlinkMessageToPb(msg)
+		if err != nil {
+			return nil, err
+		}
+		res.Queue = append(res.Queue, pb)
+	}
+	return res, nil
+}
+
+func (h *handlerManager) DeleteQueuedDownlink(ctx context.Context, in *pb_manager.QueuedDownlinkIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Queued Downlink Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rightMessagesDown); err != nil {
+		return nil, err
+	}
+	if err := h.handler.deleteQueuedDownlink(in.AppID, in.DevID, in.ID); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) FlushDownlinkQueue(ctx context.Context, in *pb_manager.DeviceIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rightMessagesDown); err != nil {
+		return nil, err
+	}
+	if err := h.handler.flushDownlinkQueue(in.AppID, in.DevID); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
@@ -0,0 +1,132 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	gogo "github.com/gogo/protobuf/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestManageDownlinkQueue(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestManageDownlinkQueue")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-manage-downlink-queue"),
+	}
+
+	_, err := h.getDownlinkQueue(appID, devID)
+	a.So(err, ShouldNotBeNil)
+
+	h.devices.Set(&device.Device{
+		AppID:           appID,
+		DevID:           devID,
+		CurrentDownlink: &types.DownlinkMessage{PayloadRaw: []byte{0x00}},
+	})
+	defer func() {
+		h.devices.Delete(appID, devID)
+	}()
+	queue, _ := h.devices.DownlinkQueue(appID, devID)
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x01}})
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x02}})
+
+	q, err := h.getDownlinkQueue(appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(q.Current, ShouldNotBeNil)
+	a.So(q.Current.PayloadRaw, ShouldResemble, []byte{0x00})
+	a.So(q.Queue, ShouldHaveLength, 2)
+
+	first := q.Queue[0].ID
+	a.So(first, ShouldNotBeEmpty)
+	err = h.deleteQueuedDownlink(appID, devID, first)
+	a.So(err, ShouldBeNil)
+	q, _ = h.getDownlinkQueue(appID, devID)
+	a.So(q.Queue, ShouldHaveLength, 1)
+	a.So(q.Queue[0].PayloadRaw, ShouldResemble, []byte{0x02})
+
+	err = h.deleteQueuedDownlink(appID, devID, first)
+	a.So(err, ShouldNotBeNil)
+
+	err = h.flushDownlinkQueue(appID, devID)
+	a.So(err, ShouldBeNil)
+	q, _ = h.getDownlinkQueue(appID, devID)
+	a.So(q.Current, ShouldBeNil)
+	a.So(q.Queue, ShouldBeEmpty)
+}
+
+func TestNextDownlink(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestNextDownlink")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-next-downlink"),
+		qEvent:    make(chan *types.DeviceEvent, 10),
+	}
+	defer func() {
+		h.devices.Delete(appID, devID)
+	}()
+
+	expired := time.Now().Add(-1 * time.Minute)
+	valid := time.Now().Add(time.Minute)
+	queue, _ := h.devices.DownlinkQueue(appID, devID)
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x01}, ExpiresAt: &expired})
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x02}, ExpiresAt: &valid})
+	queue.PushLast(&types.DownlinkMessage{PayloadRaw: []byte{0x03}, ExpiresAt: &expired})
+
+	next, err := h.nextDownlink(queue, appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(next, ShouldNotBeNil)
+	a.So(next.PayloadRaw, ShouldResemble, []byte{0x02})
+
+	event := <-h.qEvent
+	a.So(event.Event, ShouldEqual, types.DownlinkExpiredEvent)
+	a.So(event.Data.(types.DownlinkEventData).Message.PayloadRaw, ShouldResemble, []byte{0x01})
+
+	next, err = h.nextDownlink(queue, appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(next, ShouldBeNil)
+
+	event = <-h.qEvent
+	a.So(event.Event, ShouldEqual, types.DownlinkExpiredEvent)
+	a.So(event.Data.(types.DownlinkEventData).Message.PayloadRaw, ShouldResemble, []byte{0x03})
+}
+
+func TestDownlinkMessageFromPb(t *testing.T) {
+	a := New(t)
+	msg, err := downlinkMessageFromPb(&pb_manager.DownlinkMessage{
+		FPort:         1,
+		Confirmed:     true,
+		PayloadFields: `{"led":"on"}`,
+		ExpiresAt:     1500000000000000000,
+		MaxRetries:    &gogo.Int32Value{Value: 3},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(msg.FPort, ShouldEqual, 1)
+	a.So(msg.Confirmed, ShouldBeTrue)
+	a.So(msg.PayloadFields, ShouldResemble, map[string]interface{}{"led": "on"})
+	a.So(msg.ExpiresAt.UnixNano(), ShouldEqual, 1500000000000000000)
+	a.So(*msg.MaxRetries, ShouldEqual, 3)
+
+	msg, err = downlinkMessageFromPb(&pb_manager.DownlinkMessage{FPort: 1, PayloadRaw: []byte{0x01}})
+	a.So(err, ShouldBeNil)
+	a.So(msg.PayloadFields, ShouldBeNil)
+	a.So(msg.ExpiresAt, ShouldBeNil)
+	a.So(msg.MaxRetries, ShouldBeNil)
+
+	_, err = downlinkMessageFromPb(&pb_manager.DownlinkMessage{FPort: 256})
+	a.So(err, ShouldNotBeNil)
+
+	_, err = downlinkMessageFromPb(&pb_manager.DownlinkMessage{FPort: 1, PayloadFields: `[1]`})
+	a.So(err, ShouldNotBeNil)
+}
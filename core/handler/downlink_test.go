@@ -23,7 +23,7 @@ func TestEnqueueDownlink(t *testing.T) {
 	h := &handler{
 		Component: &component.Component{Ctx: GetLogger(t, "TestEnqueueDownlink")},
 		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-enqueue-downlink"),
-		qEvent:    make(chan *types.DeviceEvent, 10),
+		qEvent:    make(chan *types.DeviceEvent, 20),
 	}
 	err := h.EnqueueDownlink(&types.DownlinkMessage{
 		AppID: appID,
@@ -91,6 +91,60 @@ func TestEnqueueDownlink(t *testing.T) {
 	downlink, _ := queue.Next()
 	a.So(downlink, ShouldNotBeNil)
 	a.So(downlink.PayloadFields, ShouldHaveLength, 3)
+
+	err = h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:      appID,
+		DevID:      devID,
+		PayloadRaw: []byte{0x03},
+		TTL:        "1h",
+	})
+	a.So(err, ShouldBeNil)
+	downlink, _ = queue.Next()
+	a.So(downlink, ShouldNotBeNil)
+	a.So(downlink.TTL, ShouldBeEmpty)
+	a.So(downlink.ExpiresAt, ShouldNotBeNil)
+	a.So(*downlink.ExpiresAt, ShouldHappenWithin, time.Second, time.Now().Add(time.Hour))
+
+	err = h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:      appID,
+		DevID:      devID,
+		PayloadRaw: []byte{0x03},
+		TTL:        "forever",
+	})
+	a.So(err, ShouldNotBeNil)
+
+	expiresAt := time.Now().Add(-1 * time.Minute)
+	err = h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:      appID,
+		DevID:      devID,
+		PayloadRaw: []byte{0x03},
+		ExpiresAt:  &expiresAt,
+	})
+	a.So(err, ShouldNotBeNil)
+
+	for _, maxRetries := range []int{-1, DownlinkMaxRetriesLimit + 1} {
+		maxRetries := maxRetries
+		err = h.EnqueueDownlink(&types.DownlinkMessage{
+			AppID:      appID,
+			DevID:      devID,
+			PayloadRaw: []byte{0x03},
+			MaxRetries: &maxRetries,
+		})
+		a.So(err, ShouldNotBeNil)
+	}
+
+	maxRetries := 2
+	err = h.EnqueueDownlink(&types.DownlinkMessage{
+		AppID:      appID,
+		DevID:      devID,
+		PayloadRaw: []byte{0x03},
+		Confirmed:  true,
+		MaxRetries: &maxRetries,
+	})
+	a.So(err, ShouldBeNil)
+	downlink, _ = queue.Next()
+	a.So(downlink, ShouldNotBeNil)
+	a.So(*downlink.MaxRetries, ShouldEqual, 2)
 }
 
 func TestHandleDownlink(t *testing.T) {
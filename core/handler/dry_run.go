@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 
 	pb "github.com/TheThingsNetwork/api/handler"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
 	"github.com/TheThingsNetwork/ttn/core/handler/cayennelpp"
 	"github.com/TheThingsNetwork/ttn/core/handler/functions"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
@@ -17,6 +19,11 @@ import (
 // DryUplink converts the uplink message payload by running the payload
 // functions that are provided in the DryUplinkMessage, without actually going to the network.
 // This is helpful for testing the payload functions without having to save them.
+// For the binary payload format, the Decoder of the application contains the binary format, or is empty to use
+// the binary format of the application.
+// If the application has functions per FPort, the provided functions should be those for the Port of the
+// message, with the default functions filled in (see application.Application.FunctionsForPort).
+// The functions receive a device context without attributes and with an empty state.
 func (h *handlerManager) DryUplink(ctx context.Context, in *pb.DryUplinkMessage) (*pb.DryUplinkResult, error) {
 	app := in.App
 
@@ -32,9 +39,16 @@ func (h *handlerManager) DryUplink(ctx context.Context, in *pb.DryUplinkMessage)
 				Converter: app.Converter,
 				Validator: app.Validator,
 				Logger:    functions.NewEntryLogger(),
+				Device:    newDeviceContext(nil, app.AppID, ""),
 			}
 		case application.PayloadFormatCayenneLPP:
 			decoder = &cayennelpp.Decoder{}
+		case application.PayloadFormatBinary:
+			format, err := h.dryRunBinaryFormat(ctx, app)
+			if err != nil {
+				return nil, err
+			}
+			decoder = &binaryformat.Decoder{Format: format}
 		default:
 			return nil, errors.NewErrInvalidArgument("App", "unknown payload format")
 		}
@@ -66,6 +80,8 @@ func (h *handlerManager) DryUplink(ctx context.Context, in *pb.DryUplinkMessage)
 // DryDownlink converts the downlink message payload by running the payload
 // functions that are provided in the DryDownlinkMessage, without actually going to the network.
 // This is helpful for testing the payload functions without having to save them.
+// For the binary payload format, see DryUplink.
+// If the application has functions per FPort, the provided Encoder should be the one for the Port of the message.
 func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMessage) (*pb.DryDownlinkResult, error) {
 	app := in.App
 
@@ -92,9 +108,16 @@ func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMess
 		encoder = &CustomDownlinkFunctions{
 			Encoder: app.Encoder,
 			Logger:  functions.NewEntryLogger(),
+			Device:  newDeviceContext(nil, app.AppID, ""),
 		}
 	case application.PayloadFormatCayenneLPP:
 		encoder = &cayennelpp.Encoder{}
+	case application.PayloadFormatBinary:
+		format, err := h.dryRunBinaryFormat(ctx, app)
+		if err != nil {
+			return nil, err
+		}
+		encoder = &binaryformat.Encoder{Format: format}
 	default:
 		return nil, errors.NewErrInvalidArgument("App", "unknown payload format")
 	}
@@ -115,3 +138,26 @@ func (h *handlerManager) DryDownlink(ctx context.Context, in *pb.DryDownlinkMess
 		Logs:    encoder.Log(),
 	}, nil
 }
+
+// dryRunBinaryFormat returns the binary format in the Decoder of the application, or the binary format that is
+// saved for the application if the Decoder is empty
+func (h *handlerManager) dryRunBinaryFormat(ctx context.Context, app *pb.Application) (*binaryformat.Format, error) {
+	if app.Decoder != "" {
+		format, err := binaryformat.Parse(app.Decoder)
+		if err != nil {
+			return nil, errors.Wrap(err, "Invalid Binary Format")
+		}
+		return format, nil
+	}
+	if _, _, err := h.validateAppRights(ctx, app.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	saved, err := h.handler.applications.Get(app.AppID)
+	if err != nil {
+		return nil, err
+	}
+	if saved.BinaryFormat == nil {
+		return nil, errors.NewErrInvalidArgument("App", "application does not have a binary format")
+	}
+	return saved.BinaryFormat, nil
+}
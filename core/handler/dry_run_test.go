@@ -139,6 +139,42 @@ func TestDryUplinkFieldsCayenneLPP(t *testing.T) {
 	a.So(res.Valid, ShouldBeTrue)
 }
 
+func TestDryUplinkFieldsBinary(t *testing.T) {
+	a := New(t)
+
+	store := newCountingStore(application.NewRedisApplicationStore(GetRedisClient(), "handler-test-dry-uplink"))
+	h := &handler{
+		applications: store,
+	}
+	m := &handlerManager{handler: h}
+
+	dryUplinkMessage := &pb.DryUplinkMessage{
+		Payload: []byte{0x09, 0x92, 0x01},
+		Port:    1,
+		App: &pb.Application{
+			AppID:         "DryUplinkFields",
+			PayloadFormat: "binary",
+			Decoder:       `{"uplink":{"1":[{"name":"temperature","offset":0,"width":2,"signed":true,"scale":0.01},{"name":"on","offset":2,"width":1,"bits":1}]}}`,
+		},
+	}
+
+	res, err := m.DryUplink(context.TODO(), dryUplinkMessage)
+	a.So(err, ShouldBeNil)
+
+	a.So(res.Payload, ShouldResemble, dryUplinkMessage.Payload)
+	a.So(res.Fields, ShouldEqual, `{"on":1,"temperature":24.5}`)
+	a.So(res.Valid, ShouldBeTrue)
+
+	dryUplinkMessage.App.Decoder = `{"uplink":{"1":[{"name":"temperature","width":9}]}}`
+	_, err = m.DryUplink(context.TODO(), dryUplinkMessage)
+	a.So(err, ShouldNotBeNil)
+
+	// The binary format of the application is only used with rights to its settings
+	dryUplinkMessage.App.Decoder = ""
+	_, err = m.DryUplink(context.TODO(), dryUplinkMessage)
+	a.So(err, ShouldNotBeNil)
+}
+
 func TestDryUplinkEmptyApp(t *testing.T) {
 	a := New(t)
 
@@ -227,6 +263,30 @@ func TestDryDownlinkFieldsCayenneLPP(t *testing.T) {
 	a.So(res.Payload, ShouldResemble, []byte{5, 249, 232})
 }
 
+func TestDryDownlinkFieldsBinary(t *testing.T) {
+	a := New(t)
+
+	store := newCountingStore(application.NewRedisApplicationStore(GetRedisClient(), "handler-test-dry-downlink"))
+	h := &handler{
+		applications: store,
+	}
+	m := &handlerManager{handler: h}
+
+	msg := &pb.DryDownlinkMessage{
+		Fields: `{ "interval": 600 }`,
+		Port:   1,
+		App: &pb.Application{
+			PayloadFormat: "binary",
+			Decoder:       `{"downlink":{"1":[{"name":"interval","offset":0,"width":2,"endian":"little"}]}}`,
+		},
+	}
+
+	res, err := m.DryDownlink(context.TODO(), msg)
+	a.So(err, ShouldBeNil)
+
+	a.So(res.Payload, ShouldResemble, []byte{0x58, 0x02})
+}
+
 func TestDryDownlinkPayload(t *testing.T) {
 	a := New(t)
 
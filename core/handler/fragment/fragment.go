@@ -0,0 +1,80 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package fragment
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Fragment header formats
+const (
+	// HeaderIndexTotal is a header of two bytes: the index of the fragment (starting at 0) and the total
+	// number of fragments
+	HeaderIndexTotal = "index_total"
+	// HeaderLoRaAlliance is the FragIndexAndSessionCnt header of a DataFragment of the LoRa Alliance
+	// Fragmented Data Block Transport: two bytes (little endian), with the session in bits 15:14 and the
+	// index of the fragment (starting at 1) in bits 13:0. The total number of fragments is not in the header,
+	// but configured.
+	HeaderLoRaAlliance = "lora_alliance"
+)
+
+// HeaderSize is the size of the supported fragment headers
+const HeaderSize = 2
+
+// Fragment is a part of a payload
+type Fragment struct {
+	// Session distinguishes subsequent payloads of a device
+	Session uint8
+	// Index of the fragment, starting at 0
+	Index int
+	// Total number of fragments of the payload
+	Total   int
+	Payload []byte
+}
+
+// ValidateHeader returns an error if the header format is not supported, or if the total number of fragments
+// is needed but not set
+func ValidateHeader(header string, total int) error {
+	switch header {
+	case HeaderIndexTotal:
+		return nil
+	case HeaderLoRaAlliance:
+		if total < 1 || total > 1<<14 {
+			return errors.NewErrInvalidArgument("Fragments", fmt.Sprintf("must be between 1 and %d", 1<<14))
+		}
+		return nil
+	}
+	return errors.NewErrInvalidArgument("Fragment Header", "unknown header format "+header)
+}
+
+// Parse a fragment with the given header format from the payload. The total number of fragments is only used
+// for headers that do not contain it.
+func Parse(header string, total int, payload []byte) (*Fragment, error) {
+	if err := ValidateHeader(header, total); err != nil {
+		return nil, err
+	}
+	if len(payload) < HeaderSize {
+		return nil, errors.NewErrInvalidArgument("Fragment", "payload too short for header")
+	}
+	fragment := &Fragment{
+		Payload: payload[HeaderSize:],
+	}
+	switch header {
+	case HeaderIndexTotal:
+		fragment.Index = int(payload[0])
+		fragment.Total = int(payload[1])
+	case HeaderLoRaAlliance:
+		indexAndSession := binary.LittleEndian.Uint16(payload)
+		fragment.Session = uint8(indexAndSession >> 14)
+		fragment.Index = int(indexAndSession&0x3fff) - 1
+		fragment.Total = total
+	}
+	if fragment.Total < 1 || fragment.Index < 0 || fragment.Index >= fragment.Total {
+		return nil, errors.NewErrInvalidArgument("Fragment", fmt.Sprintf("invalid index %d of %d fragments", fragment.Index, fragment.Total))
+	}
+	return fragment, nil
+}
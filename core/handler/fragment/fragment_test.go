@@ -0,0 +1,42 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package fragment
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParse(t *testing.T) {
+	a := New(t)
+
+	_, err := Parse("unknown", 0, []byte{0x00, 0x02, 0xaa})
+	a.So(err, ShouldNotBeNil)
+
+	_, err = Parse(HeaderIndexTotal, 0, []byte{0x00})
+	a.So(err, ShouldNotBeNil)
+
+	fragment, err := Parse(HeaderIndexTotal, 0, []byte{0x01, 0x03, 0xaa, 0xbb})
+	a.So(err, ShouldBeNil)
+	a.So(fragment, ShouldResemble, &Fragment{Index: 1, Total: 3, Payload: []byte{0xaa, 0xbb}})
+
+	_, err = Parse(HeaderIndexTotal, 0, []byte{0x03, 0x03, 0xaa})
+	a.So(err, ShouldNotBeNil)
+
+	// The total is required for LoRa Alliance headers
+	_, err = Parse(HeaderLoRaAlliance, 0, []byte{0x01, 0x00, 0xaa})
+	a.So(err, ShouldNotBeNil)
+
+	// Session 2, index 3
+	fragment, err = Parse(HeaderLoRaAlliance, 4, []byte{0x03, 0x80, 0xaa})
+	a.So(err, ShouldBeNil)
+	a.So(fragment, ShouldResemble, &Fragment{Session: 2, Index: 2, Total: 4, Payload: []byte{0xaa}})
+
+	// Indices start at 1
+	_, err = Parse(HeaderLoRaAlliance, 4, []byte{0x00, 0x00, 0xaa})
+	a.So(err, ShouldNotBeNil)
+	_, err = Parse(HeaderLoRaAlliance, 4, []byte{0x05, 0x00, 0xaa})
+	a.So(err, ShouldNotBeNil)
+}
@@ -0,0 +1,256 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package fragment
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/redis.v5"
+)
+
+// Set is an incomplete set of fragments of a payload
+type Set struct {
+	AppID     string
+	DevID     string
+	Session   uint8
+	Total     int
+	Received  int
+	StartedAt time.Time
+	Deadline  time.Time
+}
+
+// Store buffers the fragments of devices until all fragments of a payload are received
+type Store interface {
+	// Add a fragment of a device. If the fragment completes the payload, the reassembled payload is returned.
+	// If the buffered fragments of the device belong to a different payload or timed out, they are removed and
+	// returned as abandoned set.
+	Add(appID, devID string, fragment *Fragment, timeout time.Duration) (payload []byte, abandoned *Set, err error)
+	// Expired removes and returns the incomplete sets that timed out before the given time
+	Expired(before time.Time) ([]*Set, error)
+	// Delete the buffered fragments of a device
+	Delete(appID, devID string) error
+}
+
+const defaultRedisPrefix = "handler"
+const redisFragmentPrefix = "fragments"
+
+// expiryMargin is the time that fragments are kept after their deadline, so that they can be reported
+var expiryMargin = time.Hour
+
+// NewRedisFragmentStore creates a new Redis-based fragment store
+func NewRedisFragmentStore(client *redis.Client, prefix string) *RedisFragmentStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	return &RedisFragmentStore{
+		client: client,
+		prefix: prefix + ":" + redisFragmentPrefix,
+	}
+}
+
+// RedisFragmentStore stores the fragments in Redis.
+// - Fragments are stored in a Hash per device, along with the session, total and deadline of the set
+// - The deadlines of all sets are stored in a Sorted Set, with the AppID and DevID as member
+type RedisFragmentStore struct {
+	client *redis.Client
+	prefix string
+}
+
+func (s *RedisFragmentStore) key(appID, devID string) string {
+	return fmt.Sprintf("%s:%s:%s", s.prefix, appID, devID)
+}
+
+func (s *RedisFragmentStore) deadlinesKey() string {
+	return s.prefix + ":deadlines"
+}
+
+func member(appID, devID string) string {
+	return appID + ":" + devID
+}
+
+const fragmentFieldPrefix = "fragment:"
+
+func fragmentField(index int) string {
+	return fragmentFieldPrefix + strconv.Itoa(index)
+}
+
+func timestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+func parseTimestamp(s string) time.Time {
+	ms, _ := strconv.ParseInt(s, 10, 64)
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+func buildSet(appID, devID string, fields map[string]string) *Set {
+	set := &Set{
+		AppID:     appID,
+		DevID:     devID,
+		StartedAt: parseTimestamp(fields["started_at"]),
+		Deadline:  parseTimestamp(fields["deadline"]),
+	}
+	session, _ := strconv.Atoi(fields["session"])
+	set.Session = uint8(session)
+	set.Total, _ = strconv.Atoi(fields["total"])
+	for field := range fields {
+		if strings.HasPrefix(field, fragmentFieldPrefix) {
+			set.Received++
+		}
+	}
+	return set
+}
+
+// addAttempts is the number of times that adding a fragment is tried when the fragments of the device are changed
+// concurrently
+const addAttempts = 3
+
+// Add a fragment of a device
+func (s *RedisFragmentStore) Add(appID, devID string, fragment *Fragment, timeout time.Duration) (payload []byte, abandoned *Set, err error) {
+	for attempt := 0; attempt < addAttempts; attempt++ {
+		payload, abandoned, err = s.add(appID, devID, fragment, timeout)
+		if err != redis.TxFailedErr {
+			break
+		}
+	}
+	return
+}
+
+// add reads the buffered fragments of the device and writes the changes in one transaction, which fails with
+// redis.TxFailedErr if the fragments were changed in the meantime
+func (s *RedisFragmentStore) add(appID, devID string, fragment *Fragment, timeout time.Duration) (payload []byte, abandoned *Set, err error) {
+	key := s.key(appID, devID)
+	err = s.client.Watch(func(tx *redis.Tx) error {
+		fields, err := tx.HGetAll(key).Result()
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if len(fields) > 0 {
+			set := buildSet(appID, devID, fields)
+			if set.Session != fragment.Session || set.Total != fragment.Total || !now.Before(set.Deadline) {
+				abandoned = set
+				fields = nil
+			}
+		}
+
+		fragments := make(map[int]string, fragment.Total)
+		for field, value := range fields {
+			if strings.HasPrefix(field, fragmentFieldPrefix) {
+				index, _ := strconv.Atoi(strings.TrimPrefix(field, fragmentFieldPrefix))
+				fragments[index] = value
+			}
+		}
+		fragments[fragment.Index] = string(fragment.Payload)
+		complete := len(fragments) == fragment.Total
+
+		_, err = tx.Pipelined(func(pipe *redis.Pipeline) error {
+			if complete || abandoned != nil {
+				pipe.Del(key)
+				pipe.ZRem(s.deadlinesKey(), member(appID, devID))
+			}
+			if complete {
+				return nil
+			}
+			if len(fields) == 0 {
+				deadline := now.Add(timeout)
+				pipe.HMSet(key, map[string]string{
+					"session":    strconv.Itoa(int(fragment.Session)),
+					"total":      strconv.Itoa(fragment.Total),
+					"started_at": timestamp(now),
+					"deadline":   timestamp(deadline),
+				})
+				pipe.ZAdd(s.deadlinesKey(), redis.Z{
+					Score:  float64(deadline.UnixNano() / int64(time.Millisecond)),
+					Member: member(appID, devID),
+				})
+				pipe.Expire(key, timeout+expiryMargin)
+			}
+			pipe.HSet(key, fragmentField(fragment.Index), string(fragment.Payload))
+			return nil
+		})
+		if err != nil || !complete {
+			return err
+		}
+
+		indices := make([]int, 0, len(fragments))
+		for index := range fragments {
+			indices = append(indices, index)
+		}
+		sort.Ints(indices)
+		for _, index := range indices {
+			payload = append(payload, fragments[index]...)
+		}
+		return nil
+	}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return payload, abandoned, nil
+}
+
+// Expired removes and returns the incomplete sets that timed out before the given time
+func (s *RedisFragmentStore) Expired(before time.Time) ([]*Set, error) {
+	members, err := s.client.ZRangeByScore(s.deadlinesKey(), redis.ZRangeBy{
+		Min: "-inf",
+		Max: timestamp(before),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	var sets []*Set
+	for _, m := range members {
+		// Only the caller that removes the member reports the set
+		removed, err := s.client.ZRem(s.deadlinesKey(), m).Result()
+		if err != nil {
+			return sets, err
+		}
+		if removed == 0 {
+			continue
+		}
+		ids := strings.SplitN(m, ":", 2)
+		if len(ids) != 2 {
+			continue
+		}
+		key := s.key(ids[0], ids[1])
+		fields, err := s.client.HGetAll(key).Result()
+		if err != nil {
+			return sets, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		set := buildSet(ids[0], ids[1], fields)
+		if set.Deadline.After(before) {
+			// The fragments were replaced by a new set in the meantime
+			err = s.client.ZAdd(s.deadlinesKey(), redis.Z{
+				Score:  float64(set.Deadline.UnixNano() / int64(time.Millisecond)),
+				Member: m,
+			}).Err()
+			if err != nil {
+				return sets, err
+			}
+			continue
+		}
+		if err := s.client.Del(key).Err(); err != nil {
+			return sets, err
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// Delete the buffered fragments of a device
+func (s *RedisFragmentStore) Delete(appID, devID string) error {
+	_, err := s.client.Pipelined(func(pipe *redis.Pipeline) error {
+		pipe.Del(s.key(appID, devID))
+		pipe.ZRem(s.deadlinesKey(), member(appID, devID))
+		return nil
+	})
+	return err
+}
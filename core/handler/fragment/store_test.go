@@ -0,0 +1,67 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package fragment
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRedisFragmentStore(t *testing.T) {
+	a := New(t)
+	s := NewRedisFragmentStore(GetRedisClient(), "handler-test-fragment-store")
+	defer s.Delete("app", "dev")
+
+	// Fragments are reassembled in order of their index
+	payload, abandoned, err := s.Add("app", "dev", &Fragment{Index: 1, Total: 3, Payload: []byte{0x02}}, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldBeNil)
+	a.So(abandoned, ShouldBeNil)
+
+	payload, abandoned, err = s.Add("app", "dev", &Fragment{Index: 0, Total: 3, Payload: []byte{0x01}}, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldBeNil)
+	a.So(abandoned, ShouldBeNil)
+
+	payload, abandoned, err = s.Add("app", "dev", &Fragment{Index: 2, Total: 3, Payload: []byte{0x03, 0x04}}, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{0x01, 0x02, 0x03, 0x04})
+	a.So(abandoned, ShouldBeNil)
+
+	// A fragment of another session abandons the incomplete set
+	s.Add("app", "dev", &Fragment{Session: 1, Index: 0, Total: 2, Payload: []byte{0x01}}, time.Minute)
+	payload, abandoned, err = s.Add("app", "dev", &Fragment{Session: 2, Index: 0, Total: 2, Payload: []byte{0x01}}, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldBeNil)
+	a.So(abandoned, ShouldNotBeNil)
+	a.So(abandoned.Session, ShouldEqual, 1)
+	a.So(abandoned.Received, ShouldEqual, 1)
+	a.So(abandoned.Total, ShouldEqual, 2)
+
+	// Incomplete sets time out
+	sets, err := s.Expired(time.Now())
+	a.So(err, ShouldBeNil)
+	a.So(sets, ShouldBeEmpty)
+
+	sets, err = s.Expired(time.Now().Add(2 * time.Minute))
+	a.So(err, ShouldBeNil)
+	a.So(sets, ShouldHaveLength, 1)
+	a.So(sets[0].AppID, ShouldEqual, "app")
+	a.So(sets[0].DevID, ShouldEqual, "dev")
+	a.So(sets[0].Session, ShouldEqual, 2)
+
+	// Expired sets are only returned once
+	sets, err = s.Expired(time.Now().Add(2 * time.Minute))
+	a.So(err, ShouldBeNil)
+	a.So(sets, ShouldBeEmpty)
+
+	// The next fragment starts a new set
+	payload, abandoned, err = s.Add("app", "dev", &Fragment{Session: 2, Index: 1, Total: 2, Payload: []byte{0x02}}, time.Minute)
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldBeNil)
+	a.So(abandoned, ShouldBeNil)
+}
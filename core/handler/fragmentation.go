@@ -0,0 +1,196 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/fragment"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// FragmentTimeoutInterval is the interval in which incomplete fragment sets are checked for timeouts
+var FragmentTimeoutInterval = 10 * time.Second
+
+// errFragmentBuffered indicates that the uplink message is a fragment that was buffered, and that the payload
+// is not complete yet
+var errFragmentBuffered = errors.New("Fragment buffered")
+
+// ReassembleFragments buffers the fragments of the application's fragmentation port until all fragments of a
+// payload are received, and replaces the payload of the last fragment by the reassembled payload
+func (h *handler) ReassembleFragments(ctx ttnlog.Interface, ttnUp *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
+	if h.fragments == nil {
+		return nil
+	}
+	app, err := h.applications.Get(appUp.AppID)
+	if err != nil {
+		return nil // Do not process if application not found
+	}
+	config := app.Fragmentation
+	if config == nil || appUp.FPort != config.Port {
+		return nil
+	}
+
+	frag, err := fragment.Parse(config.Header, config.Fragments, appUp.PayloadRaw)
+	if err != nil {
+		// The device state is still updated, but the malformed fragment is dropped
+		ctx.WithError(err).Debug("Could not parse fragment")
+		h.qEvent <- &types.DeviceEvent{
+			AppID: appUp.AppID,
+			DevID: appUp.DevID,
+			Event: types.UplinkErrorEvent,
+			Data:  types.ErrorEventData{Error: errors.Wrap(err, "Invalid fragment").Error()},
+		}
+		return errFragmentBuffered
+	}
+	payload, abandoned, err := h.fragments.Add(appUp.AppID, appUp.DevID, frag, config.GetTimeout())
+	if abandoned != nil {
+		h.fragmentTimeout(abandoned)
+	}
+	if err != nil {
+		return errors.Wrap(err, "Could not store fragment")
+	}
+	if payload == nil {
+		ctx.WithField("Index", frag.Index).WithField("Total", frag.Total).Debug("Buffered fragment")
+		return errFragmentBuffered
+	}
+
+	ctx.WithField("Total", frag.Total).Debug("Reassembled fragments")
+	appUp.PayloadRaw = payload
+	return nil
+}
+
+// fragmentTimeout publishes the event for an incomplete set of fragments
+func (h *handler) fragmentTimeout(set *fragment.Set) {
+	h.Ctx.WithField("AppID", set.AppID).WithField("DevID", set.DevID).WithField("Received", set.Received).WithField("Total", set.Total).Debug("Fragments timed out")
+	h.qEvent <- &types.DeviceEvent{
+		AppID: set.AppID,
+		DevID: set.DevID,
+		Event: types.UplinkFragmentTimeoutEvent,
+		Data: types.FragmentTimeoutEventData{
+			Session:   set.Session,
+			Received:  set.Received,
+			Total:     set.Total,
+			StartedAt: set.StartedAt,
+		},
+	}
+}
+
+// checkFragmentTimeouts publishes the events for the incomplete sets of fragments that timed out
+func (h *handler) checkFragmentTimeouts() {
+	sets, err := h.fragments.Expired(time.Now())
+	for _, set := range sets {
+		h.fragmentTimeout(set)
+	}
+	if err != nil {
+		h.Ctx.WithError(err).Warn("Could not check fragment timeouts")
+	}
+}
+
+func (h *handler) startFragmentTimeouts() {
+	if h.fragments == nil {
+		return
+	}
+	go func() {
+		for range time.Tick(FragmentTimeoutInterval) {
+			h.checkFragmentTimeouts()
+		}
+	}()
+}
+
+// fragmentationToPb converts the fragmentation settings to the proto
+func fragmentationToPb(appID string, fragmentation *application.Fragmentation) *pb_manager.Fragmentation {
+	return &pb_manager.Fragmentation{
+		AppID:     appID,
+		Port:      uint32(fragmentation.Port),
+		Header:    fragmentation.Header,
+		Fragments: uint32(fragmentation.Fragments),
+		Timeout:   fragmentation.Timeout,
+	}
+}
+
+// fragmentationFromPb converts and validates the fragmentation settings proto
+func fragmentationFromPb(pb *pb_manager.Fragmentation) (*application.Fragmentation, error) {
+	if pb.Port > 255 {
+		return nil, errors.NewErrInvalidArgument("Fragmentation Port", "must be between 1 and 223")
+	}
+	fragmentation := &application.Fragmentation{
+		Port:      uint8(pb.Port),
+		Header:    pb.Header,
+		Fragments: int(pb.Fragments),
+		Timeout:   pb.Timeout,
+	}
+	if err := fragmentation.Validate(); err != nil {
+		return nil, err
+	}
+	return fragmentation, nil
+}
+
+func (h *handlerManager) GetFragmentation(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.Fragmentation, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	if app.Fragmentation == nil {
+		return nil, errors.NewErrNotFound("Fragmentation of " + in.AppID)
+	}
+	return fragmentationToPb(in.AppID, app.Fragmentation), nil
+}
+
+func (h *handlerManager) SetFragmentation(ctx context.Context, in *pb_manager.Fragmentation) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Fragmentation")
+	}
+	fragmentation, err := fragmentationFromPb(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Fragmentation")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	app.Fragmentation = fragmentation
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, errors.Wrap(err, "Could not update fragmentation")
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteFragmentation(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	app.Fragmentation = nil
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, errors.Wrap(err, "Could not update fragmentation")
+	}
+	return &gogo.Empty{}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/fragment"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestReassembleFragments(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestReassembleFragments")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-reassemble-fragments"),
+		fragments:    fragment.NewRedisFragmentStore(GetRedisClient(), "handler-test-reassemble-fragments"),
+		qEvent:       make(chan *types.DeviceEvent, 10),
+	}
+	h.applications.Set(&application.Application{
+		AppID: appID,
+		Fragmentation: &application.Fragmentation{
+			Port:   10,
+			Header: fragment.HeaderIndexTotal,
+		},
+	})
+	defer h.applications.Delete(appID)
+	defer h.fragments.Delete(appID, devID)
+
+	buildUplink := func(port uint8, payload []byte) *types.UplinkMessage {
+		return &types.UplinkMessage{AppID: appID, DevID: devID, FPort: port, PayloadRaw: payload}
+	}
+
+	// Other ports are not fragmented
+	appUp := buildUplink(1, []byte{0x00, 0x02, 0xaa})
+	a.So(h.ReassembleFragments(h.Ctx, nil, appUp, nil), ShouldBeNil)
+	a.So(appUp.PayloadRaw, ShouldResemble, []byte{0x00, 0x02, 0xaa})
+
+	// Invalid fragments are dropped with an error event
+	a.So(h.ReassembleFragments(h.Ctx, nil, buildUplink(10, []byte{0x02, 0x02, 0xaa}), nil), ShouldEqual, errFragmentBuffered)
+	a.So(h.qEvent, ShouldHaveLength, 1)
+	a.So((<-h.qEvent).Event, ShouldEqual, types.UplinkErrorEvent)
+
+	a.So(h.ReassembleFragments(h.Ctx, nil, buildUplink(10, []byte{0x01, 0x02, 0xbb}), nil), ShouldEqual, errFragmentBuffered)
+	appUp = buildUplink(10, []byte{0x00, 0x02, 0xaa})
+	a.So(h.ReassembleFragments(h.Ctx, nil, appUp, nil), ShouldBeNil)
+	a.So(appUp.PayloadRaw, ShouldResemble, []byte{0xaa, 0xbb})
+
+	// Incomplete sets time out
+	a.So(h.ReassembleFragments(h.Ctx, nil, buildUplink(10, []byte{0x00, 0x03, 0xaa}), nil), ShouldEqual, errFragmentBuffered)
+	a.So(h.qEvent, ShouldHaveLength, 0)
+	h.applications.Set(&application.Application{
+		AppID: appID,
+		Fragmentation: &application.Fragmentation{
+			Port:    10,
+			Header:  fragment.HeaderIndexTotal,
+			Timeout: "1ms",
+		},
+	})
+	time.Sleep(5 * time.Millisecond)
+	a.So(h.ReassembleFragments(h.Ctx, nil, buildUplink(10, []byte{0x01, 0x03, 0xbb}), nil), ShouldEqual, errFragmentBuffered)
+	a.So(h.qEvent, ShouldHaveLength, 1)
+	event := <-h.qEvent
+	a.So(event.Event, ShouldEqual, types.UplinkFragmentTimeoutEvent)
+	a.So(event.Data.(types.FragmentTimeoutEventData).Received, ShouldEqual, 1)
+	a.So(event.Data.(types.FragmentTimeoutEventData).Total, ShouldEqual, 3)
+
+	time.Sleep(5 * time.Millisecond)
+	h.checkFragmentTimeouts()
+	a.So(h.qEvent, ShouldHaveLength, 1)
+}
+
+func TestFragmentationFromPb(t *testing.T) {
+	a := New(t)
+
+	fragmentation, err := fragmentationFromPb(&pb_manager.Fragmentation{AppID: "app", Port: 10, Header: fragment.HeaderIndexTotal, Timeout: "5m"})
+	a.So(err, ShouldBeNil)
+	a.So(fragmentation.Port, ShouldEqual, 10)
+	a.So(fragmentationToPb("app", fragmentation), ShouldResemble, &pb_manager.Fragmentation{AppID: "app", Port: 10, Header: fragment.HeaderIndexTotal, Timeout: "5m"})
+
+	_, err = fragmentationFromPb(&pb_manager.Fragmentation{AppID: "app", Port: 266, Header: fragment.HeaderIndexTotal})
+	a.So(err, ShouldNotBeNil)
+
+	_, err = fragmentationFromPb(&pb_manager.Fragmentation{AppID: "app", Port: 10, Header: fragment.HeaderIndexTotal, Timeout: "-1m"})
+	a.So(err, ShouldNotBeNil)
+}
@@ -0,0 +1,152 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// setFunctionSet sets the named function set of the application, and records a revision. Empty functions remove
+// the function set; devices that use it fall back to the payload format of the application.
+func (h *handler) setFunctionSet(appID string, name string, functions application.PortFunctions, author string) error {
+	return h.updateFunctions(appID, author, func(app *application.Application) error {
+		return app.SetFunctionSet(name, functions)
+	})
+}
+
+// setDevicePayloadFormat sets the payload format of the device, which overrides the payload format of the
+// application. An empty payload format removes the override.
+func (h *handler) setDevicePayloadFormat(appID, devID, format string) error {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return err
+	}
+	if err := app.ValidateDevicePayloadFormat(format); err != nil {
+		return err
+	}
+	dev, err := h.devices.Get(appID, devID)
+	if err != nil {
+		return err
+	}
+	dev.StartUpdate()
+	dev.PayloadFormat = format
+	return h.devices.Set(dev)
+}
+
+// devicePayloadFormatToPb converts the payload format of a device to the proto, with the function set in its own
+// field
+func devicePayloadFormatToPb(appID, devID, format string) *pb_manager.DevicePayloadFormat {
+	res := &pb_manager.DevicePayloadFormat{AppID: appID, DevID: devID, PayloadFormat: format}
+	if payloadFormat, set, err := application.ParseDevicePayloadFormat(format); err == nil && set != "" {
+		res.PayloadFormat, res.FunctionSet = string(payloadFormat), set
+	}
+	return res
+}
+
+// devicePayloadFormatFromPb converts the proto to the payload format of a device
+func devicePayloadFormatFromPb(pb *pb_manager.DevicePayloadFormat) string {
+	if pb.FunctionSet != "" {
+		return application.FunctionSetPrefix + pb.FunctionSet
+	}
+	return pb.PayloadFormat
+}
+
+func (h *handlerManager) ListFunctionSets(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.FunctionSetList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.FunctionSetList{}
+	if len(app.FunctionSets) > 0 {
+		res.Sets = make(map[string]*pb_manager.PortFunctions, len(app.FunctionSets))
+		for name, functions := range app.FunctionSets {
+			res.Sets[name] = portFunctionsToPb(functions)
+		}
+	}
+	return res, nil
+}
+
+func (h *handlerManager) GetFunctionSet(ctx context.Context, in *pb_manager.FunctionSetIdentifier) (*pb_manager.PortFunctions, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Function Set Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	functions, ok := app.FunctionSets[in.Name]
+	if !ok {
+		return nil, errors.NewErrNotFound("Function Set " + in.Name)
+	}
+	return portFunctionsToPb(functions), nil
+}
+
+func (h *handlerManager) SetFunctionSet(ctx context.Context, in *pb_manager.SetFunctionSetRequest) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Set Function Set Request")
+	}
+	_, claims, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.handler.setFunctionSet(in.AppID, in.Name, portFunctionsFromPb(in.Functions), revisionAuthor(claims)); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteFunctionSet(ctx context.Context, in *pb_manager.FunctionSetIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Function Set Identifier")
+	}
+	_, claims, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.handler.setFunctionSet(in.AppID, in.Name, application.PortFunctions{}, revisionAuthor(claims)); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) GetDevicePayloadFormat(ctx context.Context, in *pb_manager.DeviceIdentifier) (*pb_manager.DevicePayloadFormat, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	dev, err := h.handler.devices.Get(in.AppID, in.DevID)
+	if err != nil {
+		return nil, err
+	}
+	return devicePayloadFormatToPb(in.AppID, in.DevID, dev.PayloadFormat), nil
+}
+
+func (h *handlerManager) SetDevicePayloadFormat(ctx context.Context, in *pb_manager.DevicePayloadFormat) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Payload Format")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	if err := h.handler.setDevicePayloadFormat(in.AppID, in.DevID, devicePayloadFormatFromPb(in)); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
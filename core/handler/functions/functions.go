@@ -13,10 +13,28 @@ import (
 
 var errTimeOutExceeded = errors.NewErrInternal("Code has been running to long")
 
-func RunCode(name, code string, env map[string]interface{}, timeout time.Duration, logger Logger) (val interface{}, err error) {
+// newVM returns a new VM with console.log support
+func newVM() *otto.Otto {
 	vm := otto.New()
 	vm.SetStackDepthLimit(32)
+	vm.Set("__log", func(call otto.FunctionCall) otto.Value {
+		return otto.UndefinedValue()
+	})
+	vm.Run("console.log = function () { return __log.apply(null, arguments); }")
+	vm.Interrupt = make(chan func(), 1)
+	return vm
+}
 
+// RunCode runs the code in a new VM with the given environment
+func RunCode(name, code string, env map[string]interface{}, timeout time.Duration, logger Logger) (val interface{}, err error) {
+	vm := newVM()
+	val, _, err = run(vm, name, code, env, timeout, logger)
+	return val, err
+}
+
+// run runs the code, which is either a string or a compiled *otto.Script, in the VM. It returns whether the VM
+// can be used again, which is not the case after a timeout or a panic.
+func run(vm *otto.Otto, name string, code interface{}, env map[string]interface{}, timeout time.Duration, logger Logger) (val interface{}, clean bool, err error) {
 	// load the environment
 	for key, val := range env {
 		vm.Set(key, val)
@@ -31,14 +49,23 @@ func RunCode(name, code string, env map[string]interface{}, timeout time.Duratio
 		logger.Log(call)
 		return otto.UndefinedValue()
 	})
-	vm.Run("console.log = __log")
 
 	start := time.Now()
 
+	timer := time.AfterFunc(timeout, func() {
+		select {
+		case vm.Interrupt <- func() {
+			panic(errTimeOutExceeded)
+		}:
+		default:
+		}
+	})
+
 	defer func() {
+		stopped := timer.Stop()
 		duration := time.Since(start)
 		if caught := recover(); caught != nil {
-			val = nil
+			val, clean = nil, false
 			switch {
 			case caught == errTimeOutExceeded:
 				err = errors.NewErrInternal(fmt.Sprintf("Interrupted javascript execution for %s after %v", name, duration))
@@ -47,22 +74,20 @@ func RunCode(name, code string, env map[string]interface{}, timeout time.Duratio
 			}
 			return
 		}
-	}()
-
-	vm.Interrupt = make(chan func(), 1)
-
-	go func() {
-		time.Sleep(timeout)
-		vm.Interrupt <- func() {
-			panic(errTimeOutExceeded)
-		}
+		clean = stopped
 	}()
 
 	oVal, err := vm.Run(code)
 	if err != nil {
-		return nil, errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
+		return nil, true, errors.NewErrInternal(fmt.Sprintf("%s threw error: %s", name, err))
 	}
 
+	val, err = export(name, oVal)
+	return val, true, err
+}
+
+// export converts the value to a Go value
+func export(name string, oVal otto.Value) (interface{}, error) {
 	switch {
 	case oVal.IsBoolean():
 		return oVal.ToBoolean()
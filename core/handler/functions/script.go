@@ -0,0 +1,288 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/ast"
+	"github.com/robertkrimen/otto/parser"
+)
+
+// PoolSize is the maximum number of idle VMs that are kept for each Script
+var PoolSize = 4
+
+// CacheSize is the maximum number of Scripts that are kept in a Cache
+var CacheSize = 10000
+
+// resetSource defines __reset in the global scope of the template VM. It takes a snapshot of the globals, their
+// properties and the properties of their prototypes. After a call, __reset deletes the globals that the call
+// added and restores the globals that it replaced. It returns false if the call changed anything that can not be
+// restored this way, such as properties of objects or prototypes; the VM should then not be used again.
+const resetSource = `(function (global) {
+	var getNames = Object.getOwnPropertyNames, stringify = JSON.stringify;
+	var globals, known = Object.create(null), snapshots = [], states = [];
+
+	function shallow(object) {
+		var names = getNames(object), values = [];
+		for (var i = 0; i < names.length; i++) {
+			values.push(object[names[i]]);
+		}
+		return { object: object, names: names, values: values };
+	}
+
+	// same is like ===, but NaN (such as Number.NaN) is the same as itself
+	function same(a, b) {
+		return a === b || (a !== a && b !== b);
+	}
+
+	function unchanged(snapshot) {
+		if (getNames(snapshot.object).length !== snapshot.names.length) {
+			return false;
+		}
+		for (var i = 0; i < snapshot.names.length; i++) {
+			if (!same(snapshot.object[snapshot.names[i]], snapshot.values[i])) {
+				return false;
+			}
+		}
+		return true;
+	}
+
+	function state(value) {
+		try {
+			return stringify(value);
+		} catch (e) {
+			return null;
+		}
+	}
+
+	Object.defineProperty(global, "__reset", { value: function () {
+		var clean = true, names = getNames(global), i;
+		for (i = 0; i < names.length; i++) {
+			if (!known[names[i]] && !delete global[names[i]]) {
+				clean = false;
+			}
+		}
+		for (i = 0; i < globals.names.length; i++) {
+			if (global[globals.names[i]] !== globals.values[i]) {
+				global[globals.names[i]] = globals.values[i];
+			}
+		}
+		for (i = 0; i < snapshots.length; i++) {
+			if (!unchanged(snapshots[i])) {
+				clean = false;
+			}
+		}
+		for (i = 0; i < states.length; i++) {
+			if (state(states[i].value) !== states[i].state) {
+				clean = false;
+			}
+		}
+		return clean;
+	}});
+
+	globals = shallow(global);
+	for (var i = 0; i < globals.names.length; i++) {
+		var value = globals.values[i];
+		known[globals.names[i]] = true;
+		if (value === null || (typeof value !== "object" && typeof value !== "function")) {
+			continue;
+		}
+		snapshots.push(shallow(value));
+		if (value.prototype !== null && typeof value.prototype === "object") {
+			snapshots.push(shallow(value.prototype));
+		}
+		if (typeof value === "object") {
+			states.push({ value: value, state: state(value) });
+		}
+	}
+})(this)`
+
+// poolable returns true if the source only declares functions and variables with literal values, besides
+// directives such as "use strict". State of other sources can be held in closures, which the reset can not restore, so their VMs are not reused.
+func poolable(source string) bool {
+	program, err := parser.ParseFile(nil, "", source, 0)
+	if err != nil {
+		return false
+	}
+	for _, statement := range program.Body {
+		switch statement := statement.(type) {
+		case *ast.FunctionStatement, *ast.EmptyStatement:
+		case *ast.ExpressionStatement:
+			if _, ok := statement.Expression.(*ast.StringLiteral); !ok {
+				return false
+			}
+		case *ast.VariableStatement:
+			for _, expression := range statement.List {
+				if variable, ok := expression.(*ast.VariableExpression); !ok || !literal(variable.Initializer) {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// literal returns true if the expression is empty or a literal value, or an array or object of literal values
+func literal(expression ast.Expression) bool {
+	switch expression := expression.(type) {
+	case nil, *ast.BooleanLiteral, *ast.NullLiteral, *ast.NumberLiteral, *ast.StringLiteral:
+		return true
+	case *ast.UnaryExpression:
+		return literal(expression.Operand)
+	case *ast.ArrayLiteral:
+		for _, value := range expression.Value {
+			if !literal(value) {
+				return false
+			}
+		}
+		return true
+	case *ast.ObjectLiteral:
+		for _, property := range expression.Value {
+			if property.Kind != "value" || !literal(property.Value) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Script is a compiled payload function. The source is executed once in a template VM; every call only runs
+// the (compiled) call expression in a copy of that VM. After a call, the VM is reset to the state of the template
+// and kept in a pool for reuse, so that globals that a call sets are not visible to the next call. VMs that can
+// not be reset are discarded, and VMs of sources that are not poolable are never reused.
+type Script struct {
+	name   string
+	source string
+	call   *otto.Script
+	reset  *otto.Script
+	pool   bool
+
+	templateMu sync.Mutex
+	template   *otto.Otto
+	vms        chan *otto.Otto
+}
+
+// Compile compiles the source that defines a function, and the expression that calls it
+func Compile(name, source, call string, timeout time.Duration) (*Script, error) {
+	template := newVM()
+	if _, _, err := run(template, name, source, nil, timeout, Ignore); err != nil {
+		return nil, err
+	}
+	compiled, err := template.Compile("", call)
+	if err != nil {
+		return nil, errors.NewErrInternal(fmt.Sprintf("Could not compile %s: %s", name, err))
+	}
+	reset, err := template.Compile("", "__reset()")
+	if err != nil {
+		return nil, errors.NewErrInternal(fmt.Sprintf("Could not compile reset of %s: %s", name, err))
+	}
+	if _, _, err := run(template, name, resetSource, nil, timeout, Ignore); err != nil {
+		return nil, err
+	}
+	s := &Script{
+		name:     name,
+		source:   source,
+		call:     compiled,
+		reset:    reset,
+		pool:     poolable(source),
+		template: template,
+		vms:      make(chan *otto.Otto, PoolSize),
+	}
+	return s, nil
+}
+
+func (s *Script) copy() *otto.Otto {
+	s.templateMu.Lock()
+	defer s.templateMu.Unlock()
+	vm := s.template.Copy()
+	vm.Interrupt = make(chan func(), 1)
+	return vm
+}
+
+func (s *Script) get() *otto.Otto {
+	select {
+	case vm := <-s.vms:
+		return vm
+	default:
+		return s.copy()
+	}
+}
+
+func (s *Script) put(vm *otto.Otto) {
+	select {
+	case s.vms <- vm:
+	default:
+	}
+}
+
+// Run calls the function with the given environment
+func (s *Script) Run(env map[string]interface{}, timeout time.Duration, logger Logger) (interface{}, error) {
+	vm := s.get()
+	val, clean, err := run(vm, s.name, s.call, env, timeout, logger)
+	if clean && s.pool {
+		if reset, clean, _ := run(vm, s.name, s.reset, nil, timeout, Ignore); clean && reset == true {
+			s.put(vm)
+		}
+	}
+	return val, err
+}
+
+// Cache keeps compiled Scripts by key
+type Cache struct {
+	mu      sync.RWMutex
+	scripts map[string]*Script
+}
+
+// NewCache returns a new Cache
+func NewCache() *Cache {
+	return &Cache{
+		scripts: make(map[string]*Script),
+	}
+}
+
+// Get returns the Script for the key, compiling it if it is not in the cache or if its source has changed
+func (c *Cache) Get(key, name, source, call string, timeout time.Duration) (*Script, error) {
+	c.mu.RLock()
+	script, ok := c.scripts[key]
+	c.mu.RUnlock()
+	if ok && script.source == source {
+		return script, nil
+	}
+
+	script, err := Compile(name, source, call, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.scripts) >= CacheSize {
+		for k := range c.scripts {
+			delete(c.scripts, k)
+			break
+		}
+	}
+	c.scripts[key] = script
+	return script, nil
+}
+
+// Invalidate removes all Scripts with the given key prefix from the cache
+func (c *Cache) Invalidate(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.scripts {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.scripts, key)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package functions
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb_handler "github.com/TheThingsNetwork/api/handler"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestScript(t *testing.T) {
+	a := New(t)
+
+	source := `
+		function Add(a, b) {
+			console.log("adding", a, b)
+			return a + b
+		}
+	`
+
+	s, err := Compile("Add", source, "Add(a, b)", time.Second)
+	a.So(err, ShouldBeNil)
+
+	for i := 0; i < 3; i++ {
+		logger := NewEntryLogger()
+		val, err := s.Run(map[string]interface{}{"a": i, "b": 10}, time.Second, logger)
+		a.So(err, ShouldBeNil)
+		a.So(val, ShouldEqual, i+10)
+		a.So(logger.Entries(), ShouldResemble, []*pb_handler.LogEntry{
+			&pb_handler.LogEntry{
+				Function: "Add",
+				Fields:   []string{`"adding"`, fmt.Sprint(i), "10"},
+			},
+		})
+	}
+
+	_, err = Compile("Add", "function Add(a, b) {", "Add(a, b)", time.Second)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestScriptTimeout(t *testing.T) {
+	a := New(t)
+
+	s, err := Compile("Loop", "function Loop() { while (true) {} }", "Loop()", time.Second)
+	a.So(err, ShouldBeNil)
+
+	_, err = s.Run(nil, 10*time.Millisecond, nil)
+	a.So(err, ShouldNotBeNil)
+
+	_, err = Compile("Loop", "while (true) {}", "Loop()", 10*time.Millisecond)
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestScriptGlobals(t *testing.T) {
+	a := New(t)
+
+	source := `
+		var last = "none";
+		function Decoder(bytes, dev_id) {
+			var result = { previous: last, seen: typeof seen !== "undefined" };
+			last = dev_id;
+			seen = true;
+			return result;
+		}
+	`
+	s, err := Compile("Decoder", source, "Decoder(payload, dev_id)", time.Second)
+	a.So(err, ShouldBeNil)
+
+	val, err := s.Run(map[string]interface{}{"payload": []byte{1}, "dev_id": "dev-1"}, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	a.So(val, ShouldResemble, map[string]interface{}{"previous": "none", "seen": false})
+
+	// Globals that the first call set are not visible to a call for another device
+	val, err = s.Run(map[string]interface{}{"payload": []byte{2}, "dev_id": "dev-2"}, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	a.So(val, ShouldResemble, map[string]interface{}{"previous": "none", "seen": false})
+}
+
+func TestScriptPool(t *testing.T) {
+	a := New(t)
+
+	s, err := Compile("Add", "function Add(a, b) { return a + b }", "Add(a, b)", time.Second)
+	a.So(err, ShouldBeNil)
+
+	// The VM is reset and reused after a call
+	_, err = s.Run(map[string]interface{}{"a": 1, "b": 2}, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	a.So(len(s.vms), ShouldEqual, 1)
+	vm := <-s.vms
+	undefined, err := vm.Run(`typeof a === "undefined" && typeof b === "undefined"`)
+	a.So(err, ShouldBeNil)
+	a.So(undefined.String(), ShouldEqual, "true")
+	s.put(vm)
+
+	// VMs in which a call changed objects are not reused
+	source := `
+		var table = { count: 0 };
+		function Count() {
+			table.count++;
+			Array.prototype.extra = true;
+			return table.count;
+		}
+	`
+	s, err = Compile("Count", source, "Count()", time.Second)
+	a.So(err, ShouldBeNil)
+	for i := 0; i < 3; i++ {
+		val, err := s.Run(nil, time.Second, nil)
+		a.So(err, ShouldBeNil)
+		a.So(val, ShouldEqual, 1)
+	}
+	a.So(len(s.vms), ShouldEqual, 0)
+
+	// VMs that timed out are not reused
+	s, err = Compile("Loop", "function Loop() { while (true) {} }", "Loop()", time.Second)
+	a.So(err, ShouldBeNil)
+	_, err = s.Run(nil, 10*time.Millisecond, nil)
+	a.So(err, ShouldNotBeNil)
+	a.So(len(s.vms), ShouldEqual, 0)
+}
+
+func TestScriptIsolation(t *testing.T) {
+	a := New(t)
+
+	// A call that mutates a prototype of a built-in is not visible to the next call
+	source := `
+		function Decoder(bytes, dev_id) {
+			var previous = ({}).owner;
+			Object.prototype.owner = dev_id;
+			return { previous: previous === undefined ? "none" : previous };
+		}
+	`
+	s, err := Compile("Decoder", source, "Decoder(payload, dev_id)", time.Second)
+	a.So(err, ShouldBeNil)
+	a.So(s.pool, ShouldBeTrue)
+	for _, devID := range []string{"dev-1", "dev-2"} {
+		val, err := s.Run(map[string]interface{}{"payload": []byte{1}, "dev_id": devID}, time.Second, nil)
+		a.So(err, ShouldBeNil)
+		a.So(val, ShouldResemble, map[string]interface{}{"previous": "none"})
+	}
+
+	// State held in a closure is not visible to the next call
+	source = `
+		var last = (function () {
+			var dev = "none";
+			return function (dev_id) {
+				var previous = dev;
+				dev = dev_id;
+				return previous;
+			};
+		})();
+		function Decoder(bytes, dev_id) {
+			return { previous: last(dev_id) };
+		}
+	`
+	s, err = Compile("Decoder", source, "Decoder(payload, dev_id)", time.Second)
+	a.So(err, ShouldBeNil)
+	a.So(s.pool, ShouldBeFalse)
+	for _, devID := range []string{"dev-1", "dev-2"} {
+		val, err := s.Run(map[string]interface{}{"payload": []byte{1}, "dev_id": devID}, time.Second, nil)
+		a.So(err, ShouldBeNil)
+		a.So(val, ShouldResemble, map[string]interface{}{"previous": "none"})
+	}
+	a.So(len(s.vms), ShouldEqual, 0)
+}
+
+func TestPoolable(t *testing.T) {
+	a := New(t)
+	a.So(poolable(`"use strict"; var table = [1, -2, "three", { four: [4] }]; function Decoder(bytes) { return {}; }`), ShouldBeTrue)
+	a.So(poolable(`var decode = function (bytes) { return {}; }`), ShouldBeFalse)
+	a.So(poolable(`var table = { get value() { return 1; } }`), ShouldBeFalse)
+	a.So(poolable(`Object.prototype.extra = true`), ShouldBeFalse)
+	a.So(poolable(`function Decoder(bytes) {`), ShouldBeFalse)
+}
+
+func TestCache(t *testing.T) {
+	a := New(t)
+
+	c := NewCache()
+
+	s1, err := c.Get("app:Add", "Add", "function Add(a, b) { return a + b }", "Add(a, b)", time.Second)
+	a.So(err, ShouldBeNil)
+
+	s2, err := c.Get("app:Add", "Add", "function Add(a, b) { return a + b }", "Add(a, b)", time.Second)
+	a.So(err, ShouldBeNil)
+	a.So(s2, ShouldEqual, s1)
+
+	// A changed source is recompiled
+	s3, err := c.Get("app:Add", "Add", "function Add(a, b) { return a + b + 1 }", "Add(a, b)", time.Second)
+	a.So(err, ShouldBeNil)
+	a.So(s3, ShouldNotEqual, s1)
+
+	val, err := s3.Run(map[string]interface{}{"a": 1, "b": 2}, time.Second, nil)
+	a.So(err, ShouldBeNil)
+	a.So(val, ShouldEqual, 4)
+
+	c.Invalidate("app:")
+	a.So(c.scripts, ShouldBeEmpty)
+}
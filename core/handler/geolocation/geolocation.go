@@ -0,0 +1,234 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package geolocation estimates the location of a device from the metadata of the gateways that received it
+package geolocation
+
+import (
+	"math"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+// Location sources that are set by the Solver
+const (
+	SourceRSSI = "lora_rssi_geolocation"
+	SourceTDOA = "lora_tdoa_geolocation"
+)
+
+const (
+	earthRadius   = 6371000.0 // meters
+	speedOfLight  = 299792458.0
+	maxIterations = 20
+	minStep       = 0.01 // meters
+	maxDistance   = 100000.0
+)
+
+// Solver estimates the location of a device. With at least MinGateways gateways with a known location, it
+// returns the RSSI-weighted centroid of the gateways. With at least MinTDOAGateways gateways that also have a
+// fine timestamp, it solves the time difference of arrival instead.
+type Solver struct {
+	MinGateways     int
+	MinTDOAGateways int
+}
+
+// NewSolver returns a new Solver with the default settings
+func NewSolver() *Solver {
+	return &Solver{
+		MinGateways:     3,
+		MinTDOAGateways: 4,
+	}
+}
+
+// gateway is a gateway with known location, in local coordinates
+type gateway struct {
+	x, y     float64 // meters
+	rssi     float64
+	fineTime int64
+	hasFine  bool
+}
+
+// Solve returns the estimated location of the device, or nil if there is not enough metadata
+func (s *Solver) Solve(metadata []types.GatewayMetadata) *types.LocationMetadata {
+	var refLat, refLon float64
+	byID := make(map[string]types.GatewayMetadata)
+	ids := make([]string, 0, len(metadata))
+	for _, md := range metadata {
+		if md.Latitude == 0 && md.Longitude == 0 {
+			continue
+		}
+		existing, ok := byID[md.GtwID]
+		if !ok {
+			ids = append(ids, md.GtwID)
+			refLat += float64(md.Latitude)
+			refLon += float64(md.Longitude)
+		}
+		// Keep the antenna with the best signal
+		if !ok || md.RSSI > existing.RSSI {
+			byID[md.GtwID] = md
+		}
+	}
+	if len(ids) == 0 || len(ids) < s.MinGateways {
+		return nil
+	}
+	refLat /= float64(len(ids))
+	refLon /= float64(len(ids))
+
+	gateways := make([]gateway, 0, len(ids))
+	var numFine int
+	for _, id := range ids {
+		md := byID[id]
+		x, y := toLocal(refLat, refLon, float64(md.Latitude), float64(md.Longitude))
+		gtw := gateway{
+			x:        x,
+			y:        y,
+			rssi:     float64(md.RSSI),
+			fineTime: md.FineTimestamp,
+			hasFine:  md.FineTimestamp != 0,
+		}
+		if gtw.hasFine {
+			numFine++
+		}
+		gateways = append(gateways, gtw)
+	}
+
+	x, y, accuracy := centroid(gateways)
+	source := SourceRSSI
+
+	if s.MinTDOAGateways > 0 && numFine >= s.MinTDOAGateways {
+		if tx, ty, tAccuracy, ok := tdoa(gateways, x, y); ok {
+			x, y, accuracy, source = tx, ty, tAccuracy, SourceTDOA
+		}
+	}
+
+	lat, lon := fromLocal(refLat, refLon, x, y)
+	return &types.LocationMetadata{
+		Latitude:  float32(lat),
+		Longitude: float32(lon),
+		Accuracy:  int32(math.Max(1, math.Ceil(accuracy))),
+		Source:    source,
+	}
+}
+
+// centroid returns the RSSI-weighted centroid of the gateways, and the weighted spread of the gateways around it
+func centroid(gateways []gateway) (x, y, spread float64) {
+	var maxRSSI = math.Inf(-1)
+	for _, gtw := range gateways {
+		maxRSSI = math.Max(maxRSSI, gtw.rssi)
+	}
+	var total float64
+	weights := make([]float64, len(gateways))
+	for i, gtw := range gateways {
+		weights[i] = math.Pow(10, (gtw.rssi-maxRSSI)/20)
+		total += weights[i]
+		x += weights[i] * gtw.x
+		y += weights[i] * gtw.y
+	}
+	x /= total
+	y /= total
+	for i, gtw := range gateways {
+		spread += weights[i] * ((gtw.x-x)*(gtw.x-x) + (gtw.y-y)*(gtw.y-y))
+	}
+	spread = math.Sqrt(spread / total)
+	return
+}
+
+// tdoa solves the position of the device and the time of transmission from the fine timestamps of the gateways,
+// using Gauss-Newton iteration from the given starting position. It returns the RMS residual as accuracy.
+func tdoa(gateways []gateway, x, y float64) (float64, float64, float64, bool) {
+	var fine []gateway
+	for _, gtw := range gateways {
+		if gtw.hasFine {
+			fine = append(fine, gtw)
+		}
+	}
+	if len(fine) < 3 {
+		return 0, 0, 0, false
+	}
+
+	// Ranges relative to the first gateway, in meters. Fine timestamps are nanoseconds within the second.
+	ranges := make([]float64, len(fine))
+	for i, gtw := range fine {
+		diff := gtw.fineTime - fine[0].fineTime
+		if diff > 5e8 {
+			diff -= 1e9
+		} else if diff < -5e8 {
+			diff += 1e9
+		}
+		ranges[i] = float64(diff) * speedOfLight / 1e9
+	}
+
+	// The offset b is the distance to the first gateway
+	b := math.Hypot(fine[0].x-x, fine[0].y-y)
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		var jtj [3][3]float64
+		var jtr [3]float64
+		for i, gtw := range fine {
+			d := math.Hypot(x-gtw.x, y-gtw.y)
+			if d < 1 {
+				d = 1
+			}
+			row := [3]float64{(x - gtw.x) / d, (y - gtw.y) / d, -1}
+			residual := ranges[i] + b - d
+			for j := 0; j < 3; j++ {
+				jtr[j] += row[j] * residual
+				for k := 0; k < 3; k++ {
+					jtj[j][k] += row[j] * row[k]
+				}
+			}
+		}
+		step, ok := solve3(jtj, jtr)
+		if !ok {
+			return 0, 0, 0, false
+		}
+		x, y, b = x+step[0], y+step[1], b+step[2]
+		if math.Hypot(step[0], step[1]) < minStep {
+			break
+		}
+	}
+	if math.IsNaN(x) || math.IsNaN(y) || math.Hypot(x, y) > maxDistance {
+		return 0, 0, 0, false
+	}
+
+	var sum float64
+	for i, gtw := range fine {
+		residual := ranges[i] + b - math.Hypot(x-gtw.x, y-gtw.y)
+		sum += residual * residual
+	}
+	return x, y, math.Sqrt(sum / float64(len(fine))), true
+}
+
+// solve3 solves the 3x3 linear system a·x = b using Cramer's rule
+func solve3(a [3][3]float64, b [3]float64) (x [3]float64, ok bool) {
+	det := func(m [3][3]float64) float64 {
+		return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+			m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+			m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	}
+	d := det(a)
+	if math.Abs(d) < 1e-12 {
+		return x, false
+	}
+	for i := 0; i < 3; i++ {
+		m := a
+		for j := 0; j < 3; j++ {
+			m[j][i] = b[j]
+		}
+		x[i] = det(m) / d
+	}
+	return x, true
+}
+
+// toLocal projects the location to meters east (x) and north (y) of the reference location
+func toLocal(refLat, refLon, lat, lon float64) (x, y float64) {
+	x = (lon - refLon) * math.Pi / 180 * earthRadius * math.Cos(refLat*math.Pi/180)
+	y = (lat - refLat) * math.Pi / 180 * earthRadius
+	return
+}
+
+// fromLocal is the inverse of toLocal
+func fromLocal(refLat, refLon, x, y float64) (lat, lon float64) {
+	lat = refLat + y/earthRadius*180/math.Pi
+	lon = refLon + x/(earthRadius*math.Cos(refLat*math.Pi/180))*180/math.Pi
+	return
+}
@@ -0,0 +1,97 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package geolocation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+const (
+	refLat = 52.37
+	refLon = 4.89
+)
+
+func buildGateway(id string, x, y float64, rssi float32) types.GatewayMetadata {
+	lat, lon := fromLocal(refLat, refLon, x, y)
+	return types.GatewayMetadata{
+		GtwID: id,
+		RSSI:  rssi,
+		LocationMetadata: types.LocationMetadata{
+			Latitude:  float32(lat),
+			Longitude: float32(lon),
+		},
+	}
+}
+
+func distance(location *types.LocationMetadata, x, y float64) float64 {
+	lx, ly := toLocal(refLat, refLon, float64(location.Latitude), float64(location.Longitude))
+	return math.Hypot(lx-x, ly-y)
+}
+
+func TestSolveRSSI(t *testing.T) {
+	a := New(t)
+	s := NewSolver()
+
+	a.So(s.Solve(nil), ShouldBeNil)
+
+	gateways := []types.GatewayMetadata{
+		buildGateway("gtw-1", -1000, 0, -100),
+		buildGateway("gtw-2", 1000, 0, -100),
+	}
+	a.So(s.Solve(gateways), ShouldBeNil)
+
+	// Gateways without location are ignored
+	gateways = append(gateways, types.GatewayMetadata{GtwID: "gtw-unknown", RSSI: -30})
+	a.So(s.Solve(gateways), ShouldBeNil)
+
+	// Equal RSSI gives the centroid
+	gateways = append(gateways, buildGateway("gtw-3", 0, 1500, -100), buildGateway("gtw-3", 0, 1500, -120))
+	location := s.Solve(gateways)
+	a.So(location, ShouldNotBeNil)
+	a.So(location.Source, ShouldEqual, SourceRSSI)
+	a.So(distance(location, 0, 500), ShouldBeLessThan, 2)
+	a.So(location.Accuracy, ShouldBeGreaterThan, 500)
+
+	// A stronger gateway pulls the location towards it
+	gateways[1].RSSI = -80
+	location = s.Solve(gateways)
+	lx, _ := toLocal(refLat, refLon, float64(location.Latitude), float64(location.Longitude))
+	a.So(lx, ShouldBeGreaterThan, 500)
+}
+
+func TestSolveTDOA(t *testing.T) {
+	a := New(t)
+	s := NewSolver()
+
+	deviceX, deviceY := 300.0, -700.0
+	gateways := []types.GatewayMetadata{
+		buildGateway("gtw-1", -2000, -1500, -110),
+		buildGateway("gtw-2", 2500, -1000, -105),
+		buildGateway("gtw-3", 500, 2500, -115),
+		buildGateway("gtw-4", -1500, 2000, -120),
+	}
+	// Transmitted at 999.99 ms into the second, so some gateways receive it in the next second
+	const transmitTime = 999990000
+	for i, gtw := range gateways {
+		x, y := toLocal(refLat, refLon, float64(gtw.Latitude), float64(gtw.Longitude))
+		flight := math.Hypot(x-deviceX, y-deviceY) / speedOfLight * 1e9
+		gateways[i].FineTimestamp = (transmitTime + int64(flight)) % 1e9
+	}
+
+	location := s.Solve(gateways)
+	a.So(location, ShouldNotBeNil)
+	a.So(location.Source, ShouldEqual, SourceTDOA)
+	a.So(distance(location, deviceX, deviceY), ShouldBeLessThan, 5)
+	a.So(location.Accuracy, ShouldBeLessThan, 5)
+
+	// Not enough fine timestamps falls back to RSSI
+	gateways[3].FineTimestamp = 0
+	location = s.Solve(gateways)
+	a.So(location, ShouldNotBeNil)
+	a.So(location.Source, ShouldEqual, SourceRSSI)
+}
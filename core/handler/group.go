@@ -0,0 +1,262 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/group"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/random"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// GroupDownlinkHandler is called by an Integration for each downlink message to a device group that it receives
+type GroupDownlinkHandler func(appID, groupID string, down *types.DownlinkMessage) error
+
+// GroupDownlinkSubscriber is implemented by Integrations that also receive downlink messages for device groups
+type GroupDownlinkSubscriber interface {
+	SubscribeGroupDownlink(handler GroupDownlinkHandler) error
+}
+
+// groupMembers returns the IDs of the devices of the application that are in the group
+func (h *handler) groupMembers(appID string, deviceGroup *application.DeviceGroup) ([]string, error) {
+	devices, err := h.devices.ListForApp(appID, nil)
+	if err != nil {
+		return nil, err
+	}
+	var members []string
+	for _, dev := range devices {
+		if dev != nil && deviceGroup.Contains(dev.DevID, dev.Attributes) {
+			members = append(members, dev.DevID)
+		}
+	}
+	return members, nil
+}
+
+// EnqueueGroupDownlink enqueues the downlink message for all devices in the group, and returns the report
+// that keeps track of the scheduled, sent and acknowledged messages
+func (h *handler) EnqueueGroupDownlink(appID, groupID string, appDownlink *types.DownlinkMessage) (*group.Report, error) {
+	ctx := h.Ctx.WithFields(ttnlog.Fields{
+		"AppID":   appID,
+		"GroupID": groupID,
+	})
+
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	deviceGroup := app.GetGroup(groupID)
+	if deviceGroup == nil {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Group %s", groupID))
+	}
+	members, err := h.groupMembers(appID, deviceGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &group.Report{
+		ID:        fmt.Sprintf("%x", random.Bytes(8)),
+		AppID:     appID,
+		GroupID:   groupID,
+		CreatedAt: time.Now(),
+		Devices:   len(members),
+	}
+	// The report is created first, so that messages that are sent while enqueueing are counted
+	if err := h.groupReports.Create(report); err != nil {
+		return nil, err
+	}
+	for _, devID := range members {
+		msg := *appDownlink
+		msg.AppID = appID
+		msg.DevID = devID
+		msg.GroupDownlinkID = report.ID
+		if err := h.EnqueueDownlink(&msg); err != nil {
+			report.Failed++
+			h.countGroupDownlink(appID, &msg, group.Failed)
+		} else {
+			report.Scheduled++
+			h.countGroupDownlink(appID, &msg, group.Scheduled)
+		}
+	}
+
+	ctx.WithFields(ttnlog.Fields{
+		"Devices":   report.Devices,
+		"Scheduled": report.Scheduled,
+		"Failed":    report.Failed,
+	}).Debug("Enqueued group downlink")
+
+	return report, nil
+}
+
+// countGroupDownlink increments the counter of the group downlink report of the message, if any
+func (h *handler) countGroupDownlink(appID string, msg *types.DownlinkMessage, counter string) {
+	if msg == nil || msg.GroupDownlinkID == "" || h.groupReports == nil {
+		return
+	}
+	if err := h.groupReports.Increment(appID, msg.GroupDownlinkID, counter); err != nil {
+		h.Ctx.WithFields(ttnlog.Fields{
+			"AppID":           appID,
+			"GroupDownlinkID": msg.GroupDownlinkID,
+		}).WithError(err).Warn("Could not update group downlink report")
+	}
+}
+
+// deviceGroupToPb converts a device group to its proto
+func deviceGroupToPb(appID string, deviceGroup application.DeviceGroup) *pb_manager.DeviceGroup {
+	return &pb_manager.DeviceGroup{
+		AppID:    appID,
+		ID:       deviceGroup.ID,
+		Devices:  deviceGroup.Devices,
+		Selector: deviceGroup.Selector,
+	}
+}
+
+// groupReportToPb converts a group downlink report to its proto
+func groupReportToPb(report *group.Report) *pb_manager.GroupDownlinkReport {
+	return &pb_manager.GroupDownlinkReport{
+		ID:        report.ID,
+		AppID:     report.AppID,
+		GroupID:   report.GroupID,
+		CreatedAt: report.CreatedAt.UnixNano(),
+		Devices:   uint32(report.Devices),
+		Scheduled: uint32(report.Scheduled),
+		Failed:    uint32(report.Failed),
+		Sent:      uint32(report.Sent),
+		Acked:     uint32(report.Acked),
+	}
+}
+
+func (h *handlerManager) ListGroups(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.DeviceGroupList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.DeviceGroupList{}
+	for _, deviceGroup := range app.Groups {
+		res.Groups = append(res.Groups, deviceGroupToPb(in.AppID, deviceGroup))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) GetGroup(ctx context.Context, in *pb_manager.DeviceGroupIdentifier) (*pb_manager.DeviceGroup, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Group Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	deviceGroup := app.GetGroup(in.GroupID)
+	if deviceGroup == nil {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Group %s", in.GroupID))
+	}
+	members, err := h.handler.groupMembers(in.AppID, deviceGroup)
+	if err != nil {
+		return nil, err
+	}
+	res := deviceGroupToPb(in.AppID, *deviceGroup)
+	res.Members = members
+	return res, nil
+}
+
+func (h *handlerManager) SetGroup(ctx context.Context, in *pb_manager.DeviceGroup) (*gogo.Empty, error) {
+	if err := api.NotEmptyAndValidID(in.AppID, "AppID"); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Group")
+	}
+	deviceGroup := application.DeviceGroup{
+		ID:       in.ID,
+		Devices:  in.Devices,
+		Selector: in.Selector,
+	}
+	if err := deviceGroup.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Group")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	app.SetGroup(deviceGroup)
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteGroup(ctx context.Context, in *pb_manager.DeviceGroupIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Group Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.Devices); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	if err := app.DeleteGroup(in.GroupID); err != nil {
+		return nil, err
+	}
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) EnqueueGroupDownlink(ctx context.Context, in *pb_manager.GroupDownlinkRequest) (*pb_manager.GroupDownlinkReport, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Group Downlink Request")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rightMessagesDown); err != nil {
+		return nil, err
+	}
+	downlink, err := downlinkMessageFromPb(in.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Group Downlink Request")
+	}
+	report, err := h.handler.EnqueueGroupDownlink(in.AppID, in.GroupID, downlink)
+	if err != nil {
+		return nil, err
+	}
+	return groupReportToPb(report), nil
+}
+
+func (h *handlerManager) GetGroupDownlinkReport(ctx context.Context, in *pb_manager.GroupDownlinkIdentifier) (*pb_manager.GroupDownlinkReport, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Group Downlink Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rightMessagesDown); err != nil {
+		return nil, err
+	}
+	report, err := h.handler.groupReports.Get(in.AppID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	if report.GroupID != in.GroupID {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Group downlink %s", in.ID))
+	}
+	return groupReportToPb(report), nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package group
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"gopkg.in/redis.v5"
+)
+
+// Counters of a Report
+const (
+	Scheduled = "scheduled"
+	Failed    = "failed"
+	Sent      = "sent"
+	Acked     = "acked"
+)
+
+// Report aggregates the state of a downlink message that was sent to all devices of a group
+type Report struct {
+	ID        string    `json:"id"`
+	AppID     string    `json:"app_id"`
+	GroupID   string    `json:"group_id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Devices is the number of devices in the group when the downlink was enqueued
+	Devices int `json:"devices"`
+	// Scheduled is the number of devices the downlink was enqueued for
+	Scheduled int `json:"scheduled"`
+	// Failed is the number of devices the downlink could not be enqueued for
+	Failed int `json:"failed"`
+	// Sent is the number of devices the downlink was sent to
+	Sent int `json:"sent"`
+	// Acked is the number of devices that acknowledged the (confirmed) downlink
+	Acked int `json:"acked"`
+}
+
+// Store stores the reports of group downlinks
+type Store interface {
+	Create(report *Report) error
+	Get(appID, reportID string) (*Report, error)
+	Increment(appID, reportID, counter string) error
+	// Delete all reports of an application
+	Delete(appID string) error
+}
+
+const defaultRedisPrefix = "handler"
+const redisReportPrefix = "group-downlink"
+
+// DefaultReportAge is the time that reports are kept after they were created
+var DefaultReportAge = 7 * 24 * time.Hour
+
+// NewRedisReportStore creates a new Redis-based report store
+func NewRedisReportStore(client *redis.Client, prefix string) *RedisReportStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	return &RedisReportStore{
+		store:  storage.NewRedisStore(client, prefix+":"+redisReportPrefix),
+		client: client,
+		prefix: prefix + ":" + redisReportPrefix,
+	}
+}
+
+// RedisReportStore stores the reports in Redis.
+// - Reports are stored as a Hash per group downlink, and expire after DefaultReportAge
+type RedisReportStore struct {
+	store  *storage.RedisStore
+	client *redis.Client
+	prefix string
+}
+
+func (s *RedisReportStore) key(appID, reportID string) string {
+	return fmt.Sprintf("%s:%s:%s", s.prefix, appID, reportID)
+}
+
+// Create a report
+func (s *RedisReportStore) Create(report *Report) error {
+	key := s.key(report.AppID, report.ID)
+	_, err := s.client.Pipelined(func(pipe *redis.Pipeline) error {
+		pipe.HMSet(key, map[string]string{
+			"group_id":   report.GroupID,
+			"created_at": report.CreatedAt.UTC().Format(time.RFC3339Nano),
+			"devices":    strconv.Itoa(report.Devices),
+			Scheduled:    strconv.Itoa(report.Scheduled),
+			Failed:       strconv.Itoa(report.Failed),
+			Sent:         strconv.Itoa(report.Sent),
+			Acked:        strconv.Itoa(report.Acked),
+		})
+		pipe.Expire(key, DefaultReportAge)
+		return nil
+	})
+	return err
+}
+
+// Get a report
+func (s *RedisReportStore) Get(appID, reportID string) (*Report, error) {
+	fields, err := s.client.HGetAll(s.key(appID, reportID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Group downlink %s", reportID))
+	}
+	report := &Report{
+		ID:      reportID,
+		AppID:   appID,
+		GroupID: fields["group_id"],
+	}
+	report.CreatedAt, _ = time.Parse(time.RFC3339Nano, fields["created_at"])
+	report.Devices, _ = strconv.Atoi(fields["devices"])
+	report.Scheduled, _ = strconv.Atoi(fields[Scheduled])
+	report.Failed, _ = strconv.Atoi(fields[Failed])
+	report.Sent, _ = strconv.Atoi(fields[Sent])
+	report.Acked, _ = strconv.Atoi(fields[Acked])
+	return report, nil
+}
+
+// Increment a counter of a report. Reports that do not exist (anymore) are ignored.
+func (s *RedisReportStore) Increment(appID, reportID, counter string) error {
+	key := s.key(appID, reportID)
+	exists, err := s.client.Exists(key).Result()
+	if err != nil || !exists {
+		return err
+	}
+	return s.client.HIncrBy(key, counter, 1).Err()
+}
+
+// Delete all reports of an application
+func (s *RedisReportStore) Delete(appID string) error {
+	keys, err := s.store.Keys(s.key(appID, "*"))
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	return s.client.Del(keys...).Err()
+}
@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package group
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestRedisReportStore(t *testing.T) {
+	a := New(t)
+	s := NewRedisReportStore(GetRedisClient(), "handler-test-group-report")
+
+	_, err := s.Get("app", "report")
+	a.So(err, ShouldNotBeNil)
+
+	// Incrementing a report that does not exist is ignored
+	a.So(s.Increment("app", "report", Sent), ShouldBeNil)
+	_, err = s.Get("app", "report")
+	a.So(err, ShouldNotBeNil)
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	err = s.Create(&Report{ID: "report", AppID: "app", GroupID: "group", CreatedAt: createdAt, Devices: 3, Scheduled: 2, Failed: 1})
+	a.So(err, ShouldBeNil)
+	defer GetRedisClient().Del(s.key("app", "report"))
+
+	a.So(s.Increment("app", "report", Sent), ShouldBeNil)
+	a.So(s.Increment("app", "report", Sent), ShouldBeNil)
+	a.So(s.Increment("app", "report", Acked), ShouldBeNil)
+
+	report, err := s.Get("app", "report")
+	a.So(err, ShouldBeNil)
+	a.So(report, ShouldResemble, &Report{
+		ID:        "report",
+		AppID:     "app",
+		GroupID:   "group",
+		CreatedAt: createdAt,
+		Devices:   3,
+		Scheduled: 2,
+		Failed:    1,
+		Sent:      2,
+		Acked:     1,
+	})
+}
+
+func TestRedisReportStoreDelete(t *testing.T) {
+	a := New(t)
+	s := NewRedisReportStore(GetRedisClient(), "handler-test-group-report-delete")
+
+	a.So(s.Delete("app"), ShouldBeNil)
+
+	for _, id := range []string{"report1", "report2"} {
+		a.So(s.Create(&Report{ID: id, AppID: "app", GroupID: "group", CreatedAt: time.Now()}), ShouldBeNil)
+	}
+	a.So(s.Create(&Report{ID: "report1", AppID: "other", GroupID: "group", CreatedAt: time.Now()}), ShouldBeNil)
+	defer GetRedisClient().Del(s.key("other", "report1"))
+
+	a.So(s.Delete("app"), ShouldBeNil)
+	_, err := s.Get("app", "report1")
+	a.So(err, ShouldNotBeNil)
+	_, err = s.Get("app", "report2")
+	a.So(err, ShouldNotBeNil)
+	_, err = s.Get("other", "report1")
+	a.So(err, ShouldBeNil)
+}
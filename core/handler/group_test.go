@@ -0,0 +1,77 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/group"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestEnqueueGroupDownlink(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestEnqueueGroupDownlink")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-group-downlink"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-group-downlink"),
+		groupReports: group.NewRedisReportStore(GetRedisClient(), "handler-test-group-downlink"),
+		qEvent:       make(chan *types.DeviceEvent, 10),
+	}
+
+	h.applications.Set(&application.Application{
+		AppID: appID,
+		Groups: []application.DeviceGroup{
+			{ID: "valves", Devices: []string{"dev1"}, Selector: map[string]string{"type": "valve"}},
+		},
+	})
+	h.devices.Set(&device.Device{AppID: appID, DevID: "dev1"})
+	h.devices.Set(&device.Device{AppID: appID, DevID: "dev2", Attributes: map[string]string{"type": "valve"}})
+	h.devices.Set(&device.Device{AppID: appID, DevID: "dev3", Attributes: map[string]string{"type": "meter"}})
+	defer func() {
+		h.applications.Delete(appID)
+		for _, devID := range []string{"dev1", "dev2", "dev3"} {
+			h.devices.Delete(appID, devID)
+		}
+	}()
+
+	_, err := h.EnqueueGroupDownlink(appID, "unknown", &types.DownlinkMessage{PayloadRaw: []byte{0x01}})
+	a.So(err, ShouldNotBeNil)
+
+	report, err := h.EnqueueGroupDownlink(appID, "valves", &types.DownlinkMessage{FPort: 1, PayloadRaw: []byte{0x01}, Confirmed: true})
+	a.So(err, ShouldBeNil)
+	a.So(report.Devices, ShouldEqual, 2)
+	a.So(report.Scheduled, ShouldEqual, 2)
+	a.So(report.Failed, ShouldEqual, 0)
+
+	for _, devID := range []string{"dev1", "dev2"} {
+		queue, _ := h.devices.DownlinkQueue(appID, devID)
+		next, err := queue.Next()
+		a.So(err, ShouldBeNil)
+		a.So(next.GroupDownlinkID, ShouldEqual, report.ID)
+		a.So(next.PayloadRaw, ShouldResemble, []byte{0x01})
+	}
+	queue, _ := h.devices.DownlinkQueue(appID, "dev3")
+	length, _ := queue.Length()
+	a.So(length, ShouldEqual, 0)
+
+	msg := &types.DownlinkMessage{GroupDownlinkID: report.ID}
+	h.countGroupDownlink(appID, msg, group.Sent)
+	h.countGroupDownlink(appID, msg, group.Sent)
+	h.countGroupDownlink(appID, msg, group.Acked)
+
+	report, err = h.groupReports.Get(appID, report.ID)
+	a.So(err, ShouldBeNil)
+	a.So(report.GroupID, ShouldEqual, "valves")
+	a.So(report.Scheduled, ShouldEqual, 2)
+	a.So(report.Failed, ShouldEqual, 0)
+	a.So(report.Sent, ShouldEqual, 2)
+	a.So(report.Acked, ShouldEqual, 1)
+}
@@ -5,6 +5,7 @@ package handler
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
 	pb_broker "github.com/TheThingsNetwork/api/broker"
@@ -13,12 +14,18 @@ import (
 	"github.com/TheThingsNetwork/api/monitor/monitorclient"
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/go-utils/grpc/auth"
-	"github.com/TheThingsNetwork/ttn/amqp"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/fragment"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	"github.com/TheThingsNetwork/ttn/core/handler/geolocation"
+	"github.com/TheThingsNetwork/ttn/core/handler/group"
+	"github.com/TheThingsNetwork/ttn/core/handler/history"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
 	"github.com/TheThingsNetwork/ttn/core/types"
-	"github.com/TheThingsNetwork/ttn/mqtt"
+	"github.com/TheThingsNetwork/ttn/utils/keylock"
 	"google.golang.org/grpc"
 	"gopkg.in/redis.v5"
 )
@@ -30,22 +37,37 @@ type Handler interface {
 
 	WithMQTT(username, password string, brokers ...string) Handler
 	WithAMQP(username, password, host, exchange string) Handler
+	WithWebhooks() Handler
+	WithIntegration(i Integration) Handler
+	WithSpool(maxSize int, maxAge time.Duration) Handler
+	WithHistory(maxSize int, maxAge time.Duration) Handler
+	WithLocationSolver(solver *geolocation.Solver) Handler
 	WithDeviceAttributes(attribute ...string) Handler
 
 	HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) error
 	HandleActivationChallenge(challenge *pb_broker.ActivationChallengeRequest) (*pb_broker.ActivationChallengeResponse, error)
 	HandleActivation(activation *pb_broker.DeduplicatedDeviceActivationRequest) (*pb.DeviceActivationResponse, error)
 	EnqueueDownlink(appDownlink *types.DownlinkMessage) error
+	EnqueueGroupDownlink(appID, groupID string, appDownlink *types.DownlinkMessage) (*group.Report, error)
+
+	RegisterHTTP(mux *http.ServeMux)
 }
 
 // NewRedisHandler creates a new Redis-backed Handler
 func NewRedisHandler(client *redis.Client, ttnBrokerID string) Handler {
 	return &handler{
-		devices:      device.NewRedisDeviceStore(client, "handler"),
-		applications: application.NewRedisApplicationStore(client, "handler"),
-		ttnBrokerID:  ttnBrokerID,
-		qUp:          make(chan *types.UplinkMessage),
-		qEvent:       make(chan *types.DeviceEvent),
+		devices:           device.NewRedisDeviceStore(client, "handler"),
+		applications:      application.NewRedisApplicationStore(client, "handler"),
+		spool:             spool.NewRedisSpoolStore(client, "handler", DefaultSpoolSize, DefaultSpoolAge),
+		history:           history.NewRedisHistoryStore(client, "handler", 0, 0),
+		scripts:           functions.NewCache(),
+		groupReports:      group.NewRedisReportStore(client, "handler"),
+		functionRevisions: application.NewRedisRevisionStore(client, "handler"),
+		fragments:         fragment.NewRedisFragmentStore(client, "handler"),
+		quotas:            newRedisQuotas(client, "handler"),
+		ttnBrokerID:       ttnBrokerID,
+		qUp:               make(chan *types.UplinkMessage),
+		qEvent:            make(chan *types.DeviceEvent),
 	}
 }
 
@@ -61,24 +83,29 @@ type handler struct {
 	ttnBrokerManager pb_broker.BrokerManagerClient
 	ttnDeviceManager pb_lorawan.DeviceManagerClient
 
+	ttnNetworkServerManager pb_manager.NetworkServerManagerClient
+
 	downlink chan *pb_broker.DownlinkMessage
 
-	mqttClient   mqtt.Client
-	mqttUsername string
-	mqttPassword string
-	mqttBrokers  []string
-	mqttEnabled  bool
-	mqttUp       chan *types.UplinkMessage
-	mqttEvent    chan *types.DeviceEvent
-
-	amqpClient   amqp.Client
-	amqpUsername string
-	amqpPassword string
-	amqpHost     string
-	amqpExchange string
-	amqpEnabled  bool
-	amqpUp       chan *types.UplinkMessage
-	amqpEvent    chan *types.DeviceEvent
+	integrations []*integration
+	spool        spool.Store
+
+	history        history.Store
+	historyEnabled bool
+	qHistory       chan *history.Entry
+
+	scripts           *functions.Cache
+	functionRevisions application.RevisionStore
+
+	fragments fragment.Store
+
+	locationSolver *geolocation.Solver
+
+	groupReports group.Store
+
+	quotas *quotas
+
+	deviceLocks keylock.Locks
 
 	qUp    chan *types.UplinkMessage
 	qEvent chan *types.DeviceEvent
@@ -90,22 +117,42 @@ type handler struct {
 var (
 	// AMQPDownlinkQueue is the AMQP queue to use for downlink
 	AMQPDownlinkQueue = "ttn-handler-downlink"
+
+	// DefaultSpoolSize is the default maximum number of messages in the spool of an application
+	DefaultSpoolSize = 1000
+	// DefaultSpoolAge is the default maximum age of messages in the spool
+	DefaultSpoolAge = 24 * time.Hour
 )
 
 func (h *handler) WithMQTT(username, password string, brokers ...string) Handler {
-	h.mqttUsername = username
-	h.mqttPassword = password
-	h.mqttBrokers = brokers
-	h.mqttEnabled = true
-	return h
+	var mqttBrokers []string
+	for _, broker := range brokers {
+		mqttBrokers = append(mqttBrokers, fmt.Sprintf("tcp://%s", broker))
+	}
+	return h.WithIntegration(NewMQTTIntegration(username, password, mqttBrokers...))
 }
 
 func (h *handler) WithAMQP(username, password, host, exchange string) Handler {
-	h.amqpUsername = username
-	h.amqpPassword = password
-	h.amqpHost = host
-	h.amqpExchange = exchange
-	h.amqpEnabled = true
+	return h.WithIntegration(NewAMQPIntegration(username, password, host, exchange, AMQPDownlinkQueue))
+}
+
+func (h *handler) WithWebhooks() Handler {
+	return h.WithIntegration(NewWebhookIntegration(h.applications))
+}
+
+func (h *handler) WithSpool(maxSize int, maxAge time.Duration) Handler {
+	h.spool.SetLimits(maxSize, maxAge)
+	return h
+}
+
+func (h *handler) WithHistory(maxSize int, maxAge time.Duration) Handler {
+	h.history.SetLimits(maxSize, maxAge)
+	h.historyEnabled = true
+	return h
+}
+
+func (h *handler) WithLocationSolver(solver *geolocation.Solver) Handler {
+	h.locationSolver = solver
 	return h
 }
 
@@ -127,45 +174,14 @@ func (h *handler) Init(c *component.Component) error {
 		return err
 	}
 
-	if h.mqttEnabled {
-		var brokers []string
-		for _, broker := range h.mqttBrokers {
-			brokers = append(brokers, fmt.Sprintf("tcp://%s", broker))
-		}
-		err = h.HandleMQTT(h.mqttUsername, h.mqttPassword, brokers...)
-		if err != nil {
-			return err
-		}
-	}
+	h.startHistory()
+	h.startFragmentTimeouts()
 
-	if h.amqpEnabled {
-		err = h.HandleAMQP(h.amqpUsername, h.amqpPassword, h.amqpHost, h.amqpExchange, AMQPDownlinkQueue)
-		if err != nil {
-			return err
-		}
+	err = h.startIntegrations()
+	if err != nil {
+		return err
 	}
 
-	go func() {
-		for {
-			select {
-			case up := <-h.qUp:
-				if h.mqttEnabled {
-					h.mqttUp <- up
-				}
-				if h.amqpEnabled {
-					h.amqpUp <- up
-				}
-			case event := <-h.qEvent:
-				if h.mqttEnabled {
-					h.mqttEvent <- event
-				}
-				if h.amqpEnabled {
-					h.amqpEvent <- event
-				}
-			}
-		}
-	}()
-
 	err = h.associateBroker()
 	if err != nil {
 		return err
@@ -185,11 +201,13 @@ func (h *handler) Init(c *component.Component) error {
 }
 
 func (h *handler) Shutdown() {
-	if h.mqttEnabled {
-		h.mqttClient.Disconnect()
-	}
-	if h.amqpEnabled {
-		h.amqpClient.Disconnect()
+	h.stopIntegrations()
+}
+
+// invalidateFunctions removes the compiled payload functions of the application from the cache
+func (h *handler) invalidateFunctions(appID string) {
+	if h.scripts != nil {
+		h.scripts.Invalidate(appID + ":")
 	}
 }
 
@@ -206,6 +224,7 @@ func (h *handler) associateBroker() error {
 	h.ttnBroker = pb_broker.NewBrokerClient(conn)
 	h.ttnBrokerManager = pb_broker.NewBrokerManagerClient(conn)
 	h.ttnDeviceManager = pb_lorawan.NewDeviceManagerClient(conn)
+	h.ttnNetworkServerManager = pb_manager.NewNetworkServerManagerClient(conn)
 
 	h.downlink = make(chan *pb_broker.DownlinkMessage)
 
@@ -0,0 +1,143 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/history"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// rightMessagesUp is the right to read the uplink messages of the devices of an application
+const rightMessagesUp types.Right = "messages:up:r"
+
+// startHistory starts recording the uplink messages and events of devices, if the history is enabled
+func (h *handler) startHistory() {
+	if !h.historyEnabled {
+		return
+	}
+	h.qHistory = make(chan *history.Entry, IntegrationBufferSize)
+	go func() {
+		for entry := range h.qHistory {
+			h.storeHistory(entry)
+		}
+	}()
+}
+
+// recordHistory adds the entry to the history buffer, dropping it if the buffer is full
+func (h *handler) recordHistory(entry *history.Entry) {
+	if h.qHistory == nil {
+		return
+	}
+	entry.Time = time.Now()
+	select {
+	case h.qHistory <- entry:
+	default:
+		h.Ctx.WithFields(ttnlog.Fields{
+			"AppID": entry.AppID(),
+			"DevID": entry.DevID(),
+		}).Warn("History buffer full, dropping entry")
+	}
+}
+
+func (h *handler) storeHistory(entry *history.Entry) {
+	appID, devID := entry.AppID(), entry.DevID()
+	if appID == "" || devID == "" {
+		return
+	}
+	var err error
+	if entry.Event != nil && entry.Event.Event == types.DeleteEvent {
+		err = h.history.Delete(appID, devID)
+	} else {
+		err = h.history.Push(appID, devID, entry)
+	}
+	if err != nil {
+		h.Ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).WithError(err).Warn("Could not store history")
+	}
+}
+
+func (h *handler) getHistory(appID, devID string, query history.Query) ([]*history.Entry, error) {
+	if !h.historyEnabled {
+		return nil, errors.NewErrNotFound("History (not enabled on this Handler)")
+	}
+	if _, err := h.devices.Get(appID, devID); err != nil {
+		return nil, err
+	}
+	return h.history.Query(appID, devID, query)
+}
+
+// historyEntryToPb converts a history entry to its proto
+func historyEntryToPb(entry *history.Entry) (*pb_manager.HistoryEntry, error) {
+	pb := &pb_manager.HistoryEntry{Time: entry.Time.UnixNano()}
+	if up := entry.Uplink; up != nil {
+		pb.Uplink = &pb_manager.HistoryUplink{
+			FPort:      uint32(up.FPort),
+			FCnt:       up.FCnt,
+			Confirmed:  up.Confirmed,
+			IsRetry:    up.IsRetry,
+			PayloadRaw: up.PayloadRaw,
+		}
+		if len(up.PayloadFields) > 0 {
+			fields, err := json.Marshal(up.PayloadFields)
+			if err != nil {
+				return nil, err
+			}
+			pb.Uplink.PayloadFields = string(fields)
+		}
+		metadata, err := json.Marshal(up.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		pb.Uplink.Metadata = string(metadata)
+	}
+	if event := entry.Event; event != nil {
+		pb.Event = &pb_manager.HistoryEvent{Event: string(event.Event)}
+		if event.Data != nil {
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				return nil, err
+			}
+			pb.Event.Data = string(data)
+		}
+	}
+	return pb, nil
+}
+
+func (h *handlerManager) GetHistory(ctx context.Context, in *pb_manager.HistoryRequest) (*pb_manager.History, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid History Request")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rightMessagesUp); err != nil {
+		return nil, err
+	}
+	query := history.Query{Limit: int(in.Limit)}
+	if in.Since != 0 {
+		query.Since = time.Unix(0, in.Since)
+	}
+	if in.Until != 0 {
+		query.Until = time.Unix(0, in.Until)
+	}
+	entries, err := h.handler.getHistory(in.AppID, in.DevID, query)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.History{Entries: make([]*pb_manager.HistoryEntry, 0, len(entries))}
+	for _, entry := range entries {
+		pb, err := historyEntryToPb(entry)
+		if err != nil {
+			return nil, err
+		}
+		res.Entries = append(res.Entries, pb)
+	}
+	return res, nil
+}
@@ -0,0 +1,182 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"gopkg.in/redis.v5"
+)
+
+// Entry is an uplink message or event of a device
+type Entry struct {
+	Time   time.Time            `json:"time"`
+	Uplink *types.UplinkMessage `json:"uplink,omitempty"`
+	Event  *types.DeviceEvent   `json:"event,omitempty"`
+}
+
+// AppID returns the AppID of the message in the entry
+func (e *Entry) AppID() string {
+	if e.Uplink != nil {
+		return e.Uplink.AppID
+	}
+	if e.Event != nil {
+		return e.Event.AppID
+	}
+	return ""
+}
+
+// DevID returns the DevID of the message in the entry
+func (e *Entry) DevID() string {
+	if e.Uplink != nil {
+		return e.Uplink.DevID
+	}
+	if e.Event != nil {
+		return e.Event.DevID
+	}
+	return ""
+}
+
+// Query selects entries from the history. Zero values mean no restriction.
+type Query struct {
+	Since time.Time
+	Until time.Time
+	Limit int // The most recent entries are returned if there are more than Limit
+}
+
+// Store stores the recent uplink messages and events of devices, in a rolling queue per device
+type Store interface {
+	Push(appID, devID string, entry *Entry) error
+	Query(appID, devID string, query Query) ([]*Entry, error)
+	Delete(appID, devID string) error
+	SetLimits(maxSize int, maxAge time.Duration)
+}
+
+const defaultRedisPrefix = "handler"
+const redisHistoryPrefix = "history"
+
+// NewRedisHistoryStore creates a new Redis-based history store. Each queue holds at most maxSize entries and
+// entries older than maxAge are discarded. A zero maxSize or maxAge disables the corresponding limit.
+func NewRedisHistoryStore(client *redis.Client, prefix string, maxSize int, maxAge time.Duration) *RedisHistoryStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	return &RedisHistoryStore{
+		queues:  storage.NewRedisQueueStore(client, prefix+":"+redisHistoryPrefix),
+		maxSize: maxSize,
+		maxAge:  maxAge,
+	}
+}
+
+// RedisHistoryStore stores the history in Redis.
+// - Histories are stored as a List per device, oldest entry first
+type RedisHistoryStore struct {
+	queues  *storage.RedisQueueStore
+	maxSize int
+	maxAge  time.Duration
+}
+
+func (s *RedisHistoryStore) key(appID, devID string) string {
+	return fmt.Sprintf("%s:%s", appID, devID)
+}
+
+// SetLimits sets the maximum number of entries per device and the maximum age of the entries
+func (s *RedisHistoryStore) SetLimits(maxSize int, maxAge time.Duration) {
+	s.maxSize = maxSize
+	s.maxAge = maxAge
+}
+
+// Push an entry to the history of the device, discarding the oldest entries if the history is full and the
+// entries that are older than maxAge
+func (s *RedisHistoryStore) Push(appID, devID string, entry *Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	key := s.key(appID, devID)
+	if err := s.queues.AddEnd(key, string(data)); err != nil {
+		return err
+	}
+	if s.maxSize > 0 {
+		length, err := s.queues.Length(key)
+		if err != nil {
+			return err
+		}
+		for ; length > s.maxSize; length-- {
+			if _, err := s.queues.Next(key); err != nil {
+				return err
+			}
+		}
+	}
+	if s.maxAge <= 0 {
+		return nil
+	}
+	if err := s.discardExpired(key); err != nil {
+		return err
+	}
+	// The history of a device that does not send anything expires as a whole
+	return s.queues.Expire(key, s.maxAge)
+}
+
+// discardExpired removes the entries that are older than maxAge from the head of the queue
+func (s *RedisHistoryStore) discardExpired(key string) error {
+	oldest := time.Now().Add(-1 * s.maxAge)
+	for {
+		items, err := s.queues.GetFront(key, 1)
+		if err != nil || len(items) == 0 {
+			return err
+		}
+		entry := new(Entry)
+		if err := json.Unmarshal([]byte(items[0]), entry); err == nil && !entry.Time.Before(oldest) {
+			return nil
+		}
+		if _, err := s.queues.Next(key); err != nil {
+			return err
+		}
+	}
+}
+
+// Query the history of the device. The entries are returned oldest first.
+func (s *RedisHistoryStore) Query(appID, devID string, query Query) ([]*Entry, error) {
+	items, err := s.queues.Get(s.key(appID, devID))
+	if err != nil {
+		return nil, err
+	}
+	since := query.Since
+	if s.maxAge > 0 {
+		if oldest := time.Now().Add(-1 * s.maxAge); oldest.After(since) {
+			since = oldest
+		}
+	}
+	entries := make([]*Entry, 0, len(items))
+	for _, item := range items {
+		entry := new(Entry)
+		if err := json.Unmarshal([]byte(item), entry); err != nil {
+			return nil, err
+		}
+		if entry.Time.Before(since) {
+			continue
+		}
+		if !query.Until.IsZero() && entry.Time.After(query.Until) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if query.Limit > 0 && len(entries) > query.Limit {
+		entries = entries[len(entries)-query.Limit:]
+	}
+	return entries, nil
+}
+
+// Delete the history of the device
+func (s *RedisHistoryStore) Delete(appID, devID string) error {
+	return s.queues.Delete(s.key(appID, devID))
+}
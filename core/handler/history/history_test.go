@@ -0,0 +1,112 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHistoryStore(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisHistoryStore(GetRedisClient(), "handler-test-history-store", 4, time.Hour)
+
+	defer func() {
+		s.Delete("app", "dev")
+	}()
+
+	{
+		entries, err := s.Query("app", "dev", Query{})
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldBeEmpty)
+	}
+
+	now := time.Now()
+
+	err := s.Push("app", "dev", &Entry{
+		Time:  now.Add(-2 * time.Hour),
+		Event: &types.DeviceEvent{AppID: "app", DevID: "dev", Event: types.ActivationEvent},
+	})
+	a.So(err, ShouldBeNil)
+	for i := 0; i < 4; i++ {
+		err := s.Push("app", "dev", &Entry{
+			Time:   now.Add(time.Duration(i-4) * time.Minute),
+			Uplink: &types.UplinkMessage{AppID: "app", DevID: "dev", PayloadRaw: []byte{byte(i)}},
+		})
+		a.So(err, ShouldBeNil)
+	}
+	err = s.Push("app", "dev", &Entry{
+		Event: &types.DeviceEvent{AppID: "app", DevID: "dev", Event: types.DownlinkScheduledEvent},
+	})
+	a.So(err, ShouldBeNil)
+
+	// The oldest entries were discarded
+	{
+		entries, err := s.Query("app", "dev", Query{})
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldHaveLength, 4)
+		a.So(entries[0].Uplink.PayloadRaw, ShouldResemble, []byte{1})
+		a.So(entries[3].Event.Event, ShouldEqual, types.DownlinkScheduledEvent)
+	}
+
+	// Limit returns the most recent entries
+	{
+		entries, err := s.Query("app", "dev", Query{Limit: 2})
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldHaveLength, 2)
+		a.So(entries[0].Uplink.PayloadRaw, ShouldResemble, []byte{3})
+	}
+
+	// Time range
+	{
+		entries, err := s.Query("app", "dev", Query{
+			Since: now.Add(-150 * time.Second),
+			Until: now.Add(-30 * time.Second),
+		})
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldHaveLength, 2)
+		a.So(entries[0].Uplink.PayloadRaw, ShouldResemble, []byte{2})
+		a.So(entries[1].Uplink.PayloadRaw, ShouldResemble, []byte{3})
+	}
+
+	// Old entries are not returned
+	{
+		err := s.Push("app", "other", &Entry{
+			Time:   now.Add(-2 * time.Hour),
+			Uplink: &types.UplinkMessage{AppID: "app", DevID: "other"},
+		})
+		a.So(err, ShouldBeNil)
+		defer s.Delete("app", "other")
+
+		entries, err := s.Query("app", "other", Query{})
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldBeEmpty)
+
+		// Old entries are also removed from Redis
+		length, err := s.queues.Length("app:other")
+		a.So(err, ShouldBeNil)
+		a.So(length, ShouldEqual, 0)
+	}
+
+	// The history expires if the device does not send anything
+	{
+		ttl, err := GetRedisClient().TTL("handler-test-history-store:history:app:dev").Result()
+		a.So(err, ShouldBeNil)
+		a.So(ttl, ShouldBeGreaterThan, 59*time.Minute)
+		a.So(ttl, ShouldBeLessThanOrEqualTo, time.Hour)
+	}
+
+	{
+		err := s.Delete("app", "dev")
+		a.So(err, ShouldBeNil)
+		entries, err := s.Query("app", "dev", Query{})
+		a.So(err, ShouldBeNil)
+		a.So(entries, ShouldBeEmpty)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/handler/history"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestHistory(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestHistory")},
+		devices:   device.NewRedisDeviceStore(GetRedisClient(), "handler-test-history"),
+		history:   history.NewRedisHistoryStore(GetRedisClient(), "handler-test-history", 0, 0),
+	}
+
+	// Not enabled
+	h.recordHistory(&history.Entry{Uplink: &types.UplinkMessage{AppID: appID, DevID: devID}})
+	_, err := h.getHistory(appID, devID, history.Query{})
+	a.So(err, ShouldNotBeNil)
+
+	h.WithHistory(10, time.Hour)
+	h.startHistory()
+
+	h.devices.Set(&device.Device{AppID: appID, DevID: devID})
+	defer func() {
+		h.devices.Delete(appID, devID)
+		h.history.Delete(appID, devID)
+	}()
+
+	h.recordHistory(&history.Entry{Uplink: &types.UplinkMessage{AppID: appID, DevID: devID, PayloadRaw: []byte{0x01}}})
+	h.recordHistory(&history.Entry{Event: &types.DeviceEvent{AppID: appID, DevID: devID, Event: types.DownlinkScheduledEvent}})
+	time.Sleep(50 * time.Millisecond)
+
+	entries, err := h.getHistory(appID, devID, history.Query{})
+	a.So(err, ShouldBeNil)
+	a.So(entries, ShouldHaveLength, 2)
+	a.So(entries[0].Uplink.PayloadRaw, ShouldResemble, []byte{0x01})
+	a.So(entries[1].Event.Event, ShouldEqual, types.DownlinkScheduledEvent)
+
+	// The history is deleted with the device
+	h.recordHistory(&history.Entry{Event: &types.DeviceEvent{AppID: appID, DevID: devID, Event: types.DeleteEvent}})
+	time.Sleep(50 * time.Millisecond)
+
+	entries, err = h.getHistory(appID, devID, history.Query{})
+	a.So(err, ShouldBeNil)
+	a.So(entries, ShouldBeEmpty)
+}
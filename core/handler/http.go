@@ -0,0 +1,91 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/claims"
+	"github.com/TheThingsNetwork/ttn/api/ratelimit"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// rightMessagesDown is the right to send downlink messages to the devices of an application
+const rightMessagesDown types.Right = "messages:down:w"
+
+// handlerHTTP serves the parts of the Handler API that are only available over HTTP
+type handlerHTTP struct {
+	*handlerManager
+}
+
+// RegisterHTTP registers the HTTP API of the Handler on the given mux
+func (h *handler) RegisterHTTP(mux *http.ServeMux) {
+	server := &handlerHTTP{
+		handlerManager: &handlerManager{
+			handler:         h,
+			applicationRate: ratelimit.NewRegistry(5000, time.Hour),
+			clientRate:      ratelimit.NewRegistry(5000, time.Hour),
+		},
+	}
+	mux.HandleFunc("/integrations/http/", server.serveWebhookDownlink)
+}
+
+// requestContext returns a context with the authorization of the request as gRPC metadata
+func requestContext(req *http.Request) context.Context {
+	md := metadata.MD{}
+	if authorization := req.Header.Get("authorization"); authorization != "" {
+		if len(authorization) >= 7 && strings.ToLower(authorization[0:7]) == "bearer " {
+			md = metadata.Pairs("token", authorization[7:])
+		}
+		if len(authorization) >= 4 && strings.ToLower(authorization[0:4]) == "key " {
+			md = metadata.Pairs("key", authorization[4:])
+		}
+	}
+	return metadata.NewIncomingContext(req.Context(), md)
+}
+
+// validateRequest validates the authorization of the request and checks if it grants the right for the application
+func (h *handlerHTTP) validateRequest(req *http.Request, appID string, right types.Right) (context.Context, *claims.Claims, error) {
+	return h.validateAppRights(requestContext(req), appID, right)
+}
+
+// pathParts splits the path after the prefix into its parts
+func pathParts(path, prefix string) []string {
+	path = strings.Trim(strings.TrimPrefix(path, prefix), "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func readJSON(req *http.Request, v interface{}) error {
+	defer req.Body.Close()
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		return errors.NewErrInvalidArgument("Request body", err.Error())
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	err = errors.BuildGRPCError(err)
+	writeJSON(w, runtime.HTTPStatusFromCode(grpc.Code(err)), map[string]string{
+		"error": grpc.ErrorDesc(err),
+	})
+}
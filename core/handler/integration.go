@@ -0,0 +1,421 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/history"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// Integration connects the Handler to an external system. It publishes uplink messages and events to that
+// system and passes the downlink messages that it receives back to the Handler.
+type Integration interface {
+	// Name of the integration, used for logging
+	Name() string
+
+	// Connect to the external system
+	Connect(ctx ttnlog.Interface) error
+	// Disconnect from the external system
+	Disconnect()
+	// Healthy returns false if the integration is currently unable to publish messages
+	Healthy() bool
+
+	PublishUplink(up *types.UplinkMessage) error
+	PublishEvent(event *types.DeviceEvent) error
+	SubscribeDownlink(handler DownlinkHandler) error
+}
+
+// DownlinkHandler is called by an Integration for each downlink message that it receives
+type DownlinkHandler func(down *types.DownlinkMessage) error
+
+// publishFailureHandler is called for messages that could not be published after Publish returned
+type publishFailureHandler func(entry *spool.Entry, err error)
+
+// asyncIntegration is implemented by integrations that do not wait for the external system to confirm a publish.
+// Entries that turn out to be not published are passed to the publish failure handler, so that they can be
+// spooled at their original position.
+type asyncIntegration interface {
+	onPublishFailure(handler publishFailureHandler)
+	publishEntry(entry *spool.Entry) error
+}
+
+// targetedIntegration is implemented by integrations that publish the messages of an application to several
+// targets, such as webhooks. Messages are published, spooled and replayed per target, so that a target that is
+// unavailable does not hold up the others. The entries that are published have their Target set.
+type targetedIntegration interface {
+	// targets returns the targets of the application of the entry that the entry should be published to
+	targets(entry *spool.Entry) ([]string, error)
+	// healthyTarget returns false if the target is currently unable to receive messages
+	healthyTarget(appID, target string) bool
+}
+
+// failedEntry is a message that an asynchronous integration could not publish
+type failedEntry struct {
+	entry *spool.Entry
+	err   error
+}
+
+// IntegrationBufferSize indicates the size of the uplink and event buffers of each integration
+var IntegrationBufferSize = 100
+
+// SpoolReplayInterval indicates how often integrations try to replay their spool
+var SpoolReplayInterval = 5 * time.Second
+
+// IntegrationHealthInterval indicates how often the health of the integrations is checked
+var IntegrationHealthInterval = 10 * time.Second
+
+// integration wraps an Integration with its own buffers, so that a slow integration does not block the others
+type integration struct {
+	Integration
+	ctx     ttnlog.Interface
+	up      chan *types.UplinkMessage
+	event   chan *types.DeviceEvent
+	failed  chan failedEntry
+	healthy bool
+	seq     int64
+
+	spool   spool.Store
+	spooled map[string]bool // queues with messages in the spool
+}
+
+// IntegrationFactory creates an Integration from its configuration
+type IntegrationFactory func(config map[string]string) (Integration, error)
+
+var integrationFactories = make(map[string]IntegrationFactory)
+
+// RegisterIntegration registers a type of integration, so that it can be enabled from the configuration of the
+// Handler. It is typically called from the init function of the package that implements the integration.
+func RegisterIntegration(name string, factory IntegrationFactory) {
+	integrationFactories[name] = factory
+}
+
+// NewIntegration creates an Integration of a registered type
+func NewIntegration(name string, config map[string]string) (Integration, error) {
+	factory, ok := integrationFactories[name]
+	if !ok {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Integration %s", name))
+	}
+	return factory(config)
+}
+
+func (h *handler) WithIntegration(i Integration) Handler {
+	h.integrations = append(h.integrations, &integration{Integration: i})
+	return h
+}
+
+// startIntegration connects the integration, subscribes to its downlink and starts publishing to it
+func (h *handler) startIntegration(i *integration) error {
+	i.ctx = h.Ctx.WithField("Integration", i.Name())
+
+	if err := i.Connect(i.ctx); err != nil {
+		return err
+	}
+	if err := i.SubscribeDownlink(h.EnqueueDownlink); err != nil {
+		i.Disconnect()
+		return err
+	}
+	if subscriber, ok := i.Integration.(GroupDownlinkSubscriber); ok {
+		err := subscriber.SubscribeGroupDownlink(func(appID, groupID string, down *types.DownlinkMessage) error {
+			_, err := h.EnqueueGroupDownlink(appID, groupID, down)
+			return err
+		})
+		if err != nil {
+			i.Disconnect()
+			return err
+		}
+	}
+
+	i.up = make(chan *types.UplinkMessage, IntegrationBufferSize)
+	i.event = make(chan *types.DeviceEvent, IntegrationBufferSize)
+	i.failed = make(chan failedEntry, IntegrationBufferSize)
+	i.healthy = true
+	i.spool = h.spool
+	i.spooled = make(map[string]bool)
+
+	if i.spool != nil {
+		queues, err := i.spool.Queues(i.Name())
+		if err != nil {
+			i.Disconnect()
+			return err
+		}
+		for _, queue := range queues {
+			i.spooled[queue] = true
+		}
+	}
+
+	if async, ok := i.Integration.(asyncIntegration); ok {
+		async.onPublishFailure(func(entry *spool.Entry, err error) {
+			select {
+			case i.failed <- failedEntry{entry, err}:
+			default:
+				i.entryContext(entry).WithError(err).Warn("Could not publish message, dropping message")
+			}
+		})
+	}
+
+	go func() {
+		replay := time.NewTicker(SpoolReplayInterval)
+		defer replay.Stop()
+		for {
+			select {
+			case up, ok := <-i.up:
+				if !ok {
+					return
+				}
+				i.handle(&spool.Entry{Uplink: up})
+			case event, ok := <-i.event:
+				if !ok {
+					return
+				}
+				i.handle(&spool.Entry{Event: event})
+			case failed := <-i.failed:
+				i.handleFailed(failed.entry, failed.err)
+			case <-replay.C:
+				i.replay()
+			}
+		}
+	}()
+
+	return nil
+}
+
+// startIntegrations starts all registered integrations and distributes uplink messages and events to them
+func (h *handler) startIntegrations() error {
+	for _, i := range h.integrations {
+		if err := h.startIntegration(i); err != nil {
+			return err
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case up := <-h.qUp:
+				h.recordHistory(&history.Entry{Uplink: up})
+				h.publishUplink(up)
+			case event := <-h.qEvent:
+				h.recordHistory(&history.Entry{Event: event})
+				h.publishEvent(event)
+			}
+		}
+	}()
+
+	go func() {
+		for range time.Tick(IntegrationHealthInterval) {
+			h.checkIntegrations()
+		}
+	}()
+
+	return nil
+}
+
+func (h *handler) publishUplink(up *types.UplinkMessage) {
+	for _, i := range h.integrations {
+		select {
+		case i.up <- up:
+		default:
+			i.ctx.WithFields(ttnlog.Fields{
+				"DevID": up.DevID,
+				"AppID": up.AppID,
+			}).Warn("Uplink buffer full, dropping Uplink")
+		}
+	}
+}
+
+func (h *handler) publishEvent(event *types.DeviceEvent) {
+	for _, i := range h.integrations {
+		select {
+		case i.event <- event:
+		default:
+			i.ctx.WithFields(ttnlog.Fields{
+				"DevID": event.DevID,
+				"AppID": event.AppID,
+				"Event": event.Event,
+			}).Warn("Event buffer full, dropping Event")
+		}
+	}
+}
+
+func (i *integration) entryContext(entry *spool.Entry) ttnlog.Interface {
+	ctx := i.ctx
+	if entry.Target != "" {
+		ctx = ctx.WithField("Target", entry.Target)
+	}
+	if entry.Uplink != nil {
+		return ctx.WithFields(ttnlog.Fields{
+			"DevID": entry.Uplink.DevID,
+			"AppID": entry.Uplink.AppID,
+		})
+	}
+	return ctx.WithFields(ttnlog.Fields{
+		"DevID": entry.Event.DevID,
+		"AppID": entry.Event.AppID,
+		"Event": entry.Event.Event,
+	})
+}
+
+// healthyFor returns false if the integration is currently unable to publish messages to the target of the
+// application
+func (i *integration) healthyFor(appID, target string) bool {
+	if !i.Healthy() {
+		return false
+	}
+	if targeted, ok := i.Integration.(targetedIntegration); ok && target != "" {
+		return targeted.healthyTarget(appID, target)
+	}
+	return true
+}
+
+func (i *integration) publish(entry *spool.Entry) error {
+	if async, ok := i.Integration.(asyncIntegration); ok {
+		return async.publishEntry(entry)
+	}
+	if entry.Uplink != nil {
+		return i.PublishUplink(entry.Uplink)
+	}
+	return i.PublishEvent(entry.Event)
+}
+
+// handle publishes the entry, or adds it to the spool if the integration is unavailable or if older messages of
+// the same queue are still waiting in the spool. Entries for targeted integrations are handled per target.
+func (i *integration) handle(entry *spool.Entry) {
+	if entry.Seq == 0 {
+		entry.Seq = i.nextSeq()
+	}
+	if targeted, ok := i.Integration.(targetedIntegration); ok && entry.Target == "" {
+		targets, err := targeted.targets(entry)
+		if err != nil {
+			i.entryContext(entry).WithError(err).Warn("Could not get targets, dropping message")
+			return
+		}
+		for _, target := range targets {
+			targetEntry := *entry
+			targetEntry.Target = target
+			i.handle(&targetEntry)
+		}
+		return
+	}
+	ctx := i.entryContext(entry)
+	if i.spool == nil || (!i.spooled[entry.Queue()] && i.healthyFor(entry.AppID(), entry.Target)) {
+		if entry.Uplink != nil {
+			ctx.Debug("Publish Uplink")
+		} else {
+			ctx.Debug("Publish Event")
+		}
+		if err := i.publish(entry); err != nil {
+			i.handleFailed(entry, err)
+		}
+		return
+	}
+	i.spoolEntry(ctx, entry)
+}
+
+// nextSeq returns the sequence number for a new entry. It is based on the time, so that entries that are
+// spooled after a restart are ordered after the entries that were spooled before.
+func (i *integration) nextSeq() int64 {
+	seq := time.Now().UnixNano() / int64(time.Microsecond)
+	if seq <= i.seq {
+		seq = i.seq + 1
+	}
+	i.seq = seq
+	return seq
+}
+
+// handleFailed adds the entry that could not be published to the spool, or drops it if there is no spool
+func (i *integration) handleFailed(entry *spool.Entry, err error) {
+	ctx := i.entryContext(entry).WithError(err)
+	if i.spool == nil {
+		if entry.Uplink != nil {
+			ctx.Warn("Could not publish Uplink")
+		} else {
+			ctx.Warn("Could not publish Event")
+		}
+		return
+	}
+	i.spoolEntry(ctx, entry)
+}
+
+func (i *integration) spoolEntry(ctx ttnlog.Interface, entry *spool.Entry) {
+	if err := i.spool.Push(i.Name(), entry); err != nil {
+		ctx.WithError(err).Warn("Could not add message to spool, dropping message")
+		return
+	}
+	i.spooled[entry.Queue()] = true
+	ctx.Debug("Added message to spool")
+}
+
+// replay publishes the spooled messages of each queue that can be published to in order, until the queue is
+// empty or publishing fails
+func (i *integration) replay() {
+	if len(i.spooled) == 0 || !i.Healthy() {
+		return
+	}
+	for queue := range i.spooled {
+		appID, target := spool.SplitQueue(queue)
+		if !i.healthyFor(appID, target) {
+			continue
+		}
+		ctx := i.ctx.WithField("AppID", appID)
+		if target != "" {
+			ctx = ctx.WithField("Target", target)
+		}
+		replayed, err := i.replayQueue(queue)
+		if err != nil {
+			ctx.WithError(err).Warn("Could not replay spool")
+			continue
+		}
+		ctx.WithField("Messages", replayed).Info("Replayed spool")
+	}
+}
+
+// replayQueue publishes the spooled messages of the queue in order, until the queue is empty or publishing fails
+func (i *integration) replayQueue(queue string) (replayed int, err error) {
+	for {
+		entry, err := i.spool.Next(i.Name(), queue)
+		if err != nil {
+			return replayed, err
+		}
+		if entry == nil {
+			delete(i.spooled, queue)
+			return replayed, nil
+		}
+		if err := i.publish(entry); err != nil {
+			if err := i.spool.Push(i.Name(), entry); err != nil {
+				i.entryContext(entry).WithError(err).Warn("Could not return message to spool, dropping message")
+			}
+			return replayed, err
+		}
+		replayed++
+	}
+}
+
+// checkIntegrations logs changes in the health of the integrations
+func (h *handler) checkIntegrations() {
+	for _, i := range h.integrations {
+		healthy := i.Healthy()
+		if healthy == i.healthy {
+			continue
+		}
+		i.healthy = healthy
+		if healthy {
+			i.ctx.Info("Integration is healthy again")
+		} else {
+			i.ctx.Warn("Integration is unhealthy")
+		}
+	}
+}
+
+func (h *handler) stopIntegrations() {
+	for _, i := range h.integrations {
+		if i.up != nil {
+			i.Disconnect()
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+type testIntegration struct {
+	name    string
+	block   chan struct{}
+	healthy bool
+	up      chan *types.UplinkMessage
+	event   chan *types.DeviceEvent
+}
+
+func (i *testIntegration) Name() string                            { return i.name }
+func (i *testIntegration) Connect(ctx ttnlog.Interface) error      { return nil }
+func (i *testIntegration) Disconnect()                             {}
+func (i *testIntegration) Healthy() bool                           { return i.healthy }
+func (i *testIntegration) SubscribeDownlink(DownlinkHandler) error { return nil }
+
+func (i *testIntegration) PublishUplink(up *types.UplinkMessage) error {
+	if i.block != nil {
+		<-i.block
+	}
+	i.up <- up
+	return nil
+}
+
+func (i *testIntegration) PublishEvent(event *types.DeviceEvent) error {
+	if i.block != nil {
+		<-i.block
+	}
+	i.event <- event
+	return nil
+}
+
+func TestIntegrations(t *testing.T) {
+	a := New(t)
+
+	defer func(size int) { IntegrationBufferSize = size }(IntegrationBufferSize)
+	IntegrationBufferSize = 1
+
+	slow := &testIntegration{
+		name:    "slow",
+		block:   make(chan struct{}),
+		healthy: true,
+		up:      make(chan *types.UplinkMessage, 10),
+		event:   make(chan *types.DeviceEvent, 10),
+	}
+	fast := &testIntegration{
+		name:    "fast",
+		healthy: true,
+		up:      make(chan *types.UplinkMessage, 10),
+		event:   make(chan *types.DeviceEvent, 10),
+	}
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestIntegrations")},
+		qUp:       make(chan *types.UplinkMessage),
+		qEvent:    make(chan *types.DeviceEvent),
+	}
+	h.WithIntegration(slow)
+	h.WithIntegration(fast)
+
+	err := h.startIntegrations()
+	a.So(err, ShouldBeNil)
+
+	// The slow integration should not block the fast one
+	for i := 0; i < 5; i++ {
+		select {
+		case h.qUp <- &types.UplinkMessage{AppID: "app", DevID: "dev"}:
+		case <-time.After(50 * time.Millisecond):
+			t.Fatal("Uplink was blocked")
+		}
+	}
+	for i := 0; i < 5; i++ {
+		select {
+		case <-fast.up:
+		case <-time.After(50 * time.Millisecond):
+			t.Fatal("Fast integration did not receive uplink")
+		}
+	}
+
+	h.qEvent <- &types.DeviceEvent{AppID: "app", DevID: "dev", Event: types.ActivationEvent}
+	select {
+	case event := <-fast.event:
+		a.So(event.Event, ShouldEqual, types.ActivationEvent)
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Fast integration did not receive event")
+	}
+
+	close(slow.block)
+	<-time.After(10 * time.Millisecond)
+	a.So(len(slow.up), ShouldBeLessThan, 5)
+
+	// Health changes are tracked
+	fast.healthy = false
+	h.checkIntegrations()
+	a.So(h.integrations[1].healthy, ShouldBeFalse)
+	fast.healthy = true
+	h.checkIntegrations()
+	a.So(h.integrations[1].healthy, ShouldBeTrue)
+}
+
+func TestIntegrationSpool(t *testing.T) {
+	a := New(t)
+
+	store := spool.NewRedisSpoolStore(GetRedisClient(), "handler-test-integration-spool", 10, time.Hour)
+	defer store.Delete("test", "app")
+
+	ti := &testIntegration{
+		name:  "test",
+		up:    make(chan *types.UplinkMessage, 10),
+		event: make(chan *types.DeviceEvent, 10),
+	}
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestIntegrationSpool")},
+		spool:     store,
+	}
+	h.WithIntegration(ti)
+	i := h.integrations[0]
+	err := h.startIntegration(i)
+	a.So(err, ShouldBeNil)
+
+	// Messages are spooled while the integration is unhealthy
+	i.handle(&spool.Entry{Uplink: &types.UplinkMessage{AppID: "app", DevID: "dev", PayloadRaw: []byte{1}}})
+	i.handle(&spool.Entry{Event: &types.DeviceEvent{AppID: "app", DevID: "dev", Event: types.ActivationEvent}})
+	a.So(ti.up, ShouldBeEmpty)
+	a.So(ti.event, ShouldBeEmpty)
+
+	length, err := store.Length("test", "app")
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 2)
+	a.So(h.integrationStatus(), ShouldResemble, []*pb_manager.IntegrationStatus{{Name: "test", Healthy: false, Spool: 2}})
+
+	// New messages are spooled as long as the spool is not empty
+	ti.healthy = true
+	i.handle(&spool.Entry{Uplink: &types.UplinkMessage{AppID: "app", DevID: "dev", PayloadRaw: []byte{2}}})
+	a.So(ti.up, ShouldBeEmpty)
+
+	// The spool is replayed in order
+	i.replay()
+	a.So(len(ti.up), ShouldEqual, 2)
+	a.So((<-ti.up).PayloadRaw, ShouldResemble, []byte{1})
+	a.So((<-ti.up).PayloadRaw, ShouldResemble, []byte{2})
+	a.So((<-ti.event).Event, ShouldEqual, types.ActivationEvent)
+
+	length, err = store.Length("test", "app")
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 0)
+	a.So(h.integrationStatus(), ShouldResemble, []*pb_manager.IntegrationStatus{{Name: "test", Healthy: true}})
+}
+
+type asyncTestIntegration struct {
+	testIntegration
+	failed  publishFailureHandler
+	entries chan *spool.Entry
+}
+
+func (i *asyncTestIntegration) onPublishFailure(handler publishFailureHandler) { i.failed = handler }
+
+func (i *asyncTestIntegration) publishEntry(entry *spool.Entry) error {
+	i.entries <- entry
+	return nil
+}
+
+func TestIntegrationPublishFailure(t *testing.T) {
+	a := New(t)
+
+	store := spool.NewRedisSpoolStore(GetRedisClient(), "handler-test-integration-publish-failure", 10, time.Hour)
+	defer store.Delete("test", "app")
+
+	ti := &asyncTestIntegration{
+		testIntegration: testIntegration{
+			name:    "test",
+			healthy: true,
+			up:      make(chan *types.UplinkMessage, 10),
+			event:   make(chan *types.DeviceEvent, 10),
+		},
+		entries: make(chan *spool.Entry, 10),
+	}
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestIntegrationPublishFailure")},
+		spool:     store,
+	}
+	h.WithIntegration(ti)
+	err := h.startIntegration(h.integrations[0])
+	a.So(err, ShouldBeNil)
+	a.So(ti.failed, ShouldNotBeNil)
+
+	i := h.integrations[0]
+
+	// Messages that turn out to be not published are spooled at their original position
+	for p := byte(1); p <= 3; p++ {
+		i.handle(&spool.Entry{Uplink: &types.UplinkMessage{AppID: "app", DevID: "dev", PayloadRaw: []byte{p}}})
+	}
+	first, second := <-ti.entries, <-ti.entries
+	<-ti.entries
+	ti.failed(second, errors.New("timeout"))
+	ti.failed(first, errors.New("timeout"))
+	<-time.After(10 * time.Millisecond)
+
+	length, err := store.Length("test", "app")
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 2)
+
+	next, err := store.Next("test", "app")
+	a.So(err, ShouldBeNil)
+	a.So(next.Uplink.PayloadRaw, ShouldResemble, []byte{1})
+	next, err = store.Next("test", "app")
+	a.So(err, ShouldBeNil)
+	a.So(next.Uplink.PayloadRaw, ShouldResemble, []byte{2})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/TheThingsNetwork/go-account-lib/rights"
 	"github.com/TheThingsNetwork/go-utils/grpc/ttnctx"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/api/ratelimit"
 	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/core/handler/device"
@@ -73,6 +74,18 @@ func (h *handlerManager) validateTTNAuthAppContext(ctx context.Context, appID st
 	return ctx, claims, nil
 }
 
+// validateAppRights validates the authorization in the context and checks if it grants the right for the application
+func (h *handlerManager) validateAppRights(ctx context.Context, appID string, right types.Right) (context.Context, *claims.Claims, error) {
+	ctx, claims, err := h.validateTTNAuthAppContext(ctx, appID)
+	if err != nil {
+		return ctx, nil, err
+	}
+	if err := checkAppRights(claims, appID, right); err != nil {
+		return ctx, nil, err
+	}
+	return ctx, claims, nil
+}
+
 func (h *handlerManager) GetDevice(ctx context.Context, in *pb_handler.DeviceIdentifier) (*pb_handler.Device, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Device Identifier")
@@ -144,23 +157,36 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb_handler.Device) (
 		return nil, err
 	}
 
+	if err := h.setDevice(ctx, token, in, nil); err != nil {
+		return nil, err
+	}
+
+	return &gogo.Empty{}, nil
+}
+
+// setDevice creates or updates the device, after the rights of the request have been checked. The AppEUI and
+// DevEUI of a new device are checked against euis (see deviceEUIs), or against the registry if euis is nil.
+// The device is added to euis when it is set.
+func (h *handlerManager) setDevice(ctx context.Context, token string, in *pb_handler.Device, euis map[string]string) error {
 	if _, err := h.handler.applications.Get(in.AppID); err != nil {
-		return nil, errors.Wrap(err, "Application not registered to this Handler")
+		return errors.Wrap(err, "Application not registered to this Handler")
 	}
 
 	dev, err := h.handler.devices.Get(in.AppID, in.DevID)
 	if err != nil && errors.GetErrType(err) != errors.NotFound {
-		return nil, err
+		return err
 	}
 
 	lorawan := in.GetLoRaWANDevice()
 	if lorawan == nil {
-		return nil, errors.NewErrInvalidArgument("Device", "No LoRaWAN Device")
+		return errors.NewErrInvalidArgument("Device", "No LoRaWAN Device")
 	}
 
 	var eventType types.EventType
+	var oldEUIs string
 	if dev != nil {
 		eventType = types.UpdateEvent
+		oldEUIs = euiKey(dev.AppEUI, dev.DevEUI)
 		if dev.AppEUI != *lorawan.AppEUI || dev.DevEUI != *lorawan.DevEUI {
 			// If the AppEUI or DevEUI is changed, we should remove the device from the NetworkServer and re-add it later
 			_, err = h.handler.ttnDeviceManager.DeleteDevice(ttnctx.OutgoingContextWithToken(ctx, token), &pb_lorawan.DeviceIdentifier{
@@ -168,21 +194,21 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb_handler.Device) (
 				DevEUI: &dev.DevEUI,
 			})
 			if err != nil {
-				return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
+				return errors.Wrap(errors.FromGRPCError(err), "Broker did not delete device")
 			}
 		}
 		dev.StartUpdate()
 	} else {
 		eventType = types.CreateEvent
-		existingDevices, err := h.handler.devices.ListForApp(in.AppID, nil)
-		if err != nil {
-			return nil, err
-		}
-		for _, existingDevice := range existingDevices {
-			if existingDevice.AppEUI == *lorawan.AppEUI && existingDevice.DevEUI == *lorawan.DevEUI {
-				return nil, errors.NewErrAlreadyExists("Device with AppEUI and DevEUI")
+		if euis == nil {
+			euis, err = h.deviceEUIs(in.AppID)
+			if err != nil {
+				return err
 			}
 		}
+		if _, ok := euis[euiKey(*lorawan.AppEUI, *lorawan.DevEUI)]; ok {
+			return errors.NewErrAlreadyExists("Device with AppEUI and DevEUI")
+		}
 		dev = new(device.Device)
 	}
 
@@ -205,12 +231,19 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb_handler.Device) (
 
 	_, err = h.handler.ttnDeviceManager.SetDevice(ttnctx.OutgoingContextWithToken(ctx, token), lorawanPb)
 	if err != nil {
-		return nil, errors.Wrap(errors.FromGRPCError(err), "Broker did not set device")
+		return errors.Wrap(errors.FromGRPCError(err), "Broker did not set device")
 	}
 
 	err = h.handler.devices.Set(dev)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	if euis != nil {
+		if oldEUIs != "" {
+			delete(euis, oldEUIs)
+		}
+		euis[euiKey(dev.AppEUI, dev.DevEUI)] = dev.DevID
 	}
 
 	h.handler.qEvent <- &types.DeviceEvent{
@@ -220,7 +253,24 @@ func (h *handlerManager) SetDevice(ctx context.Context, in *pb_handler.Device) (
 		Data:  nil, // Don't send potentially sensitive details over MQTT
 	}
 
-	return &gogo.Empty{}, nil
+	return nil
+}
+
+// deviceEUIs returns the DevIDs of the devices of the application, indexed by their AppEUI and DevEUI
+func (h *handlerManager) deviceEUIs(appID string) (map[string]string, error) {
+	devices, err := h.handler.devices.ListForApp(appID, nil)
+	if err != nil {
+		return nil, err
+	}
+	euis := make(map[string]string, len(devices))
+	for _, dev := range devices {
+		euis[euiKey(dev.AppEUI, dev.DevEUI)] = dev.DevID
+	}
+	return euis, nil
+}
+
+func euiKey(appEUI types.AppEUI, devEUI types.DevEUI) string {
+	return appEUI.String() + ":" + devEUI.String()
 }
 
 func (h *handlerManager) DeleteDevice(ctx context.Context, in *pb_handler.DeviceIdentifier) (*gogo.Empty, error) {
@@ -253,6 +303,7 @@ func (h *handlerManager) DeleteDevice(ctx context.Context, in *pb_handler.Device
 	if err != nil {
 		return nil, err
 	}
+	h.handler.deleteDeviceData(in.AppID, in.DevID)
 	h.handler.qEvent <- &types.DeviceEvent{
 		AppID: in.AppID,
 		DevID: in.DevID,
@@ -406,8 +457,14 @@ func (h *handlerManager) SetApplication(ctx context.Context, in *pb_handler.Appl
 		return nil, err
 	}
 
+	previous := app.PayloadFunctions()
 	app.StartUpdate()
 
+	// The binary format is set with SetBinaryFormat
+	if application.PayloadFormat(in.PayloadFormat) == application.PayloadFormatBinary && app.BinaryFormat == nil {
+		return nil, errors.NewErrInvalidArgument("Payload Format", "application does not have a binary format")
+	}
+
 	app.PayloadFormat = application.PayloadFormat(in.PayloadFormat)
 	app.CustomDecoder = in.Decoder
 	app.CustomConverter = in.Converter
@@ -426,10 +483,66 @@ func (h *handlerManager) SetApplication(ctx context.Context, in *pb_handler.Appl
 	if err != nil {
 		return nil, err
 	}
+	h.handler.invalidateFunctions(app.AppID)
+
+	if functions := app.PayloadFunctions(); !functions.Equal(previous) {
+		err = h.handler.addRevision(app.AppID, previous, &application.Revision{
+			Author:    revisionAuthor(claims),
+			Functions: functions,
+		})
+		if err != nil {
+			h.handler.Ctx.WithField("AppID", app.AppID).WithError(err).Warn("Could not record revision of payload functions")
+		}
+	}
 
 	return &gogo.Empty{}, nil
 }
 
+// deleteApplicationData deletes what the Handler keeps for a deleted application besides the application and its
+// devices: the revisions of the payload functions, the spools of the integrations, the group downlink reports and the
+// quota usage. Errors are logged, as the application is already deleted.
+func (h *handler) deleteApplicationData(appID string) {
+	ctx := h.Ctx.WithField("AppID", appID)
+	if h.functionRevisions != nil {
+		if err := h.functionRevisions.Delete(appID); err != nil {
+			ctx.WithError(err).Warn("Could not delete revisions of payload functions")
+		}
+	}
+	if h.spool != nil {
+		for _, i := range h.integrations {
+			if err := h.spool.Delete(i.Name(), appID); err != nil {
+				ctx.WithField("Integration", i.Name()).WithError(err).Warn("Could not delete spool")
+			}
+		}
+	}
+	if h.groupReports != nil {
+		if err := h.groupReports.Delete(appID); err != nil {
+			ctx.WithError(err).Warn("Could not delete group downlink reports")
+		}
+	}
+	if h.quotas != nil {
+		if err := h.quotas.delete(appID); err != nil {
+			ctx.WithError(err).Warn("Could not delete quota usage")
+		}
+	}
+}
+
+// deleteDeviceData deletes what the Handler keeps for a deleted device besides the device itself: the history of
+// its uplink and its buffered fragments. Errors are logged, as the device is already deleted.
+func (h *handler) deleteDeviceData(appID, devID string) {
+	ctx := h.Ctx.WithFields(ttnlog.Fields{"AppID": appID, "DevID": devID})
+	if h.history != nil {
+		if err := h.history.Delete(appID, devID); err != nil {
+			ctx.WithError(err).Warn("Could not delete history")
+		}
+	}
+	if h.fragments != nil {
+		if err := h.fragments.Delete(appID, devID); err != nil {
+			ctx.WithError(err).Warn("Could not delete fragments")
+		}
+	}
+}
+
 func (h *handlerManager) DeleteApplication(ctx context.Context, in *pb_handler.ApplicationIdentifier) (*gogo.Empty, error) {
 	if err := in.Validate(); err != nil {
 		return nil, errors.Wrap(err, "Invalid Application Identifier")
@@ -463,6 +576,7 @@ func (h *handlerManager) DeleteApplication(ctx context.Context, in *pb_handler.A
 		if err != nil {
 			return nil, err
 		}
+		h.handler.deleteDeviceData(dev.AppID, dev.DevID)
 	}
 
 	// Delete the Application
@@ -470,6 +584,8 @@ func (h *handlerManager) DeleteApplication(ctx context.Context, in *pb_handler.A
 	if err != nil {
 		return nil, err
 	}
+	h.handler.invalidateFunctions(in.AppID)
+	h.handler.deleteApplicationData(in.AppID)
 
 	err = h.handler.Discovery.RemoveAppID(in.AppID, token)
 	if err != nil {
@@ -495,15 +611,24 @@ func (h *handlerManager) GetDevAddr(ctx context.Context, in *pb_lorawan.DevAddrR
 	return res, nil
 }
 
+// validateComponentAccess validates that the claims in the context grant access to the Handler itself
+func (h *handlerManager) validateComponentAccess(ctx context.Context) error {
+	if h.handler.Identity.ID == "dev" {
+		return nil
+	}
+	claims, err := h.handler.ValidateTTNAuthContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "No access")
+	}
+	if !claims.ComponentAccess(h.handler.Identity.ID) {
+		return errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", h.handler.Identity.ID))
+	}
+	return nil
+}
+
 func (h *handlerManager) GetStatus(ctx context.Context, in *pb_handler.StatusRequest) (*pb_handler.Status, error) {
-	if h.handler.Identity.ID != "dev" {
-		claims, err := h.handler.ValidateTTNAuthContext(ctx)
-		if err != nil {
-			return nil, errors.Wrap(err, "No access")
-		}
-		if !claims.ComponentAccess(h.handler.Identity.ID) {
-			return nil, errors.NewErrPermissionDenied(fmt.Sprintf("Claims do not grant access to %s", h.handler.Identity.ID))
-		}
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
 	}
 	status := h.handler.GetStatus()
 	if status == nil {
@@ -512,6 +637,16 @@ func (h *handlerManager) GetStatus(ctx context.Context, in *pb_handler.StatusReq
 	return status, nil
 }
 
+func (h *handlerManager) GetHandlerStatus(ctx context.Context, in *pb_manager.HandlerStatusRequest) (*pb_manager.HandlerStatus, error) {
+	if err := h.validateComponentAccess(ctx); err != nil {
+		return nil, err
+	}
+	return &pb_manager.HandlerStatus{
+		Integrations:     h.handler.integrationStatus(),
+		PayloadFunctions: h.handler.functionsStatus(),
+	}, nil
+}
+
 func (h *handler) RegisterManager(s *grpc.Server) {
 	server := &handlerManager{
 		handler:        h,
@@ -523,5 +658,6 @@ func (h *handler) RegisterManager(s *grpc.Server) {
 
 	pb_handler.RegisterHandlerManagerServer(s, server)
 	pb_handler.RegisterApplicationManagerServer(s, server)
+	pb_manager.RegisterApplicationManagerServer(s, server)
 	pb_lorawan.RegisterDevAddrManagerServer(s, server)
 }
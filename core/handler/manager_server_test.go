@@ -0,0 +1,83 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/fragment"
+	"github.com/TheThingsNetwork/ttn/core/handler/group"
+	"github.com/TheThingsNetwork/ttn/core/handler/history"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDeleteApplicationData(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	prefix := "handler-test-delete-application-data"
+	h := &handler{
+		Component:         &component.Component{Ctx: GetLogger(t, "TestDeleteApplicationData")},
+		functionRevisions: application.NewRedisRevisionStore(GetRedisClient(), prefix),
+		spool:             spool.NewRedisSpoolStore(GetRedisClient(), prefix, 10, time.Hour),
+		groupReports:      group.NewRedisReportStore(GetRedisClient(), prefix),
+		quotas:            newRedisQuotas(GetRedisClient(), prefix),
+	}
+	h.WithIntegration(&testIntegration{name: "test"})
+
+	uplink := &types.UplinkMessage{AppID: appID, DevID: "dev1", PayloadRaw: []byte{1}}
+	a.So(h.functionRevisions.Add(appID, &application.Revision{CreatedAt: time.Now()}), ShouldBeNil)
+	a.So(h.spool.Push("test", &spool.Entry{Uplink: uplink}), ShouldBeNil)
+	a.So(h.groupReports.Create(&group.Report{ID: "report", AppID: appID, GroupID: "group", CreatedAt: time.Now()}), ShouldBeNil)
+	a.So(h.quotas.uplinks.Add(1, appID, appID+":dev1"), ShouldBeNil)
+
+	h.deleteApplicationData(appID)
+
+	revisions, err := h.functionRevisions.List(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldBeEmpty)
+
+	length, err := h.spool.Length("test", appID)
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 0)
+
+	_, err = h.groupReports.Get(appID, "report")
+	a.So(err, ShouldNotBeNil)
+
+	usage, err := h.quotas.uplinks.Usage(appID + ":dev1")
+	a.So(err, ShouldBeNil)
+	a.So(usage, ShouldEqual, 0)
+}
+
+func TestDeleteDeviceData(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	prefix := "handler-test-delete-device-data"
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestDeleteDeviceData")},
+		history:   history.NewRedisHistoryStore(GetRedisClient(), prefix, 0, 0),
+		fragments: fragment.NewRedisFragmentStore(GetRedisClient(), prefix),
+	}
+
+	uplink := &types.UplinkMessage{AppID: appID, DevID: devID, PayloadRaw: []byte{1}}
+	a.So(h.history.Push(appID, devID, &history.Entry{Time: time.Now(), Uplink: uplink}), ShouldBeNil)
+	_, _, err := h.fragments.Add(appID, devID, &fragment.Fragment{Total: 2, Payload: []byte{1}}, time.Minute)
+	a.So(err, ShouldBeNil)
+
+	h.deleteDeviceData(appID, devID)
+
+	entries, err := h.history.Query(appID, devID, history.Query{})
+	a.So(err, ShouldBeNil)
+	a.So(entries, ShouldBeEmpty)
+
+	expired, err := h.fragments.Expired(time.Now().Add(24 * time.Hour))
+	a.So(err, ShouldBeNil)
+	a.So(expired, ShouldBeEmpty)
+}
@@ -7,97 +7,123 @@ import (
 	"time"
 
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/mqtt"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 )
 
 // MQTTTimeout indicates how long we should wait for an MQTT publish
 var MQTTTimeout = 2 * time.Second
 
-// MQTTBufferSize indicates the size for uplink channel buffers
-var MQTTBufferSize = 10
-
-func (h *handler) HandleMQTT(username, password string, mqttBrokers ...string) error {
-	h.mqttClient = mqtt.NewClient(h.Ctx, "ttnhdl", username, password, mqttBrokers...)
+type mqttIntegration struct {
+	username string
+	password string
+	brokers  []string
+	ctx      ttnlog.Interface
+	client   mqtt.Client
+	failed   publishFailureHandler
+}
 
-	err := h.mqttClient.Connect()
-	if err != nil {
-		return err
+// NewMQTTIntegration returns a new Integration that publishes to the given MQTT brokers
+func NewMQTTIntegration(username, password string, brokers ...string) Integration {
+	return &mqttIntegration{
+		username: username,
+		password: password,
+		brokers:  brokers,
 	}
+}
 
-	h.mqttUp = make(chan *types.UplinkMessage, MQTTBufferSize)
-	h.mqttEvent = make(chan *types.DeviceEvent, MQTTBufferSize)
+func (i *mqttIntegration) Name() string {
+	return "MQTT"
+}
 
-	token := h.mqttClient.SubscribeDownlink(func(client mqtt.Client, appID string, devID string, msg types.DownlinkMessage) {
+func (i *mqttIntegration) Connect(ctx ttnlog.Interface) error {
+	i.ctx = ctx
+	i.client = mqtt.NewClient(ctx, "ttnhdl", i.username, i.password, i.brokers...)
+	return i.client.Connect()
+}
+
+func (i *mqttIntegration) Disconnect() {
+	i.client.Disconnect()
+}
+
+func (i *mqttIntegration) Healthy() bool {
+	return i.client.IsConnected()
+}
+
+func (i *mqttIntegration) SubscribeDownlink(handler DownlinkHandler) error {
+	token := i.client.SubscribeDownlink(func(client mqtt.Client, appID string, devID string, msg types.DownlinkMessage) {
 		down := &msg
 		down.DevID = devID
 		down.AppID = appID
-		go h.EnqueueDownlink(down)
+		go handler(down)
 	})
 	token.Wait()
-	if token.Error() != nil {
-		return err
-	}
+	return token.Error()
+}
 
-	ctx := h.Ctx.WithField("Protocol", "MQTT")
+func (i *mqttIntegration) SubscribeGroupDownlink(handler GroupDownlinkHandler) error {
+	token := i.client.SubscribeGroupDownlink("", "", func(client mqtt.Client, appID string, groupID string, msg types.DownlinkMessage) {
+		down := &msg
+		down.AppID = appID
+		go handler(appID, groupID, down)
+	})
+	token.Wait()
+	return token.Error()
+}
 
-	go func() {
-		for up := range h.mqttUp {
-			ctx := ctx.WithFields(ttnlog.Fields{
-				"DevID": up.DevID,
-				"AppID": up.AppID,
-			})
-			ctx.Debug("Publish Uplink")
-			upToken := h.mqttClient.PublishUplink(*up)
-			go func(ctx ttnlog.Interface) {
-				if upToken.WaitTimeout(MQTTTimeout) {
-					if upToken.Error() != nil {
-						ctx.WithError(upToken.Error()).Warn("Could not publish Uplink")
-					}
-				} else {
-					ctx.Warn("Uplink publish timeout")
-				}
-			}(ctx)
-			if len(up.PayloadFields) > 0 {
-				fieldsToken := h.mqttClient.PublishUplinkFields(up.AppID, up.DevID, up.PayloadFields)
-				go func(ctx ttnlog.Interface) {
-					if fieldsToken.WaitTimeout(MQTTTimeout) {
-						if fieldsToken.Error() != nil {
-							ctx.WithError(fieldsToken.Error()).Warn("Could not publish Uplink Fields")
-						}
-					} else {
-						ctx.Warn("Uplink Fields publish timeout")
-					}
-				}(ctx)
-			}
+func (i *mqttIntegration) onPublishFailure(handler publishFailureHandler) {
+	i.failed = handler
+}
+
+// PublishUplink publishes the uplink without waiting for the broker, so that a slow broker does not block the
+// next messages. If the broker does not confirm the uplink, it is passed to the publish failure handler.
+func (i *mqttIntegration) PublishUplink(up *types.UplinkMessage) error {
+	return i.publishEntry(&spool.Entry{Uplink: up})
+}
+
+// PublishEvent publishes the event without waiting for the broker, like PublishUplink
+func (i *mqttIntegration) PublishEvent(event *types.DeviceEvent) error {
+	return i.publishEntry(&spool.Entry{Event: event})
+}
+
+func (i *mqttIntegration) publishEntry(entry *spool.Entry) error {
+	if up := entry.Uplink; up != nil {
+		i.confirm(i.client.PublishUplink(*up), entry)
+		if len(up.PayloadFields) > 0 {
+			i.confirm(i.client.PublishUplinkFields(up.AppID, up.DevID, up.PayloadFields), nil)
 		}
-	}()
+		return nil
+	}
+	event := entry.Event
+	if event.DevID == "" {
+		i.confirm(i.client.PublishAppEvent(event.AppID, event.Event, event.Data), entry)
+	} else {
+		i.confirm(i.client.PublishDeviceEvent(event.AppID, event.DevID, event.Event, event.Data), entry)
+	}
+	return nil
+}
 
+// confirm waits for the token in a goroutine. If the publish fails, the entry is passed to the publish failure
+// handler; without entry or handler, the failure is only logged.
+func (i *mqttIntegration) confirm(token mqtt.Token, entry *spool.Entry) {
 	go func() {
-		for event := range h.mqttEvent {
-			ctx := ctx.WithFields(ttnlog.Fields{
-				"DevID": event.DevID,
-				"AppID": event.AppID,
-				"Event": event.Event,
-			})
-			ctx.Debug("Publish Event")
-			var token mqtt.Token
-			if event.DevID == "" {
-				token = h.mqttClient.PublishAppEvent(event.AppID, event.Event, event.Data)
-			} else {
-				token = h.mqttClient.PublishDeviceEvent(event.AppID, event.DevID, event.Event, event.Data)
-			}
-			go func() {
-				if token.WaitTimeout(MQTTTimeout) {
-					if token.Error() != nil {
-						ctx.WithError(token.Error()).Warn("Could not publish Event")
-					}
-				} else {
-					ctx.Warn("Event publish timeout")
-				}
-			}()
+		err := waitMQTTToken(token)
+		if err == nil {
+			return
 		}
+		if entry != nil && i.failed != nil {
+			i.failed(entry, err)
+			return
+		}
+		i.ctx.WithError(err).Warn("Could not publish to MQTT")
 	}()
+}
 
-	return nil
+func waitMQTTToken(token mqtt.Token) error {
+	if !token.WaitTimeout(MQTTTimeout) {
+		return errors.New("MQTT publish timeout")
+	}
+	return token.Error()
 }
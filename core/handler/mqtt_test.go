@@ -41,8 +41,10 @@ func TestHandleMQTT(t *testing.T) {
 	defer func() {
 		h.devices.Delete(appID, devID)
 	}()
-	err = h.HandleMQTT("", "", fmt.Sprintf("tcp://%s", host))
+	i := &integration{Integration: NewMQTTIntegration("", "", fmt.Sprintf("tcp://%s", host))}
+	err = h.startIntegration(i)
 	a.So(err, ShouldBeNil)
+	defer i.Disconnect()
 
 	c.PublishDownlink(types.DownlinkMessage{
 		AppID:      appID,
@@ -62,7 +64,7 @@ func TestHandleMQTT(t *testing.T) {
 		wg.Done()
 	}).Wait()
 
-	h.mqttUp <- &types.UplinkMessage{
+	i.up <- &types.UplinkMessage{
 		DevID:      devID,
 		AppID:      appID,
 		PayloadRaw: []byte{0xAA, 0xBC},
@@ -78,7 +80,7 @@ func TestHandleMQTT(t *testing.T) {
 		wg.Done()
 	}).Wait()
 
-	h.mqttEvent <- &types.DeviceEvent{
+	i.event <- &types.DeviceEvent{
 		DevID: devID,
 		AppID: appID,
 		Event: types.ActivationEvent,
@@ -0,0 +1,131 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// updateFunctions updates the payload functions of the application, and records a revision if they changed
+func (h *handler) updateFunctions(appID string, author string, update func(app *application.Application) error) error {
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return err
+	}
+	previous := app.PayloadFunctions()
+	app.StartUpdate()
+	if err := update(app); err != nil {
+		return err
+	}
+	if err := h.applications.Set(app); err != nil {
+		return err
+	}
+	h.invalidateFunctions(appID)
+
+	if current := app.PayloadFunctions(); !current.Equal(previous) {
+		err = h.addRevision(appID, previous, &application.Revision{
+			Author:    author,
+			Functions: current,
+		})
+		if err != nil {
+			h.Ctx.WithField("AppID", appID).WithError(err).Warn("Could not record revision of payload functions")
+		}
+	}
+	return nil
+}
+
+// setPortFunctions sets the payload functions of the application for the FPort, and records a revision. Empty
+// functions remove the functions for the FPort, so that the default functions of the application are used.
+func (h *handler) setPortFunctions(appID string, port uint8, functions application.PortFunctions, author string) error {
+	return h.updateFunctions(appID, author, func(app *application.Application) error {
+		app.SetPortFunctions(port, functions)
+		if !functions.IsEmpty() && app.PayloadFormat == "" {
+			app.PayloadFormat = application.PayloadFormatCustom
+		}
+		return nil
+	})
+}
+
+// portFunctionsFromPb converts the port functions proto
+func portFunctionsFromPb(pb *pb_manager.PortFunctions) application.PortFunctions {
+	return application.PortFunctions{
+		Decoder:   pb.Decoder,
+		Converter: pb.Converter,
+		Validator: pb.Validator,
+		Encoder:   pb.Encoder,
+	}
+}
+
+func (h *handlerManager) ListPortFunctions(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.PortFunctionsList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.PortFunctionsList{}
+	if len(app.PortFunctions) > 0 {
+		res.Ports = make(map[uint32]*pb_manager.PortFunctions, len(app.PortFunctions))
+		for port, functions := range app.PortFunctions {
+			res.Ports[uint32(port)] = portFunctionsToPb(functions)
+		}
+	}
+	return res, nil
+}
+
+func (h *handlerManager) GetPortFunctions(ctx context.Context, in *pb_manager.PortFunctionsIdentifier) (*pb_manager.PortFunctions, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Port Functions Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	if !app.HasPortFunctions(uint8(in.FPort)) {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Functions for FPort %d", in.FPort))
+	}
+	return portFunctionsToPb(app.PortFunctions[uint8(in.FPort)]), nil
+}
+
+func (h *handlerManager) SetPortFunctions(ctx context.Context, in *pb_manager.SetPortFunctionsRequest) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Set Port Functions Request")
+	}
+	_, claims, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.handler.setPortFunctions(in.AppID, uint8(in.FPort), portFunctionsFromPb(in.Functions), revisionAuthor(claims)); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) DeletePortFunctions(ctx context.Context, in *pb_manager.PortFunctionsIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Port Functions Identifier")
+	}
+	_, claims, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.handler.setPortFunctions(in.AppID, uint8(in.FPort), application.PortFunctions{}, revisionAuthor(claims)); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
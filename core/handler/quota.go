@@ -0,0 +1,334 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/api/trace"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/api/ratelimit"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/toa"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/redis.v5"
+)
+
+// Names of the quotas, as used in quota/exceeded events
+const (
+	quotaUplinksPerHour        = "uplinks_per_hour"
+	quotaDownlinksPerDay       = "downlinks_per_day"
+	quotaDownlinkAirtimePerDay = "downlink_airtime_per_day"
+)
+
+// errQuotaExceeded indicates that the uplink message exceeds the quota, so that it is not published
+var errQuotaExceeded = errors.New("Uplink quota exceeded")
+
+// quotas keeps the traffic usage of applications and devices that have quotas. The usage is kept in Redis, so it is
+// shared by all handler instances and kept when a handler restarts.
+type quotas struct {
+	uplinks         *ratelimit.Quota
+	downlinks       *ratelimit.Quota
+	downlinkAirtime *ratelimit.Quota
+}
+
+func newRedisQuotas(client *redis.Client, prefix string) *quotas {
+	prefix += ":quota"
+	return &quotas{
+		uplinks:         ratelimit.NewQuota(client, prefix+":"+quotaUplinksPerHour, time.Hour),
+		downlinks:       ratelimit.NewQuota(client, prefix+":"+quotaDownlinksPerDay, 24*time.Hour),
+		downlinkAirtime: ratelimit.NewQuota(client, prefix+":"+quotaDownlinkAirtimePerDay, 24*time.Hour),
+	}
+}
+
+// delete deletes the usage of the application and its devices
+func (q *quotas) delete(appID string) error {
+	for _, quota := range []*ratelimit.Quota{q.uplinks, q.downlinks, q.downlinkAirtime} {
+		if err := quota.Delete(appID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// quotaLimits returns the application and the device with their limits for a quota
+func quotaLimits(appID, devID string, q *application.Quotas, limit func(application.QuotaLimits) int64) []ratelimit.QuotaLimit {
+	return []ratelimit.QuotaLimit{
+		{ID: appID, Limit: limit(q.Application)},
+		{ID: appID + ":" + devID, Limit: limit(q.Device)},
+	}
+}
+
+// getQuotas returns the quotas of the application, or nil if it has none or quotas are not kept
+func (h *handler) getQuotas(appID string) *application.Quotas {
+	if h.quotas == nil {
+		return nil
+	}
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil
+	}
+	return app.Quotas
+}
+
+// takeQuota adds n to the usage of the application and device, or returns an error if that would exceed the limit
+// of one of them. The first time that happens in a period, a quota/exceeded event is published for the application
+// or device.
+func (h *handler) takeQuota(quota *ratelimit.Quota, name string, n int64, appID, devID string, limits []ratelimit.QuotaLimit) error {
+	exceeded, err := quota.Take(n, limits...)
+	if err != nil {
+		return err
+	}
+	if exceeded == nil {
+		return nil
+	}
+	if marked, _ := quota.MarkExceeded(exceeded.ID); marked {
+		usage, _ := quota.Usage(exceeded.ID)
+		event := &types.DeviceEvent{
+			AppID: appID,
+			Event: types.QuotaExceededEvent,
+			Data: types.QuotaExceededEventData{
+				Quota: name,
+				Limit: exceeded.Limit,
+				Usage: usage,
+			},
+		}
+		if exceeded.ID != appID {
+			event.DevID = devID
+		}
+		h.qEvent <- event
+	}
+	return grpc.Errorf(codes.ResourceExhausted, "Quota %s exceeded", name)
+}
+
+// takeUplinkQuota counts an uplink message of the device, or returns an error if that exceeds the quota
+func (h *handler) takeUplinkQuota(appID, devID string) error {
+	q := h.getQuotas(appID)
+	if q == nil {
+		return nil
+	}
+	limits := quotaLimits(appID, devID, q, func(l application.QuotaLimits) int64 { return l.UplinksPerHour })
+	return h.takeQuota(h.quotas.uplinks, quotaUplinksPerHour, 1, appID, devID, limits)
+}
+
+// CheckUplinkQuota is an UplinkProcessor that stops processing the uplink message if it exceeds the quota. It runs
+// after ConvertFromLoRaWAN, so the frame counter and acknowledgements of the device are still updated.
+func (h *handler) CheckUplinkQuota(ctx ttnlog.Interface, uplink *pb_broker.DeduplicatedUplinkMessage, appUp *types.UplinkMessage, dev *device.Device) error {
+	if err := h.takeUplinkQuota(dev.AppID, dev.DevID); err != nil {
+		ctx.WithError(err).Debug("Drop uplink")
+		uplink.Trace = uplink.Trace.WithEvent(trace.DropEvent, "reason", err)
+		return errQuotaExceeded
+	}
+	return nil
+}
+
+// DownlinkAirtimeEstimate is the data rate and coding rate that are used to estimate the airtime of a downlink
+// message when it is enqueued, before it is known how it is sent
+var DownlinkAirtimeEstimate = struct {
+	DataRate   string
+	CodingRate string
+}{"SF9BW125", "4/5"}
+
+// lorawanOverhead is the number of bytes that LoRaWAN adds to the payload of a downlink message: MHDR, FHDR
+// without FOpts, FPort and MIC
+const lorawanOverhead = 1 + 7 + 1 + 4
+
+// estimateDownlinkAirtime estimates the airtime of the downlink message. If the message has payload fields that
+// still need to be encoded, their JSON size is used as the size of the payload, which is larger than most encodings.
+func estimateDownlinkAirtime(msg *types.DownlinkMessage) (time.Duration, error) {
+	size := len(msg.PayloadRaw)
+	if size == 0 && len(msg.PayloadFields) > 0 {
+		fields, err := json.Marshal(msg.PayloadFields)
+		if err != nil {
+			return 0, err
+		}
+		size = len(fields)
+	}
+	return toa.ComputeLoRa(uint(size+lorawanOverhead), DownlinkAirtimeEstimate.DataRate, DownlinkAirtimeEstimate.CodingRate)
+}
+
+// takeDownlinkQuota counts an enqueued downlink message of the device and its estimated airtime, or returns an
+// error if that exceeds the quota
+func (h *handler) takeDownlinkQuota(appID, devID string, msg *types.DownlinkMessage) error {
+	q := h.getQuotas(appID)
+	if q == nil {
+		return nil
+	}
+	airtime, err := estimateDownlinkAirtime(msg)
+	if err != nil {
+		return err
+	}
+	airtimeLimits := quotaLimits(appID, devID, q, func(l application.QuotaLimits) int64 { return l.DownlinkAirtimePerDay })
+	if err := h.takeQuota(h.quotas.downlinkAirtime, quotaDownlinkAirtimePerDay, int64(airtime/time.Millisecond), appID, devID, airtimeLimits); err != nil {
+		return err
+	}
+	limits := quotaLimits(appID, devID, q, func(l application.QuotaLimits) int64 { return l.DownlinksPerDay })
+	if err := h.takeQuota(h.quotas.downlinks, quotaDownlinksPerDay, 1, appID, devID, limits); err != nil {
+		h.quotas.downlinkAirtime.Add(-int64(airtime/time.Millisecond), appID, appID+":"+devID)
+		return err
+	}
+	return nil
+}
+
+// addDownlinkAirtime counts the airtime of a downlink message that was sent to the device, but that was not
+// counted when it was enqueued
+func (h *handler) addDownlinkAirtime(appID, devID string, downlink *pb_broker.DownlinkMessage) {
+	q := h.getQuotas(appID)
+	if q == nil {
+		return
+	}
+	airtime, err := downlinkAirtime(downlink)
+	if err != nil || airtime == 0 {
+		return
+	}
+	h.quotas.downlinkAirtime.Add(int64(airtime/time.Millisecond), appID, appID+":"+devID)
+}
+
+// downlinkAirtime returns the time on air of the downlink message
+func downlinkAirtime(downlink *pb_broker.DownlinkMessage) (time.Duration, error) {
+	if downlink.DownlinkOption == nil || downlink.DownlinkOption.ProtocolConfiguration == nil {
+		return 0, nil
+	}
+	config := downlink.DownlinkOption.ProtocolConfiguration.GetLoRaWAN()
+	if config == nil {
+		return 0, nil
+	}
+	switch config.Modulation {
+	case pb_lorawan.Modulation_LORA:
+		return toa.ComputeLoRa(uint(len(downlink.Payload)), config.DataRate, config.CodingRate)
+	case pb_lorawan.Modulation_FSK:
+		return toa.ComputeFSK(uint(len(downlink.Payload)), int(config.BitRate))
+	}
+	return 0, nil
+}
+
+// quotaUsage returns the current usage of the application
+func (h *handler) quotaUsage(appID string) (map[string]int64, error) {
+	if h.quotas == nil {
+		return nil, nil
+	}
+	usage := make(map[string]int64, 3)
+	for name, quota := range map[string]*ratelimit.Quota{
+		quotaUplinksPerHour:        h.quotas.uplinks,
+		quotaDownlinksPerDay:       h.quotas.downlinks,
+		quotaDownlinkAirtimePerDay: h.quotas.downlinkAirtime,
+	} {
+		used, err := quota.Usage(appID)
+		if err != nil {
+			return nil, err
+		}
+		usage[name] = used
+	}
+	return usage, nil
+}
+
+func quotaLimitsToPb(limits application.QuotaLimits) *pb_manager.QuotaLimits {
+	return &pb_manager.QuotaLimits{
+		UplinksPerHour:        limits.UplinksPerHour,
+		DownlinksPerDay:       limits.DownlinksPerDay,
+		DownlinkAirtimePerDay: limits.DownlinkAirtimePerDay,
+	}
+}
+
+func quotaLimitsFromPb(limits *pb_manager.QuotaLimits) application.QuotaLimits {
+	if limits == nil {
+		return application.QuotaLimits{}
+	}
+	return application.QuotaLimits{
+		UplinksPerHour:        limits.UplinksPerHour,
+		DownlinksPerDay:       limits.DownlinksPerDay,
+		DownlinkAirtimePerDay: limits.DownlinkAirtimePerDay,
+	}
+}
+
+// quotasToPb converts the quotas of the application and its usage to the API type
+func quotasToPb(appID string, quotas *application.Quotas, usage map[string]int64) *pb_manager.Quotas {
+	if quotas == nil {
+		quotas = new(application.Quotas)
+	}
+	return &pb_manager.Quotas{
+		AppID:       appID,
+		Application: quotaLimitsToPb(quotas.Application),
+		Device:      quotaLimitsToPb(quotas.Device),
+		Usage: &pb_manager.QuotaUsage{
+			UplinksPerHour:        usage[quotaUplinksPerHour],
+			DownlinksPerDay:       usage[quotaDownlinksPerDay],
+			DownlinkAirtimePerDay: usage[quotaDownlinkAirtimePerDay],
+		},
+	}
+}
+
+func (h *handlerManager) GetQuotas(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.Quotas, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := h.handler.quotaUsage(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	return quotasToPb(in.AppID, app.Quotas, usage), nil
+}
+
+func (h *handlerManager) SetQuotas(ctx context.Context, in *pb_manager.Quotas) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	quotas := &application.Quotas{
+		Application: quotaLimitsFromPb(in.Application),
+		Device:      quotaLimitsFromPb(in.Device),
+	}
+	if err := quotas.Validate(); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	app.Quotas = quotas
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, errors.Wrap(err, "Could not update quotas")
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteQuotas(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	app.Quotas = nil
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, errors.Wrap(err, "Could not update quotas")
+	}
+	return &gogo.Empty{}, nil
+}
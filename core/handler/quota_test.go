@@ -0,0 +1,147 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestQuotas(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	devID := "dev1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestQuotas")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-quotas"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-quotas"),
+		quotas:       newRedisQuotas(GetRedisClient(), "handler-test-quotas"),
+		qEvent:       make(chan *types.DeviceEvent, 10),
+	}
+	defer h.quotas.delete(appID)
+	usage := func(quota string) int64 {
+		usage, err := h.quotaUsage(appID)
+		a.So(err, ShouldBeNil)
+		return usage[quota]
+	}
+
+	// No quotas
+	a.So(h.takeUplinkQuota(appID, devID), ShouldBeNil)
+	a.So(usage(quotaUplinksPerHour), ShouldEqual, 0)
+
+	h.applications.Set(&application.Application{
+		AppID: appID,
+		Quotas: &application.Quotas{
+			Application: application.QuotaLimits{UplinksPerHour: 3, DownlinkAirtimePerDay: 500},
+			Device:      application.QuotaLimits{UplinksPerHour: 2, DownlinksPerDay: 1},
+		},
+	})
+	defer h.applications.Delete(appID)
+
+	a.So(h.takeUplinkQuota(appID, devID), ShouldBeNil)
+	a.So(h.takeUplinkQuota(appID, devID), ShouldBeNil)
+	a.So(h.takeUplinkQuota(appID, devID), ShouldNotBeNil)
+	a.So(h.takeUplinkQuota(appID, devID), ShouldNotBeNil)
+	a.So(h.takeUplinkQuota(appID, "dev2"), ShouldBeNil)
+	a.So(h.takeUplinkQuota(appID, "dev3"), ShouldNotBeNil)
+	a.So(usage(quotaUplinksPerHour), ShouldEqual, 3)
+
+	// Events are only published once per period
+	a.So(h.qEvent, ShouldHaveLength, 2)
+	event := <-h.qEvent
+	a.So(event.DevID, ShouldEqual, devID)
+	a.So(event.Event, ShouldEqual, types.QuotaExceededEvent)
+	a.So(event.Data, ShouldResemble, types.QuotaExceededEventData{Quota: quotaUplinksPerHour, Limit: 2, Usage: 2})
+	event = <-h.qEvent
+	a.So(event.DevID, ShouldBeEmpty)
+	a.So(event.Data, ShouldResemble, types.QuotaExceededEventData{Quota: quotaUplinksPerHour, Limit: 3, Usage: 3})
+
+	msg := &types.DownlinkMessage{PayloadRaw: []byte{1, 2, 3}}
+	estimate, err := estimateDownlinkAirtime(msg)
+	a.So(err, ShouldBeNil)
+	a.So(estimate, ShouldBeGreaterThan, 0)
+
+	a.So(h.takeDownlinkQuota(appID, devID, msg), ShouldBeNil)
+	a.So(h.takeDownlinkQuota(appID, devID, msg), ShouldNotBeNil)
+	a.So(h.takeDownlinkQuota(appID, "dev2", msg), ShouldBeNil)
+	<-h.qEvent
+
+	// The estimated airtime is counted at enqueue, and not for the refused downlink
+	a.So(usage(quotaDownlinksPerDay), ShouldEqual, 2)
+	a.So(usage(quotaDownlinkAirtimePerDay), ShouldEqual, 2*int64(estimate/time.Millisecond))
+
+	// A downlink that would exceed the airtime quota is refused
+	a.So(h.takeDownlinkQuota(appID, "dev3", &types.DownlinkMessage{PayloadRaw: make([]byte, 50)}), ShouldNotBeNil)
+	event = <-h.qEvent
+	a.So(event.DevID, ShouldBeEmpty)
+	a.So(event.Data.(types.QuotaExceededEventData).Quota, ShouldEqual, quotaDownlinkAirtimePerDay)
+
+	downlink := &pb_broker.DownlinkMessage{
+		Payload: make([]byte, 20),
+		DownlinkOption: &pb_broker.DownlinkOption{
+			ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+				Modulation: pb_lorawan.Modulation_LORA,
+				DataRate:   "SF9BW125",
+				CodingRate: "4/5",
+			}}},
+		},
+	}
+	airtime, err := downlinkAirtime(downlink)
+	a.So(err, ShouldBeNil)
+	a.So(airtime, ShouldBeGreaterThan, 0)
+
+	before := usage(quotaDownlinkAirtimePerDay)
+	h.addDownlinkAirtime(appID, "dev2", downlink)
+	a.So(usage(quotaDownlinkAirtimePerDay), ShouldEqual, before+int64(airtime/time.Millisecond))
+}
+
+func TestHandleUplinkQuota(t *testing.T) {
+	a := New(t)
+	appID := "appid"
+	devID := "devid"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestHandleUplinkQuota")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-handle-uplink-quota"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-handle-uplink-quota"),
+		quotas:       newRedisQuotas(GetRedisClient(), "handler-test-handle-uplink-quota"),
+		qUp:          make(chan *types.UplinkMessage, 10),
+		qEvent:       make(chan *types.DeviceEvent, 10),
+	}
+	defer h.quotas.delete(appID)
+	h.InitStatus()
+	h.devices.Set(&device.Device{AppID: appID, DevID: devID})
+	defer h.devices.Delete(appID, devID)
+	h.applications.Set(&application.Application{
+		AppID:  appID,
+		Quotas: &application.Quotas{Device: application.QuotaLimits{UplinksPerHour: 1}},
+	})
+	defer h.applications.Delete(appID)
+
+	uplink, _ := buildLoRaWANUplink([]byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x0A, 0x4D, 0xDA, 0x23, 0x99, 0x61, 0xD4})
+	a.So(h.HandleUplink(uplink), ShouldBeNil)
+	a.So(h.qUp, ShouldHaveLength, 1)
+
+	// The device state is updated, but the uplink that exceeds the quota is not published
+	uplink.UnmarshalPayload()
+	uplink.Message.GetLoRaWAN().GetMACPayload().FCnt++
+	uplink.GetProtocolMetadata().GetLoRaWAN().FCnt = uplink.Message.GetLoRaWAN().GetMACPayload().FCnt
+	uplink.Message.GetLoRaWAN().SetMIC(types.NwkSKey{})
+	uplink.Payload = uplink.Message.GetLoRaWAN().PHYPayloadBytes()
+	a.So(h.HandleUplink(uplink), ShouldBeNil)
+	a.So(h.qUp, ShouldHaveLength, 1)
+
+	dev, err := h.devices.Get(appID, devID)
+	a.So(err, ShouldBeNil)
+	a.So(dev.FCntUp, ShouldEqual, 2)
+}
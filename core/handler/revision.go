@@ -0,0 +1,210 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheThingsNetwork/go-account-lib/claims"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// revisionAuthor returns the author of a revision from the claims of the request
+func revisionAuthor(claims *claims.Claims) string {
+	if claims == nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// addRevision records a revision of the payload functions of the application. If the application does not have
+// revisions yet, the previous payload functions are recorded first, so that they can be restored.
+func (h *handler) addRevision(appID string, previous application.PayloadFunctions, revision *application.Revision) error {
+	if h.functionRevisions == nil {
+		return nil
+	}
+	revisions, err := h.functionRevisions.List(appID)
+	if err != nil {
+		return err
+	}
+	if len(revisions) == 0 && !previous.IsEmpty() {
+		if err := h.functionRevisions.Add(appID, &application.Revision{
+			CreatedAt: time.Now(),
+			Functions: previous,
+		}); err != nil {
+			return err
+		}
+	}
+	revision.CreatedAt = time.Now()
+	return h.functionRevisions.Add(appID, revision)
+}
+
+// listRevisions returns the revisions of the payload functions of the application, with the diff to the
+// previous revision
+func (h *handler) listRevisions(appID string) ([]*application.Revision, error) {
+	revisions, err := h.functionRevisions.List(appID)
+	if err != nil {
+		return nil, err
+	}
+	var previous application.PayloadFunctions
+	for _, revision := range revisions {
+		revision.Diff = revision.Functions.Diff(previous)
+		previous = revision.Functions
+	}
+	return revisions, nil
+}
+
+// rollbackFunctions restores the payload functions of a revision, and records that as a new revision. The revision
+// is recorded first, and removed again if the payload functions could not be restored.
+func (h *handler) rollbackFunctions(appID string, number int, author string) (*application.Revision, error) {
+	target, err := h.functionRevisions.Get(appID, number)
+	if err != nil {
+		return nil, err
+	}
+	app, err := h.applications.Get(appID)
+	if err != nil {
+		return nil, err
+	}
+	previous := app.PayloadFunctions()
+	app.StartUpdate()
+	if err := app.SetPayloadFunctions(target.Functions); err != nil {
+		return nil, err
+	}
+
+	revision := &application.Revision{
+		Author:     author,
+		RollbackOf: number,
+		Functions:  target.Functions,
+		Diff:       target.Functions.Diff(previous),
+	}
+	if err := h.addRevision(appID, previous, revision); err != nil {
+		return nil, errors.Wrap(err, "Could not record revision")
+	}
+	if err := h.applications.Set(app); err != nil {
+		if err := h.functionRevisions.Remove(appID, revision.Number); err != nil {
+			h.Ctx.WithField("AppID", appID).WithError(err).Warn("Could not remove revision of failed rollback")
+		}
+		return nil, err
+	}
+	h.invalidateFunctions(appID)
+
+	h.Ctx.WithField("AppID", appID).WithField("Revision", number).Info("Rolled back payload functions")
+	return revision, nil
+}
+
+// portFunctionsToPb converts port functions to their proto
+func portFunctionsToPb(functions application.PortFunctions) *pb_manager.PortFunctions {
+	return &pb_manager.PortFunctions{
+		Decoder:   functions.Decoder,
+		Converter: functions.Converter,
+		Validator: functions.Validator,
+		Encoder:   functions.Encoder,
+	}
+}
+
+// payloadFunctionsToPb converts payload functions to their proto
+func payloadFunctionsToPb(functions application.PayloadFunctions) *pb_manager.PayloadFunctions {
+	pb := &pb_manager.PayloadFunctions{
+		PayloadFormat: string(functions.PayloadFormat),
+		Decoder:       functions.Decoder,
+		Converter:     functions.Converter,
+		Validator:     functions.Validator,
+		Encoder:       functions.Encoder,
+		BinaryFormat:  functions.BinaryFormat,
+	}
+	if len(functions.Ports) > 0 {
+		pb.Ports = make(map[uint32]*pb_manager.PortFunctions, len(functions.Ports))
+		for port, portFunctions := range functions.Ports {
+			pb.Ports[uint32(port)] = portFunctionsToPb(portFunctions)
+		}
+	}
+	if len(functions.Sets) > 0 {
+		pb.Sets = make(map[string]*pb_manager.PortFunctions, len(functions.Sets))
+		for name, setFunctions := range functions.Sets {
+			pb.Sets[name] = portFunctionsToPb(setFunctions)
+		}
+	}
+	return pb
+}
+
+// revisionToPb converts a revision to its proto
+func revisionToPb(revision *application.Revision) *pb_manager.Revision {
+	return &pb_manager.Revision{
+		Number:     uint32(revision.Number),
+		Author:     revision.Author,
+		CreatedAt:  revision.CreatedAt.UnixNano(),
+		RollbackOf: uint32(revision.RollbackOf),
+		Functions:  payloadFunctionsToPb(revision.Functions),
+		Diff:       revision.Diff,
+	}
+}
+
+// errRevisionsDisabled is returned by the revision RPCs if the Handler does not keep revisions
+var errRevisionsDisabled = errors.NewErrNotFound("Revisions of payload functions")
+
+func (h *handlerManager) ListRevisions(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.RevisionList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	if h.handler.functionRevisions == nil {
+		return nil, errRevisionsDisabled
+	}
+	revisions, err := h.handler.listRevisions(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.RevisionList{}
+	for _, revision := range revisions {
+		res.Revisions = append(res.Revisions, revisionToPb(revision))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) GetRevision(ctx context.Context, in *pb_manager.RevisionIdentifier) (*pb_manager.Revision, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Revision Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	if h.handler.functionRevisions == nil {
+		return nil, errRevisionsDisabled
+	}
+	revisions, err := h.handler.listRevisions(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		if revision.Number == int(in.Number) {
+			return revisionToPb(revision), nil
+		}
+	}
+	return nil, errors.NewErrNotFound(fmt.Sprintf("Revision %d", in.Number))
+}
+
+func (h *handlerManager) RollbackPayloadFunctions(ctx context.Context, in *pb_manager.RevisionIdentifier) (*pb_manager.Revision, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Revision Identifier")
+	}
+	_, claims, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings)
+	if err != nil {
+		return nil, err
+	}
+	if h.handler.functionRevisions == nil {
+		return nil, errRevisionsDisabled
+	}
+	revision, err := h.handler.rollbackFunctions(in.AppID, int(in.Number), revisionAuthor(claims))
+	if err != nil {
+		return nil, err
+	}
+	return revisionToPb(revision), nil
+}
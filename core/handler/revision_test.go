@@ -0,0 +1,64 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/functions"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPayloadFunctionRevisions(t *testing.T) {
+	a := New(t)
+	appID := "app1"
+	h := &handler{
+		Component:         &component.Component{Ctx: GetLogger(t, "TestPayloadFunctionRevisions")},
+		applications:      application.NewRedisApplicationStore(GetRedisClient(), "handler-test-revisions"),
+		functionRevisions: application.NewRedisRevisionStore(GetRedisClient(), "handler-test-revisions"),
+		scripts:           functions.NewCache(),
+	}
+	defer h.functionRevisions.Delete(appID)
+
+	original := application.PayloadFunctions{PayloadFormat: application.PayloadFormatCustom, Decoder: "v1"}
+	app := &application.Application{AppID: appID}
+	app.SetPayloadFunctions(original)
+	h.applications.Set(app)
+	defer h.applications.Delete(appID)
+
+	// The first change also records the payload functions from before revisions were kept
+	changed := application.PayloadFunctions{PayloadFormat: application.PayloadFormatCustom, Decoder: "v2"}
+	a.So(h.addRevision(appID, original, &application.Revision{Author: "alice", Functions: changed}), ShouldBeNil)
+	app, _ = h.applications.Get(appID)
+	app.StartUpdate()
+	app.SetPayloadFunctions(changed)
+	h.applications.Set(app)
+
+	revisions, err := h.listRevisions(appID)
+	a.So(err, ShouldBeNil)
+	a.So(revisions, ShouldHaveLength, 2)
+	a.So(revisions[0].Author, ShouldBeEmpty)
+	a.So(revisions[0].Functions, ShouldResemble, original)
+	a.So(revisions[1].Author, ShouldEqual, "alice")
+	a.So(revisions[1].Diff, ShouldContainSubstring, "-v1\n+v2")
+
+	_, err = h.rollbackFunctions(appID, 5, "bob")
+	a.So(err, ShouldNotBeNil)
+
+	revision, err := h.rollbackFunctions(appID, 1, "bob")
+	a.So(err, ShouldBeNil)
+	a.So(revision.Number, ShouldEqual, 3)
+	a.So(revision.RollbackOf, ShouldEqual, 1)
+	a.So(revision.Author, ShouldEqual, "bob")
+
+	app, _ = h.applications.Get(appID)
+	a.So(app.CustomDecoder, ShouldEqual, "v1")
+
+	revisions, _ = h.listRevisions(appID)
+	a.So(revisions, ShouldHaveLength, 3)
+	a.So(revisions[2].Diff, ShouldContainSubstring, "-v2\n+v1")
+}
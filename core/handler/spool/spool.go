@@ -0,0 +1,203 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package spool
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/storage"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"gopkg.in/redis.v5"
+)
+
+// Entry is a message that could not be published by an integration. Seq orders the entries of a queue; an entry
+// that fails again is put back at its original position.
+type Entry struct {
+	Seq    int64                `json:"seq"`
+	Time   time.Time            `json:"time"`
+	Uplink *types.UplinkMessage `json:"uplink,omitempty"`
+	Event  *types.DeviceEvent   `json:"event,omitempty"`
+	// Target is the destination within the integration that the entry is for, such as a webhook. Entries with a
+	// target are spooled in a queue per target.
+	Target string `json:"target,omitempty"`
+}
+
+// AppID returns the AppID of the message in the entry
+func (e *Entry) AppID() string {
+	if e.Uplink != nil {
+		return e.Uplink.AppID
+	}
+	if e.Event != nil {
+		return e.Event.AppID
+	}
+	return ""
+}
+
+// Queue returns the queue of the entry: the AppID of the message, followed by the Target if the entry has one
+func (e *Entry) Queue() string {
+	if e.Target != "" {
+		return e.AppID() + "/" + e.Target
+	}
+	return e.AppID()
+}
+
+// SplitQueue returns the AppID and the Target of a queue
+func SplitQueue(queue string) (appID, target string) {
+	parts := strings.SplitN(queue, "/", 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return queue, ""
+}
+
+// Store stores the messages that could not be published by an integration, in a queue per application, or per
+// application and target, that is ordered by the Seq of the entries
+type Store interface {
+	Queues(integration string) ([]string, error)
+	Length(integration, queue string) (int, error)
+	Count(integration string) (int, error)
+	Push(integration string, entry *Entry) error
+	Next(integration, queue string) (*Entry, error)
+	Delete(integration, appID string) error
+	SetLimits(maxSize int, maxAge time.Duration)
+}
+
+const defaultRedisPrefix = "handler"
+const redisSpoolPrefix = "spool"
+
+// NewRedisSpoolStore creates a new Redis-based spool store. Each queue holds at most maxSize entries and entries
+// older than maxAge are discarded. A zero maxSize or maxAge disables the corresponding limit.
+func NewRedisSpoolStore(client *redis.Client, prefix string, maxSize int, maxAge time.Duration) *RedisSpoolStore {
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+	return &RedisSpoolStore{
+		store:   storage.NewRedisStore(client, prefix+":"+redisSpoolPrefix),
+		client:  client,
+		prefix:  prefix + ":" + redisSpoolPrefix + ":",
+		maxSize: maxSize,
+		maxAge:  maxAge,
+	}
+}
+
+// RedisSpoolStore stores the spool in Redis.
+// - Spools are stored as a Sorted Set per integration and queue, scored by the Seq of the entries
+type RedisSpoolStore struct {
+	store   *storage.RedisStore
+	client  *redis.Client
+	prefix  string
+	maxSize int
+	maxAge  time.Duration
+}
+
+func (s *RedisSpoolStore) key(integration, queue string) string {
+	return fmt.Sprintf("%s%s:%s", s.prefix, integration, queue)
+}
+
+// SetLimits sets the maximum number of entries per queue and the maximum age of the entries
+func (s *RedisSpoolStore) SetLimits(maxSize int, maxAge time.Duration) {
+	s.maxSize = maxSize
+	s.maxAge = maxAge
+}
+
+// Queues returns the queues that have messages in the spool of the integration
+func (s *RedisSpoolStore) Queues(integration string) ([]string, error) {
+	keys, err := s.store.Keys(s.key(integration, "*"))
+	if err != nil {
+		return nil, err
+	}
+	queues := make([]string, 0, len(keys))
+	for _, key := range keys {
+		queues = append(queues, strings.TrimPrefix(key, s.key(integration, "")))
+	}
+	return queues, nil
+}
+
+// Length returns the number of messages in a queue of the spool of the integration
+func (s *RedisSpoolStore) Length(integration, queue string) (int, error) {
+	length, err := s.client.ZCard(s.key(integration, queue)).Result()
+	if err != nil {
+		return 0, err
+	}
+	return int(length), nil
+}
+
+// Count returns the total number of messages in the spool of the integration
+func (s *RedisSpoolStore) Count(integration string) (int, error) {
+	queues, err := s.Queues(integration)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, queue := range queues {
+		length, err := s.Length(integration, queue)
+		if err != nil {
+			return 0, err
+		}
+		count += length
+	}
+	return count, nil
+}
+
+// Push an entry to its queue at the position of its Seq, discarding the oldest entries if the queue is full
+func (s *RedisSpoolStore) Push(integration string, entry *Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	key := s.key(integration, entry.Queue())
+	_, err = s.client.Pipelined(func(pipe *redis.Pipeline) error {
+		pipe.ZAdd(key, redis.Z{Score: float64(entry.Seq), Member: string(data)})
+		if s.maxSize > 0 {
+			pipe.ZRemRangeByRank(key, 0, int64(-s.maxSize-1))
+		}
+		return nil
+	})
+	return err
+}
+
+// Next removes the entry with the lowest Seq from the queue and returns it, skipping entries that are too old.
+// It returns nil if the queue is empty.
+func (s *RedisSpoolStore) Next(integration, queue string) (*Entry, error) {
+	key := s.key(integration, queue)
+	for {
+		res, err := s.client.ZRange(key, 0, 0).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			return nil, nil
+		}
+		removed, err := s.client.ZRem(key, res[0]).Result()
+		if err != nil {
+			return nil, err
+		}
+		if removed == 0 {
+			continue // Removed by someone else
+		}
+		entry := new(Entry)
+		if err := json.Unmarshal([]byte(res[0]), entry); err != nil {
+			return nil, err
+		}
+		if s.maxAge > 0 && time.Since(entry.Time) > s.maxAge {
+			continue
+		}
+		return entry, nil
+	}
+}
+
+// Delete the queues of the integration for an application, including those of its targets
+func (s *RedisSpoolStore) Delete(integration, appID string) error {
+	keys, err := s.store.Keys(s.key(integration, appID+"/*"))
+	if err != nil {
+		return err
+	}
+	return s.client.Del(append(keys, s.key(integration, appID))...).Err()
+}
@@ -0,0 +1,116 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package spool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestSpoolStore(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisSpoolStore(GetRedisClient(), "handler-test-spool-store", 3, time.Hour)
+
+	defer func() {
+		s.Delete("MQTT", "app")
+	}()
+
+	{
+		next, err := s.Next("MQTT", "app")
+		a.So(err, ShouldBeNil)
+		a.So(next, ShouldBeNil)
+	}
+
+	for i := byte(0); i < 4; i++ {
+		err := s.Push("MQTT", &Entry{Seq: int64(i + 1), Uplink: &types.UplinkMessage{AppID: "app", DevID: "dev", PayloadRaw: []byte{i}}})
+		a.So(err, ShouldBeNil)
+	}
+	err := s.Push("MQTT", &Entry{
+		Seq:   5,
+		Time:  time.Now().Add(-2 * time.Hour),
+		Event: &types.DeviceEvent{AppID: "app", DevID: "dev", Event: types.ActivationEvent},
+	})
+	a.So(err, ShouldBeNil)
+
+	{
+		queues, err := s.Queues("MQTT")
+		a.So(err, ShouldBeNil)
+		a.So(queues, ShouldResemble, []string{"app"})
+
+		count, err := s.Count("MQTT")
+		a.So(err, ShouldBeNil)
+		a.So(count, ShouldEqual, 3)
+
+		count, err = s.Count("AMQP")
+		a.So(err, ShouldBeNil)
+		a.So(count, ShouldEqual, 0)
+	}
+
+	// The oldest entries were discarded
+	{
+		next, err := s.Next("MQTT", "app")
+		a.So(err, ShouldBeNil)
+		a.So(next.Uplink.PayloadRaw, ShouldResemble, []byte{2})
+
+		// An entry that is pushed again keeps its position
+		err = s.Push("MQTT", next)
+		a.So(err, ShouldBeNil)
+
+		next, err = s.Next("MQTT", "app")
+		a.So(err, ShouldBeNil)
+		a.So(next.Uplink.PayloadRaw, ShouldResemble, []byte{2})
+
+		next, err = s.Next("MQTT", "app")
+		a.So(err, ShouldBeNil)
+		a.So(next.Uplink.PayloadRaw, ShouldResemble, []byte{3})
+	}
+
+	// The expired entry is skipped
+	{
+		next, err := s.Next("MQTT", "app")
+		a.So(err, ShouldBeNil)
+		a.So(next, ShouldBeNil)
+
+		length, err := s.Length("MQTT", "app")
+		a.So(err, ShouldBeNil)
+		a.So(length, ShouldEqual, 0)
+	}
+}
+
+func TestSpoolStoreTargets(t *testing.T) {
+	a := New(t)
+
+	s := NewRedisSpoolStore(GetRedisClient(), "handler-test-spool-store-targets", 0, 0)
+
+	up := &types.UplinkMessage{AppID: "app", DevID: "dev"}
+	a.So(s.Push("HTTP", &Entry{Seq: 1, Uplink: up, Target: "webhook1"}), ShouldBeNil)
+	a.So(s.Push("HTTP", &Entry{Seq: 2, Uplink: up, Target: "webhook2"}), ShouldBeNil)
+	a.So(s.Push("HTTP", &Entry{Seq: 3, Uplink: &types.UplinkMessage{AppID: "other", DevID: "dev"}, Target: "webhook1"}), ShouldBeNil)
+	defer s.Delete("HTTP", "other")
+
+	appID, target := SplitQueue("app/webhook1")
+	a.So(appID, ShouldEqual, "app")
+	a.So(target, ShouldEqual, "webhook1")
+
+	next, err := s.Next("HTTP", "app/webhook2")
+	a.So(err, ShouldBeNil)
+	a.So(next.Seq, ShouldEqual, 2)
+	a.So(next.Target, ShouldEqual, "webhook2")
+	a.So(s.Push("HTTP", next), ShouldBeNil)
+
+	count, err := s.Count("HTTP")
+	a.So(err, ShouldBeNil)
+	a.So(count, ShouldEqual, 3)
+
+	// Deleting the spool of an application deletes the queues of its targets
+	a.So(s.Delete("HTTP", "app"), ShouldBeNil)
+	queues, err := s.Queues("HTTP")
+	a.So(err, ShouldBeNil)
+	a.So(queues, ShouldResemble, []string{"other/webhook1"})
+}
@@ -4,8 +4,13 @@
 package handler
 
 import (
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/TheThingsNetwork/api"
 	pb "github.com/TheThingsNetwork/api/handler"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/api/stats"
 	"github.com/rcrowley/go-metrics"
 )
@@ -14,6 +19,9 @@ type status struct {
 	uplink      metrics.Meter
 	downlink    metrics.Meter
 	activations metrics.Meter
+
+	functionsLock sync.RWMutex
+	functions     map[string]map[string]metrics.Histogram // execution time of payload functions by AppID and direction
 }
 
 func (h *handler) InitStatus() {
@@ -21,7 +29,86 @@ func (h *handler) InitStatus() {
 		uplink:      metrics.NewMeter(),
 		downlink:    metrics.NewMeter(),
 		activations: metrics.NewMeter(),
+		functions:   make(map[string]map[string]metrics.Histogram),
+	}
+}
+
+// observeFunctions records the execution time of the payload functions of an application
+func (h *handler) observeFunctions(appID, direction string, duration time.Duration) {
+	if h.status == nil {
+		return
+	}
+	h.status.functionsLock.Lock()
+	defer h.status.functionsLock.Unlock()
+	histograms, ok := h.status.functions[appID]
+	if !ok {
+		histograms = make(map[string]metrics.Histogram)
+		h.status.functions[appID] = histograms
+	}
+	histogram, ok := histograms[direction]
+	if !ok {
+		histogram = metrics.NewHistogram(metrics.NewUniformSample(128))
+		histograms[direction] = histogram
+	}
+	histogram.Update(int64(duration / time.Microsecond))
+}
+
+type byAppIDAndDirection []*pb_manager.FunctionStatus
+
+func (a byAppIDAndDirection) Len() int      { return len(a) }
+func (a byAppIDAndDirection) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byAppIDAndDirection) Less(i, j int) bool {
+	if a[i].AppID != a[j].AppID {
+		return a[i].AppID < a[j].AppID
 	}
+	return a[i].Direction < a[j].Direction
+}
+
+// functionsStatus returns the execution time of the payload functions by AppID and direction
+func (h *handler) functionsStatus() []*pb_manager.FunctionStatus {
+	var status []*pb_manager.FunctionStatus
+	if h.status == nil {
+		return status
+	}
+	h.status.functionsLock.RLock()
+	defer h.status.functionsLock.RUnlock()
+	for appID, histograms := range h.status.functions {
+		for direction, histogram := range histograms {
+			snapshot := histogram.Snapshot()
+			percentiles := snapshot.Percentiles([]float64{0.5, 0.95, 0.99})
+			status = append(status, &pb_manager.FunctionStatus{
+				AppID:     appID,
+				Direction: direction,
+				Count:     snapshot.Count(),
+				Mean:      snapshot.Mean() / 1000,
+				P50:       percentiles[0] / 1000,
+				P95:       percentiles[1] / 1000,
+				P99:       percentiles[2] / 1000,
+				Max:       float64(snapshot.Max()) / 1000,
+			})
+		}
+	}
+	sort.Sort(byAppIDAndDirection(status))
+	return status
+}
+
+// integrationStatus returns the health and spool depth of the integrations. It is served by GetHandlerStatus, as the
+// Status message of GetStatus is defined in the TheThingsNetwork/api protos and has no field for it.
+func (h *handler) integrationStatus() []*pb_manager.IntegrationStatus {
+	status := make([]*pb_manager.IntegrationStatus, 0, len(h.integrations))
+	for _, i := range h.integrations {
+		s := &pb_manager.IntegrationStatus{
+			Name:    i.Name(),
+			Healthy: i.up != nil && i.Healthy(),
+		}
+		if h.spool != nil {
+			if spool, err := h.spool.Count(i.Name()); err == nil {
+				s.Spool = uint32(spool)
+			}
+		}
+		status = append(status, s)
+	}
+	return status
 }
 
 func (h *handler) GetStatus() *pb.Status {
@@ -9,6 +9,8 @@ import (
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	"github.com/TheThingsNetwork/api/logfields"
 	"github.com/TheThingsNetwork/api/trace"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 )
 
@@ -40,47 +42,23 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 
 	uplink.Trace = uplink.Trace.WithEvent(trace.ReceiveEvent)
 
-	dev, err := h.devices.Get(appID, devID)
-	if err != nil {
-		return err
-	}
-	dev.StartUpdate()
-
-	// Build AppUplink
 	appUplink := &types.UplinkMessage{
 		AppID: appID,
 		DevID: devID,
 	}
 
-	// Get Uplink Processors
-	processors := []UplinkProcessor{
-		h.ConvertFromLoRaWAN,
-		h.ConvertMetadata,
-		h.ConvertFieldsUp,
-	}
-
-	ctx.WithField("NumProcessors", len(processors)).Debug("Running Uplink Processors")
-	uplink.Trace = uplink.Trace.WithEvent("process uplink")
-
-	// Run Uplink Processors
-	for _, processor := range processors {
-		err = processor(ctx, uplink, appUplink, dev)
-		if err == ErrNotNeeded {
-			err = nil
-			return nil
-		} else if err != nil {
-			return err
-		}
-	}
-
-	err = h.devices.Set(dev)
-	if err != nil {
+	dev, publish, err := h.processUplink(ctx, uplink, appUplink)
+	if err == ErrNotNeeded {
+		err = nil
+		return nil
+	} else if err != nil {
 		return err
 	}
-	dev.StartUpdate()
 
 	// Publish Uplink
-	h.qUp <- appUplink
+	if publish {
+		h.qUp <- appUplink
+	}
 
 	noDownlinkErrEvent := &types.DeviceEvent{
 		AppID: appID,
@@ -89,9 +67,22 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 		Data:  types.ErrorEventData{Error: "No gateways available for downlink"},
 	}
 
+	// Give the application the time to respond to the uplink. The device is not locked while waiting, so that
+	// the response can be enqueued.
 	if dev.CurrentDownlink == nil {
 		<-time.After(ResponseDeadline)
+	}
+
+	unlock := h.lockDevice(appID, devID)
+	defer unlock()
+
+	dev, err = h.devices.Get(appID, devID)
+	if err != nil {
+		return err
+	}
+	dev.StartUpdate()
 
+	if dev.CurrentDownlink == nil {
 		queue, err := h.devices.DownlinkQueue(appID, devID)
 		if err != nil {
 			return err
@@ -99,11 +90,15 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 
 		if len, _ := queue.Length(); len > 0 {
 			if uplink.ResponseTemplate != nil {
-				next, err := queue.Next()
+				next, err := h.nextDownlink(queue, appID, devID)
 				if err != nil {
 					return err
 				}
 				dev.CurrentDownlink = next
+				dev.CurrentDownlinkAttempts = 0
+			} else if dev.Class.ReceivesDownlinkWithoutUplink() {
+				go h.sendClassCDownlink(appID, devID)
+				return nil
 			} else {
 				h.qEvent <- noDownlinkErrEvent
 				return nil
@@ -142,3 +137,55 @@ func (h *handler) HandleUplink(uplink *pb_broker.DeduplicatedUplinkMessage) (err
 
 	return nil
 }
+
+// processUplink runs the uplink processors and saves the state of the device. It returns the device, and whether
+// the uplink should be published.
+func (h *handler) processUplink(ctx ttnlog.Interface, uplink *pb_broker.DeduplicatedUplinkMessage, appUplink *types.UplinkMessage) (dev *device.Device, publish bool, err error) {
+	appID, devID := uplink.AppID, uplink.DevID
+
+	unlock := h.lockDevice(appID, devID)
+	defer unlock()
+
+	dev, err = h.devices.Get(appID, devID)
+	if err != nil {
+		return nil, false, err
+	}
+	dev.StartUpdate()
+
+	// Get Uplink Processors
+	processors := []UplinkProcessor{
+		h.ConvertFromLoRaWAN,
+		h.CheckUplinkQuota,
+		h.ConvertMetadata,
+		h.ReassembleFragments,
+		h.ConvertFieldsUp,
+	}
+
+	ctx.WithField("NumProcessors", len(processors)).Debug("Running Uplink Processors")
+	uplink.Trace = uplink.Trace.WithEvent("process uplink")
+
+	// Run Uplink Processors
+	publish = true
+	for _, processor := range processors {
+		err = processor(ctx, uplink, appUplink, dev)
+		if err == errFragmentBuffered || err == errQuotaExceeded {
+			// The device state is still updated and downlink is still sent, but the uplink is not published
+			publish = false
+			break
+		} else if err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Drop the pending downlink if it expired
+	if dev.CurrentDownlink != nil && dev.CurrentDownlink.Expired(time.Now()) {
+		h.expireDownlink(appID, devID, dev.CurrentDownlink)
+		dev.CurrentDownlink = nil
+		dev.CurrentDownlinkAttempts = 0
+	}
+
+	if err := h.devices.Set(dev); err != nil {
+		return nil, false, err
+	}
+	return dev, publish, nil
+}
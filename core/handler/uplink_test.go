@@ -189,4 +189,59 @@ func TestHandleUplink(t *testing.T) {
 	a.So(next.PayloadRaw, ShouldResemble, []byte{0x12, 0x34})
 	a.So(dev.CurrentDownlink, ShouldNotBeNil)
 	a.So(dev.CurrentDownlink.PayloadRaw, ShouldResemble, []byte{0xaa, 0xbc})
+	a.So(dev.CurrentDownlinkAttempts, ShouldEqual, 1)
+}
+
+func TestHandleUplinkResponse(t *testing.T) {
+	a := New(t)
+	var wg WaitGroup
+	appID := "appid"
+	devID := "devid"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestHandleUplinkResponse")},
+		devices:      device.NewRedisDeviceStore(GetRedisClient(), "handler-test-handle-uplink-response"),
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-handle-uplink-response"),
+	}
+	h.InitStatus()
+	h.devices.Set(&device.Device{
+		AppID:  appID,
+		DevID:  devID,
+		AppEUI: types.AppEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+		DevEUI: types.DevEUI([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+	})
+	defer h.devices.Delete(appID, devID)
+	h.applications.Set(&application.Application{AppID: appID})
+	defer h.applications.Delete(appID)
+	h.qUp = make(chan *types.UplinkMessage, 10)
+	h.qEvent = make(chan *types.DeviceEvent, 10)
+	h.downlink = make(chan *pb_broker.DownlinkMessage)
+
+	uplink, _ := buildLoRaWANUplink([]byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x0A, 0x4D, 0xDA, 0x23, 0x99, 0x61, 0xD4})
+	uplink.ResponseTemplate = &pb_broker.DownlinkMessage{
+		Payload: []byte{0x60, 0x04, 0x03, 0x02, 0x01, 0x00, 0x00, 0x00, 0x0A, 0x21, 0xEA, 0x8B, 0x0E},
+		DownlinkOption: &pb_broker.DownlinkOption{
+			ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{}}},
+		},
+	}
+
+	// The device is not locked while the Handler waits for the application, so the response can be enqueued
+	wg.Add(2)
+	go func() {
+		<-h.qUp
+		unlock := h.lockDevice(appID, devID)
+		queue, _ := h.devices.DownlinkQueue(appID, devID)
+		queue.PushFirst(&types.DownlinkMessage{PayloadRaw: []byte{0xaa, 0xbc}})
+		unlock()
+		wg.Done()
+	}()
+	go func() {
+		<-h.downlink
+		wg.Done()
+	}()
+	a.So(h.HandleUplink(uplink), ShouldBeNil)
+	a.So(wg.WaitFor(50*time.Millisecond), ShouldBeNil)
+
+	dev, _ := h.devices.Get(appID, devID)
+	a.So(dev.CurrentDownlink, ShouldNotBeNil)
+	a.So(dev.CurrentDownlink.PayloadRaw, ShouldResemble, []byte{0xaa, 0xbc})
 }
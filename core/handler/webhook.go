@@ -0,0 +1,456 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/TheThingsNetwork/api"
+	"github.com/TheThingsNetwork/go-account-lib/rights"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/backoff"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// WebhookTimeout indicates how long we should wait for a webhook to respond
+var WebhookTimeout = 5 * time.Second
+
+// WebhookRetries indicates how many times a failed webhook request is retried before the message is spooled
+var WebhookRetries = 5
+
+// WebhookBackoff is the backoff configuration for retrying failed webhook requests. A webhook is unhealthy until
+// the backoff after its last failed request has passed.
+var WebhookBackoff = backoff.Config{
+	MaxDelay:  30 * time.Second,
+	BaseDelay: 1 * time.Second,
+	Factor:    1.6,
+	Jitter:    0.2,
+}
+
+// WebhookWorkers indicates how many webhook requests are done concurrently
+var WebhookWorkers = 20
+
+// WebhookQueueSize indicates how many messages can wait for a webhook worker
+var WebhookQueueSize = 1000
+
+var errWebhookQueueFull = errors.New("Webhook queue is full")
+
+// webhookEvent is the body of a request that is posted to the event URL of a webhook
+type webhookEvent struct {
+	AppID string          `json:"app_id"`
+	DevID string          `json:"dev_id,omitempty"`
+	Event types.EventType `json:"event"`
+	Data  interface{}     `json:"data,omitempty"`
+}
+
+// webhookJob is a message that has to be posted to a webhook of an application
+type webhookJob struct {
+	entry   *spool.Entry
+	webhook application.Webhook
+	url     string
+	body    []byte
+	retries int
+}
+
+// webhookHealth is the health of a webhook, which is unavailable until retryAt after failed requests
+type webhookHealth struct {
+	failures int
+	retryAt  time.Time
+}
+
+type webhookIntegration struct {
+	applications application.Store
+	client       *http.Client
+	ctx          ttnlog.Interface
+	jobs         chan *webhookJob
+	done         chan struct{}
+	failed       publishFailureHandler
+
+	mu     sync.Mutex
+	health map[string]*webhookHealth // by AppID and webhook ID
+}
+
+// NewWebhookIntegration returns a new Integration that posts to the webhooks of the applications in the store
+func NewWebhookIntegration(applications application.Store) Integration {
+	return &webhookIntegration{
+		applications: applications,
+		health:       make(map[string]*webhookHealth),
+	}
+}
+
+func (i *webhookIntegration) Name() string {
+	return "HTTP"
+}
+
+func (i *webhookIntegration) Connect(ctx ttnlog.Interface) error {
+	i.ctx = ctx
+	i.client = &http.Client{
+		Timeout:   WebhookTimeout,
+		Transport: &http.Transport{DialContext: dialWebhook},
+	}
+	i.jobs = make(chan *webhookJob, WebhookQueueSize)
+	i.done = make(chan struct{})
+	for w := 0; w < WebhookWorkers; w++ {
+		go i.work()
+	}
+	return nil
+}
+
+func (i *webhookIntegration) Disconnect() {
+	close(i.done)
+}
+
+// Healthy returns true, as the health of webhooks is tracked per webhook
+func (i *webhookIntegration) Healthy() bool {
+	return true
+}
+
+// healthyTarget returns false if the webhook of the application is backing off after failed requests
+func (i *webhookIntegration) healthyTarget(appID, webhookID string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	health, ok := i.health[appID+"/"+webhookID]
+	return !ok || time.Now().After(health.retryAt)
+}
+
+// targets returns the IDs of the webhooks of the application that take the message of the entry
+func (i *webhookIntegration) targets(entry *spool.Entry) ([]string, error) {
+	app, err := i.applications.Get(entry.AppID())
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, webhook := range app.Webhooks {
+		if url, _ := webhookMessage(webhook, entry); url != "" {
+			ids = append(ids, webhook.ID)
+		}
+	}
+	return ids, nil
+}
+
+// SubscribeDownlink does nothing, as downlink messages for webhooks are enqueued through the HTTP API of the Handler
+func (i *webhookIntegration) SubscribeDownlink(handler DownlinkHandler) error {
+	return nil
+}
+
+func (i *webhookIntegration) onPublishFailure(handler publishFailureHandler) {
+	i.failed = handler
+}
+
+// PublishUplink queues the uplink for the webhook workers. If it can not be posted to a webhook, it is passed to
+// the publish failure handler for that webhook.
+func (i *webhookIntegration) PublishUplink(up *types.UplinkMessage) error {
+	return i.publishEntry(&spool.Entry{Uplink: up})
+}
+
+// PublishEvent queues the event for the webhook workers. If it can not be posted to a webhook, it is passed to
+// the publish failure handler for that webhook.
+func (i *webhookIntegration) PublishEvent(event *types.DeviceEvent) error {
+	return i.publishEntry(&spool.Entry{Event: event})
+}
+
+// publishEntry queues the entry for the webhook of its target, or for all webhooks of the application if it has
+// no target. Entries for webhooks that no longer exist are dropped.
+func (i *webhookIntegration) publishEntry(entry *spool.Entry) error {
+	app, err := i.applications.Get(entry.AppID())
+	if err != nil {
+		return err
+	}
+	for _, webhook := range app.Webhooks {
+		if entry.Target != "" && webhook.ID != entry.Target {
+			continue
+		}
+		url, msg := webhookMessage(webhook, entry)
+		if url == "" {
+			continue
+		}
+		body, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		webhookEntry := *entry
+		webhookEntry.Target = webhook.ID
+		if !i.queue(&webhookJob{entry: &webhookEntry, webhook: webhook, url: url, body: body}) {
+			return errWebhookQueueFull
+		}
+	}
+	return nil
+}
+
+// webhookMessage returns the URL of the webhook that the message of the entry is posted to, and the message. The
+// URL is empty if the webhook does not take the message.
+func webhookMessage(webhook application.Webhook, entry *spool.Entry) (url string, msg interface{}) {
+	if up := entry.Uplink; up != nil {
+		return webhook.UplinkURL, up
+	}
+	event := entry.Event
+	return webhook.EventURL, &webhookEvent{
+		AppID: event.AppID,
+		DevID: event.DevID,
+		Event: event.Event,
+		Data:  event.Data,
+	}
+}
+
+// queue the job for the workers, and return false if the queue is full
+func (i *webhookIntegration) queue(job *webhookJob) bool {
+	select {
+	case i.jobs <- job:
+		return true
+	default:
+		return false
+	}
+}
+
+func (i *webhookIntegration) work() {
+	for {
+		select {
+		case job := <-i.jobs:
+			i.handleJob(job)
+		case <-i.done:
+			return
+		}
+	}
+}
+
+// handleJob posts the message of the job to its webhook. A failed request is queued again after the backoff of the
+// webhook, so that the worker is free in the meantime. A message that could not be posted after WebhookRetries
+// retries is passed to the publish failure handler.
+func (i *webhookIntegration) handleJob(job *webhookJob) {
+	ctx := i.ctx.WithFields(ttnlog.Fields{
+		"AppID":   job.entry.AppID(),
+		"Webhook": job.webhook.ID,
+	})
+	retry, err := i.do(job.webhook, job.url, job.body)
+	delay := i.record(job.entry.AppID(), job.webhook.ID, err)
+	if err == nil {
+		return
+	}
+	if retry && job.retries < WebhookRetries {
+		job.retries++
+		ctx.WithError(err).WithField("Delay", delay).Debug("Retrying webhook")
+		time.AfterFunc(delay, func() {
+			select {
+			case <-i.done:
+				return
+			default:
+			}
+			if !i.queue(job) {
+				i.fail(ctx, job.entry, errWebhookQueueFull)
+			}
+		})
+		return
+	}
+	i.fail(ctx, job.entry, err)
+}
+
+// fail passes the entry that could not be posted to the publish failure handler
+func (i *webhookIntegration) fail(ctx ttnlog.Interface, entry *spool.Entry, err error) {
+	if i.failed != nil {
+		i.failed(entry, err)
+		return
+	}
+	ctx.WithError(err).Warn("Could not post to webhook")
+}
+
+// record records the result of a request to the webhook of the application for its health, and returns how long
+// the webhook should not be used after a failure
+func (i *webhookIntegration) record(appID, webhookID string, err error) time.Duration {
+	key := appID + "/" + webhookID
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if err == nil {
+		delete(i.health, key)
+		return 0
+	}
+	health, ok := i.health[key]
+	if !ok {
+		health = new(webhookHealth)
+		i.health[key] = health
+	}
+	delay := WebhookBackoff.Backoff(health.failures)
+	health.failures++
+	health.retryAt = time.Now().Add(delay)
+	return delay
+}
+
+// do does a single request to the webhook and returns whether it makes sense to retry on failure
+func (i *webhookIntegration) do(webhook application.Webhook, url string, body []byte) (retry bool, err error) {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range webhook.Headers {
+		req.Header.Set(key, value)
+	}
+	res, err := i.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("Webhook responded with %s", res.Status)
+	return res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests, err
+}
+
+// redactedHeader replaces the values of webhook headers in responses, as they often contain credentials. Setting a
+// header to this value keeps its current value.
+const redactedHeader = "<...>"
+
+func webhookToPb(appID string, webhook application.Webhook) *pb_manager.Webhook {
+	res := &pb_manager.Webhook{
+		AppID:     appID,
+		ID:        webhook.ID,
+		UplinkURL: webhook.UplinkURL,
+		EventURL:  webhook.EventURL,
+	}
+	if len(webhook.Headers) > 0 {
+		res.Headers = make(map[string]string, len(webhook.Headers))
+		for key := range webhook.Headers {
+			res.Headers[key] = redactedHeader
+		}
+	}
+	return res
+}
+
+// keepRedactedHeaders sets the headers of the webhook that have the redacted value to their value in the existing
+// webhook, and removes them if they don't exist there
+func keepRedactedHeaders(webhook application.Webhook, existing *application.Webhook) {
+	for key, value := range webhook.Headers {
+		if value != redactedHeader {
+			continue
+		}
+		if existing != nil {
+			if value, ok := existing.Headers[key]; ok {
+				webhook.Headers[key] = value
+				continue
+			}
+		}
+		delete(webhook.Headers, key)
+	}
+}
+
+func webhookFromPb(webhook *pb_manager.Webhook) application.Webhook {
+	return application.Webhook{
+		ID:        webhook.ID,
+		UplinkURL: webhook.UplinkURL,
+		EventURL:  webhook.EventURL,
+		Headers:   webhook.Headers,
+	}
+}
+
+func (h *handlerManager) GetWebhooks(ctx context.Context, in *pb_manager.ApplicationIdentifier) (*pb_manager.WebhookList, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.WebhookList{Webhooks: make([]*pb_manager.Webhook, 0, len(app.Webhooks))}
+	for _, webhook := range app.Webhooks {
+		res.Webhooks = append(res.Webhooks, webhookToPb(in.AppID, webhook))
+	}
+	return res, nil
+}
+
+func (h *handlerManager) SetWebhook(ctx context.Context, in *pb_manager.Webhook) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Application Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	webhook := webhookFromPb(in)
+	if err := webhook.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Webhook")
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	keepRedactedHeaders(webhook, app.GetWebhook(webhook.ID))
+	app.StartUpdate()
+	app.SetWebhook(webhook)
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+func (h *handlerManager) DeleteWebhook(ctx context.Context, in *pb_manager.WebhookIdentifier) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Webhook Identifier")
+	}
+	if _, _, err := h.validateAppRights(ctx, in.AppID, rights.AppSettings); err != nil {
+		return nil, err
+	}
+	app, err := h.handler.applications.Get(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	app.StartUpdate()
+	if err := app.DeleteWebhook(in.ID); err != nil {
+		return nil, err
+	}
+	if err := h.handler.applications.Set(app); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
+// serveWebhookDownlink serves the downlink endpoint of the HTTP integration, which webhooks can post downlink
+// messages to:
+//
+//	POST   /integrations/http/<AppID>/down           enqueues a downlink message
+func (h *handlerHTTP) serveWebhookDownlink(w http.ResponseWriter, req *http.Request) {
+	parts := pathParts(req.URL.Path, "/integrations/http/")
+	if len(parts) != 2 || parts[1] != "down" || req.Method != "POST" {
+		http.NotFound(w, req)
+		return
+	}
+	h.enqueueWebhookDownlink(w, req, parts[0])
+}
+
+func (h *handlerHTTP) enqueueWebhookDownlink(w http.ResponseWriter, req *http.Request, appID string) {
+	if _, _, err := h.validateRequest(req, appID, rightMessagesDown); err != nil {
+		writeError(w, err)
+		return
+	}
+	var downlink types.DownlinkMessage
+	if err := readJSON(req, &downlink); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := api.NotEmptyAndValidID(downlink.DevID, "Device ID"); err != nil {
+		writeError(w, errors.NewErrInvalidArgument("Downlink", err.Error()))
+		return
+	}
+	downlink.AppID = appID
+	if err := h.handler.EnqueueDownlink(&downlink); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, nil)
+}
@@ -0,0 +1,35 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+)
+
+// dialWebhook connects to the address of a webhook. It refuses the addresses that webhooks are not allowed to post
+// to, also when a host name resolves to them, and connects to the address that it checked.
+func dialWebhook(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range addrs {
+		if !application.WebhookAddressAllowed(ip.IP) {
+			return nil, fmt.Errorf("Webhook host %s has internal address %s", host, ip.IP)
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("Webhook host %s has no addresses", host)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, net.JoinHostPort(addrs[0].IP.String(), port))
+}
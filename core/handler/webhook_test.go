@@ -0,0 +1,233 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/spool"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func init() {
+	// The test servers listen on the loopback address
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	application.WebhookAllowedNetworks = append(application.WebhookAllowedNetworks, loopback)
+}
+
+func TestHandleWebhooks(t *testing.T) {
+	a := New(t)
+	var wg WaitGroup
+
+	WebhookBackoff.BaseDelay = 10 * time.Millisecond
+
+	var eventAttempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.So(r.Header.Get("Content-Type"), ShouldEqual, "application/json")
+		a.So(r.Header.Get("Authorization"), ShouldEqual, "secret")
+		switch r.URL.Path {
+		case "/up":
+			var up types.UplinkMessage
+			a.So(json.NewDecoder(r.Body).Decode(&up), ShouldBeNil)
+			a.So(up.PayloadRaw, ShouldResemble, []byte{0xAA, 0xBC})
+			wg.Done()
+		case "/events":
+			// The first attempt fails, so that the handler should retry
+			if atomic.AddInt32(&eventAttempts, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			var event webhookEvent
+			a.So(json.NewDecoder(r.Body).Decode(&event), ShouldBeNil)
+			a.So(event.Event, ShouldEqual, types.ActivationEvent)
+			wg.Done()
+		}
+	}))
+	defer server.Close()
+
+	appID := "handler-webhooks-app1"
+	devID := "handler-webhooks-dev1"
+	h := &handler{
+		Component:    &component.Component{Ctx: GetLogger(t, "TestHandleWebhooks")},
+		applications: application.NewRedisApplicationStore(GetRedisClient(), "handler-test-handle-webhooks"),
+	}
+	h.applications.Set(&application.Application{
+		AppID: appID,
+		Webhooks: []application.Webhook{
+			{
+				ID:        "test",
+				UplinkURL: server.URL + "/up",
+				EventURL:  server.URL + "/events",
+				Headers:   map[string]string{"Authorization": "secret"},
+			},
+		},
+	})
+	defer func() {
+		h.applications.Delete(appID)
+	}()
+
+	i := &integration{Integration: NewWebhookIntegration(h.applications)}
+	err := h.startIntegration(i)
+	a.So(err, ShouldBeNil)
+
+	wg.Add(1)
+	i.up <- &types.UplinkMessage{
+		DevID:      devID,
+		AppID:      appID,
+		PayloadRaw: []byte{0xAA, 0xBC},
+	}
+
+	wg.Add(1)
+	i.event <- &types.DeviceEvent{
+		DevID: devID,
+		AppID: appID,
+		Event: types.ActivationEvent,
+	}
+
+	a.So(wg.WaitFor(time.Second), ShouldBeNil)
+	a.So(atomic.LoadInt32(&eventAttempts), ShouldEqual, 2)
+}
+
+func TestWebhookPublishFailure(t *testing.T) {
+	a := New(t)
+
+	defer func(retries int) { WebhookRetries = retries }(WebhookRetries)
+	WebhookRetries = 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	appID := "handler-webhooks-app2"
+	applications := application.NewRedisApplicationStore(GetRedisClient(), "handler-test-webhook-publish-failure")
+	applications.Set(&application.Application{
+		AppID: appID,
+		Webhooks: []application.Webhook{
+			{ID: "test", UplinkURL: server.URL + "/up"},
+		},
+	})
+	defer applications.Delete(appID)
+
+	i := NewWebhookIntegration(applications).(*webhookIntegration)
+	a.So(i.Connect(GetLogger(t, "TestWebhookPublishFailure")), ShouldBeNil)
+	defer i.Disconnect()
+
+	failed := make(chan *spool.Entry, 1)
+	i.onPublishFailure(func(entry *spool.Entry, err error) {
+		failed <- entry
+	})
+	a.So(i.Healthy(), ShouldBeTrue)
+
+	err := i.PublishUplink(&types.UplinkMessage{AppID: appID, DevID: "dev"})
+	a.So(err, ShouldBeNil)
+
+	select {
+	case entry := <-failed:
+		a.So(entry.Uplink.AppID, ShouldEqual, appID)
+		a.So(entry.Target, ShouldEqual, "test")
+	case <-time.After(time.Second):
+		t.Fatal("Publish failure was not reported")
+	}
+	a.So(i.Healthy(), ShouldBeTrue)
+	a.So(i.healthyTarget(appID, "test"), ShouldBeFalse)
+	a.So(i.healthyTarget("other-app", "test"), ShouldBeTrue)
+}
+
+func TestWebhookSpoolPerWebhook(t *testing.T) {
+	a := New(t)
+
+	defer func(retries int, backoff time.Duration, interval time.Duration) {
+		WebhookRetries, WebhookBackoff.BaseDelay, SpoolReplayInterval = retries, backoff, interval
+	}(WebhookRetries, WebhookBackoff.BaseDelay, SpoolReplayInterval)
+	WebhookRetries = 0
+	WebhookBackoff.BaseDelay = 100 * time.Millisecond
+	SpoolReplayInterval = 20 * time.Millisecond
+
+	var available int32
+	var okPosts, failingPosts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			atomic.AddInt32(&okPosts, 1)
+		case "/failing":
+			atomic.AddInt32(&failingPosts, 1)
+			if atomic.LoadInt32(&available) == 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}
+	}))
+	defer server.Close()
+
+	appID := "handler-webhooks-app3"
+	applications := application.NewRedisApplicationStore(GetRedisClient(), "handler-test-webhook-spool")
+	applications.Set(&application.Application{
+		AppID: appID,
+		Webhooks: []application.Webhook{
+			{ID: "ok", UplinkURL: server.URL + "/ok"},
+			{ID: "failing", UplinkURL: server.URL + "/failing"},
+		},
+	})
+	defer applications.Delete(appID)
+
+	store := spool.NewRedisSpoolStore(GetRedisClient(), "handler-test-webhook-spool", 10, time.Hour)
+	defer store.Delete("HTTP", appID)
+
+	h := &handler{
+		Component: &component.Component{Ctx: GetLogger(t, "TestWebhookSpoolPerWebhook")},
+		spool:     store,
+	}
+	h.WithIntegration(NewWebhookIntegration(applications))
+	i := h.integrations[0]
+	a.So(h.startIntegration(i), ShouldBeNil)
+	defer i.Disconnect()
+
+	// Only the message for the failing webhook is spooled
+	i.up <- &types.UplinkMessage{AppID: appID, DevID: "dev"}
+	<-time.After(50 * time.Millisecond)
+	a.So(atomic.LoadInt32(&okPosts), ShouldEqual, 1)
+	length, err := store.Length("HTTP", appID+"/failing")
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 1)
+	length, err = store.Length("HTTP", appID+"/ok")
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 0)
+
+	// The spool is only replayed to the failing webhook, when its backoff has passed
+	atomic.StoreInt32(&available, 1)
+	<-time.After(300 * time.Millisecond)
+	length, err = store.Length("HTTP", appID+"/failing")
+	a.So(err, ShouldBeNil)
+	a.So(length, ShouldEqual, 0)
+	a.So(atomic.LoadInt32(&failingPosts), ShouldEqual, 2)
+	a.So(atomic.LoadInt32(&okPosts), ShouldEqual, 1)
+}
+
+func TestWebhookHeaderRedaction(t *testing.T) {
+	a := New(t)
+	existing := application.Webhook{
+		ID:        "test",
+		UplinkURL: "https://example.com/uplink",
+		Headers:   map[string]string{"Authorization": "secret"},
+	}
+	res := webhookToPb("app", existing)
+	a.So(res.Headers, ShouldResemble, map[string]string{"Authorization": redactedHeader})
+
+	// Setting the redacted values keeps the existing values
+	res.Headers["X-Unknown"] = redactedHeader
+	res.Headers["X-Other"] = "value"
+	webhook := webhookFromPb(res)
+	keepRedactedHeaders(webhook, &existing)
+	a.So(webhook.Headers, ShouldResemble, map[string]string{"Authorization": "secret", "X-Other": "value"})
+}
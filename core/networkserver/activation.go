@@ -12,6 +12,7 @@ import (
 	pb_handler "github.com/TheThingsNetwork/api/handler"
 	"github.com/TheThingsNetwork/api/trace"
 	"github.com/TheThingsNetwork/go-utils/pseudorandom"
+	"github.com/TheThingsNetwork/ttn/core/band"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
@@ -147,6 +148,23 @@ func (n *networkServer) HandleActivate(activation *pb_handler.DeviceActivationRe
 		dev.ADR.Band = band
 	}
 
+	// The device starts with the default channels of the band and the channels of the CFList
+	dev.Channels = device.ChannelSettings{Band: dev.ADR.Band, Override: dev.Channels.Override}
+	if lorawan.CFList != nil {
+		dev.Channels.Confirmed = cfListChannels(dev.Channels.Band, lorawan.CFList.Freq)
+	}
+
+	// The device uses the receive window settings of the JoinAccept
+	dev.RX = device.RXState{Desired: dev.RX.Desired}
+	if fp, err := band.Get(dev.Channels.Band); err == nil {
+		rx := defaultRXSettings(fp)
+		rx.RX1DROffset, rx.RX2DataRate = int(lorawan.Rx1DROffset), int(lorawan.Rx2DR)
+		if lorawan.RxDelay != 0 {
+			rx.RX1Delay = int(lorawan.RxDelay)
+		}
+		setCurrentRXSettings(dev, rx)
+	}
+
 	err = n.devices.Set(dev)
 	if err != nil {
 		return nil, err
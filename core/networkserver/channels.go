@@ -0,0 +1,333 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/brocaar/lorawan"
+	lora "github.com/brocaar/lorawan/band"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// MaxChannelAttempts is the number of times the NetworkServer tries to configure the channels of a device. After
+// that, it stops sending channel requests until the device is activated again.
+var MaxChannelAttempts = 3
+
+// maxFOptsLength is the maximum length of the MAC commands in the FOpts of a frame
+const maxFOptsLength = 15
+
+func bandChannel(fp band.FrequencyPlan, index int, ch lora.Channel) device.Channel {
+	channel := device.Channel{Index: index, Frequency: ch.Frequency}
+	for i, dr := range ch.DataRates {
+		if i == 0 || dr < channel.MinDataRate {
+			channel.MinDataRate = dr
+		}
+		if i == 0 || dr > channel.MaxDataRate {
+			channel.MaxDataRate = dr
+		}
+	}
+	if index < len(fp.DownlinkChannels) && fp.DownlinkChannels[index].Frequency != ch.Frequency {
+		channel.DownlinkFrequency = fp.DownlinkChannels[index].Frequency
+	}
+	return channel
+}
+
+// desiredChannels returns the channels that the device should use next to the default channels of its band
+func desiredChannels(dev *device.Device) ([]device.Channel, error) {
+	if len(dev.Channels.Override) > 0 {
+		return dev.Channels.Override, nil
+	}
+	if dev.Channels.Band == "" {
+		return nil, nil
+	}
+	fp, err := band.Get(dev.Channels.Band)
+	if err != nil {
+		return nil, err
+	}
+	if fp.DefaultChannels == 0 {
+		return nil, nil
+	}
+	var channels []device.Channel
+	for i, ch := range fp.UplinkChannels {
+		if i < fp.DefaultChannels || len(ch.DataRates) <= 1 { // ignore FSK channels
+			continue
+		}
+		channels = append(channels, bandChannel(fp, i, ch))
+	}
+	return channels, nil
+}
+
+// cfListChannels returns the channels that a device received in the CFList of its JoinAccept
+func cfListChannels(bandName string, cfList []uint32) []device.Channel {
+	fp, err := band.Get(bandName)
+	if err != nil || fp.DefaultChannels == 0 {
+		return nil
+	}
+	var channels []device.Channel
+	for i, frequency := range cfList {
+		if frequency == 0 {
+			continue
+		}
+		index := fp.DefaultChannels + i
+		if index < len(fp.UplinkChannels) && fp.UplinkChannels[index].Frequency == int(frequency) {
+			channels = append(channels, bandChannel(fp, index, fp.UplinkChannels[index]))
+			continue
+		}
+		// Channels from the CFList use the data rates of the default channels
+		channel := bandChannel(fp, index, fp.UplinkChannels[0])
+		channel.Frequency, channel.DownlinkFrequency = int(frequency), 0
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// maxChannels is the maximum number of channels of a device, which is the number of channel indexes in a
+// NewChannelReq
+const maxChannels = 16
+
+// validateChannels checks the channels that a device should use next to the default channels of its band
+func validateChannels(dev *device.Device, channels []device.Channel) error {
+	var defaultChannels int
+	if dev.Channels.Band != "" {
+		fp, err := band.Get(dev.Channels.Band)
+		if err != nil {
+			return err
+		}
+		defaultChannels = fp.DefaultChannels
+	}
+	indexes := make(map[int]bool, len(channels))
+	for _, ch := range channels {
+		if ch.Index < defaultChannels || ch.Index >= maxChannels {
+			return errors.NewErrInvalidArgument("Channel index", fmt.Sprintf("must be between %d and %d", defaultChannels, maxChannels-1))
+		}
+		if indexes[ch.Index] {
+			return errors.NewErrInvalidArgument("Channel index", fmt.Sprintf("%d is used more than once", ch.Index))
+		}
+		indexes[ch.Index] = true
+		if ch.Frequency < 0 || ch.DownlinkFrequency < 0 {
+			return errors.NewErrInvalidArgument("Channel frequency", "can not be negative")
+		}
+		if ch.MinDataRate < 0 || ch.MaxDataRate > 15 || ch.MinDataRate > ch.MaxDataRate {
+			return errors.NewErrInvalidArgument("Channel data rates", "must be between 0 and 15, with the minimum below the maximum")
+		}
+	}
+	return nil
+}
+
+// channelRequests returns the channels that have to be configured (or disabled) to get from the confirmed to the
+// desired channels
+func channelRequests(confirmed, desired []device.Channel) (requests []device.Channel) {
+	confirmedByIndex := make(map[int]device.Channel, len(confirmed))
+	for _, ch := range confirmed {
+		confirmedByIndex[ch.Index] = ch
+	}
+	desiredIndexes := make(map[int]bool, len(desired))
+	for _, ch := range desired {
+		desiredIndexes[ch.Index] = true
+		if existing, ok := confirmedByIndex[ch.Index]; !ok || existing != ch {
+			requests = append(requests, ch)
+		}
+	}
+	for _, ch := range confirmed {
+		if !desiredIndexes[ch.Index] {
+			requests = append(requests, device.Channel{Index: ch.Index})
+		}
+	}
+	return
+}
+
+// channelCommands returns the NewChannelReq (and DlChannelReq) for the channel
+func channelCommands(ch device.Channel) []pb_lorawan.MACCommand {
+	newChannelReq := &lorawan.NewChannelReqPayload{
+		ChIndex: uint8(ch.Index),
+		Freq:    uint32(ch.Frequency),
+		MinDR:   uint8(ch.MinDataRate),
+		MaxDR:   uint8(ch.MaxDataRate),
+	}
+	newChannelPayload, _ := newChannelReq.MarshalBinary()
+	commands := []pb_lorawan.MACCommand{{CID: uint32(lorawan.NewChannelReq), Payload: newChannelPayload}}
+	if ch.Frequency != 0 && ch.DownlinkFrequency != 0 {
+		frequency := ch.DownlinkFrequency / 100
+		commands = append(commands, pb_lorawan.MACCommand{
+			CID:     dlChannelReq,
+			Payload: []byte{uint8(ch.Index), byte(frequency), byte(frequency >> 8), byte(frequency >> 16)},
+		})
+	}
+	return commands
+}
+
+// confirmChannel stores that the device acknowledged the channel
+func confirmChannel(dev *device.Device, ch device.Channel) {
+	confirmed := make([]device.Channel, 0, len(dev.Channels.Confirmed)+1)
+	for _, existing := range dev.Channels.Confirmed {
+		if existing.Index != ch.Index {
+			confirmed = append(confirmed, existing)
+		}
+	}
+	if ch.Frequency != 0 {
+		confirmed = append(confirmed, ch)
+	}
+	dev.Channels.Confirmed = confirmed
+}
+
+// handleChannelAnswers handles the NewChannelAns and DlChannelAns MAC commands of an uplink, which are in the
+// same order as the requests in the last downlink. It returns false if the device rejected any of the requests,
+// or did not answer them.
+func handleChannelAnswers(dev *device.Device, newChannelAnswers, dlChannelAnswers []bool) bool {
+	if len(dev.Channels.Pending) == 0 {
+		return true
+	}
+	failed := false
+	var dlChannelIndex int
+	for i, ch := range dev.Channels.Pending {
+		ok := i < len(newChannelAnswers) && newChannelAnswers[i]
+		if ch.Frequency != 0 && ch.DownlinkFrequency != 0 {
+			ok = ok && dlChannelIndex < len(dlChannelAnswers) && dlChannelAnswers[dlChannelIndex]
+			dlChannelIndex++
+		}
+		if ok {
+			confirmChannel(dev, ch)
+		} else {
+			failed = true
+		}
+	}
+	dev.Channels.Pending = nil
+	if failed {
+		dev.Channels.Failed++
+		return false
+	}
+	dev.Channels.Failed = 0
+	return true
+}
+
+// handleDownlinkChannels adds NewChannelReq and DlChannelReq MAC commands for the channels of the device that
+// are not configured yet, as far as they fit in the FOpts
+func (n *networkServer) handleDownlinkChannels(message *pb_broker.DownlinkMessage, dev *device.Device) error {
+	if len(dev.Channels.Pending) != 0 {
+		// The device answers the requests of the last downlink in its next uplink
+		return nil
+	}
+	if dev.Channels.Failed >= MaxChannelAttempts {
+		return nil
+	}
+
+	desired, err := desiredChannels(dev)
+	if err != nil {
+		return err
+	}
+	requests := channelRequests(dev.Channels.Confirmed, desired)
+	if len(requests) == 0 {
+		return nil
+	}
+
+	lorawanDownlinkMAC := message.GetMessage().GetLoRaWAN().GetMACPayload()
+	var length int
+	for _, cmd := range lorawanDownlinkMAC.FOpts {
+		length += 1 + len(cmd.Payload)
+	}
+	for _, ch := range requests {
+		commands := channelCommands(ch)
+		var commandsLength int
+		for _, cmd := range commands {
+			commandsLength += 1 + len(cmd.Payload)
+		}
+		if length+commandsLength > maxFOptsLength {
+			break
+		}
+		length += commandsLength
+		lorawanDownlinkMAC.FOpts = append(lorawanDownlinkMAC.FOpts, commands...)
+		dev.Channels.Pending = append(dev.Channels.Pending, ch)
+	}
+
+	return nil
+}
+
+func channelsToPb(channels []device.Channel) []*pb_manager.Channel {
+	res := make([]*pb_manager.Channel, 0, len(channels))
+	for _, ch := range channels {
+		res = append(res, &pb_manager.Channel{
+			Index:             uint32(ch.Index),
+			Frequency:         uint64(ch.Frequency),
+			DownlinkFrequency: uint64(ch.DownlinkFrequency),
+			MinDataRate:       uint32(ch.MinDataRate),
+			MaxDataRate:       uint32(ch.MaxDataRate),
+		})
+	}
+	return res
+}
+
+func channelsFromPb(channels []*pb_manager.Channel) []device.Channel {
+	if len(channels) == 0 {
+		return nil
+	}
+	res := make([]device.Channel, 0, len(channels))
+	for _, ch := range channels {
+		res = append(res, device.Channel{
+			Index:             int(ch.Index),
+			Frequency:         int(ch.Frequency),
+			DownlinkFrequency: int(ch.DownlinkFrequency),
+			MinDataRate:       int(ch.MinDataRate),
+			MaxDataRate:       int(ch.MaxDataRate),
+		})
+	}
+	return res
+}
+
+// setChannelOverride sets the channels that the device should use instead of the extra channels of its band, or
+// resets the device to the channels of its band if channels is empty. The attempts to configure the channels start
+// over.
+func setChannelOverride(dev *device.Device, channels []device.Channel) error {
+	if err := validateChannels(dev, channels); err != nil {
+		return err
+	}
+	dev.Channels.Override = channels
+	dev.Channels.Failed = 0
+	return nil
+}
+
+func (n *networkServerManager) GetChannels(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceChannels, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	dev, err := n.getDevice(ctx, deviceIdentifier(in.AppEUI, in.DevEUI))
+	if err != nil {
+		return nil, err
+	}
+	return &pb_manager.NetworkServerDeviceChannels{
+		AppEUI:    in.AppEUI,
+		DevEUI:    in.DevEUI,
+		Band:      dev.Channels.Band,
+		Override:  channelsToPb(dev.Channels.Override),
+		Confirmed: channelsToPb(dev.Channels.Confirmed),
+	}, nil
+}
+
+func (n *networkServerManager) SetChannels(ctx context.Context, in *pb_manager.NetworkServerDeviceChannels) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Channels")
+	}
+	id := deviceIdentifier(in.AppEUI, in.DevEUI)
+	unlock := n.lockDevice(id)
+	defer unlock()
+	dev, err := n.getDevice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+	if err := setChannelOverride(dev, channelsFromPb(in.Override)); err != nil {
+		return nil, err
+	}
+	if err := n.networkServer.devices.Set(dev); err != nil {
+		return nil, errors.Wrap(err, "Could not update channels")
+	}
+	return &gogo.Empty{}, nil
+}
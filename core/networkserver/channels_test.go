@@ -0,0 +1,188 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestDesiredChannels(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	channels, err := desiredChannels(dev)
+	a.So(err, ShouldBeNil)
+	a.So(channels, ShouldBeEmpty)
+
+	dev.Channels.Band = "US_902_928"
+	channels, err = desiredChannels(dev)
+	a.So(err, ShouldBeNil)
+	a.So(channels, ShouldBeEmpty)
+
+	dev.Channels.Band = "EU_863_870"
+	channels, err = desiredChannels(dev)
+	a.So(err, ShouldBeNil)
+	a.So(channels, ShouldHaveLength, 5) // FSK channel is ignored
+	a.So(channels[0], ShouldResemble, device.Channel{Index: 3, Frequency: 867100000, MinDataRate: 0, MaxDataRate: 5})
+
+	dev.Channels.Override = []device.Channel{{Index: 3, Frequency: 868900000, MaxDataRate: 5}}
+	channels, err = desiredChannels(dev)
+	a.So(err, ShouldBeNil)
+	a.So(channels, ShouldResemble, dev.Channels.Override)
+}
+
+func TestCFListChannels(t *testing.T) {
+	a := New(t)
+	channels := cfListChannels("EU_863_870", []uint32{867100000, 867300000, 867500000, 867700000, 869000000})
+	a.So(channels, ShouldHaveLength, 5)
+	a.So(channels[0], ShouldResemble, device.Channel{Index: 3, Frequency: 867100000, MinDataRate: 0, MaxDataRate: 5})
+	a.So(channels[4], ShouldResemble, device.Channel{Index: 7, Frequency: 869000000, MinDataRate: 0, MaxDataRate: 5})
+
+	a.So(cfListChannels("US_902_928", []uint32{867100000}), ShouldBeEmpty)
+}
+
+func TestChannelRequests(t *testing.T) {
+	a := New(t)
+	ch3 := device.Channel{Index: 3, Frequency: 867100000, MaxDataRate: 5}
+	ch4 := device.Channel{Index: 4, Frequency: 867300000, MaxDataRate: 5}
+	ch5 := device.Channel{Index: 5, Frequency: 867500000, MaxDataRate: 5}
+
+	a.So(channelRequests([]device.Channel{ch3, ch4}, []device.Channel{ch3, ch4}), ShouldBeEmpty)
+	a.So(channelRequests([]device.Channel{ch3}, []device.Channel{ch3, ch4}), ShouldResemble, []device.Channel{ch4})
+
+	changed := ch4
+	changed.DownlinkFrequency = 869525000
+	a.So(channelRequests([]device.Channel{ch3, ch4}, []device.Channel{ch3, changed}), ShouldResemble, []device.Channel{changed})
+
+	// Channels that are not desired are disabled
+	a.So(channelRequests([]device.Channel{ch3, ch5}, []device.Channel{ch3}), ShouldResemble, []device.Channel{{Index: 5}})
+}
+
+func TestChannelCommands(t *testing.T) {
+	a := New(t)
+
+	commands := channelCommands(device.Channel{Index: 3, Frequency: 867100000, MaxDataRate: 5})
+	a.So(commands, ShouldHaveLength, 1)
+	a.So(commands[0].CID, ShouldEqual, uint32(lorawan.NewChannelReq))
+	var newChannelReq lorawan.NewChannelReqPayload
+	a.So(newChannelReq.UnmarshalBinary(commands[0].Payload), ShouldBeNil)
+	a.So(newChannelReq, ShouldResemble, lorawan.NewChannelReqPayload{ChIndex: 3, Freq: 867100000, MaxDR: 5})
+
+	commands = channelCommands(device.Channel{Index: 3, Frequency: 867100000, MaxDataRate: 5, DownlinkFrequency: 869525000})
+	a.So(commands, ShouldHaveLength, 2)
+	a.So(commands[1].CID, ShouldEqual, dlChannelReq)
+	a.So(commands[1].Payload, ShouldResemble, []byte{0x03, 0xD2, 0xAD, 0x84}) // 8695250 = 0x84ADD2
+}
+
+func TestValidateChannels(t *testing.T) {
+	a := New(t)
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+	a.So(validateChannels(dev, []device.Channel{{Index: 3, Frequency: 868900000, MaxDataRate: 5}}), ShouldBeNil)
+	a.So(validateChannels(dev, []device.Channel{{Index: 1, Frequency: 868900000, MaxDataRate: 5}}), ShouldNotBeNil)
+	a.So(validateChannels(dev, []device.Channel{{Index: 16, Frequency: 868900000, MaxDataRate: 5}}), ShouldNotBeNil)
+	a.So(validateChannels(dev, []device.Channel{{Index: 3, Frequency: 868900000, MinDataRate: 5, MaxDataRate: 3}}), ShouldNotBeNil)
+	a.So(validateChannels(dev, []device.Channel{{Index: 3}, {Index: 3}}), ShouldNotBeNil)
+}
+
+func TestHandleChannels(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleChannels"),
+		},
+	}
+
+	downlink := func() *pb_broker.DownlinkMessage {
+		message := &pb_broker.DownlinkMessage{Message: new(pb_protocol.Message)}
+		message.Message.InitLoRaWAN().InitDownlink()
+		return message
+	}
+
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+	dev.Channels.Confirmed = cfListChannels("EU_863_870", []uint32{867100000, 867300000, 867500000})
+
+	// The missing channels are requested, as far as they fit in the FOpts
+	message := downlink()
+	err := ns.handleDownlinkChannels(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldHaveLength, 2)
+	a.So(dev.Channels.Pending, ShouldHaveLength, 2)
+	a.So(dev.Channels.Pending[0].Index, ShouldEqual, 6)
+	a.So(dev.Channels.Pending[1].Index, ShouldEqual, 7)
+
+	// Nothing is requested until the device answers in an uplink
+	message = downlink()
+	err = ns.handleDownlinkChannels(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+	a.So(dev.Channels.Pending, ShouldHaveLength, 2)
+	a.So(dev.Channels.Failed, ShouldEqual, 0)
+
+	// A negative answer is retried
+	a.So(handleChannelAnswers(dev, []bool{true, false}, nil), ShouldBeFalse)
+	a.So(dev.Channels.Failed, ShouldEqual, 1)
+	a.So(dev.Channels.Confirmed, ShouldHaveLength, 4)
+
+	message = downlink()
+	err = ns.handleDownlinkChannels(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.Channels.Pending, ShouldHaveLength, 1)
+	a.So(dev.Channels.Pending[0].Index, ShouldEqual, 7)
+
+	a.So(handleChannelAnswers(dev, []bool{true}, nil), ShouldBeTrue)
+	a.So(dev.Channels.Failed, ShouldEqual, 0)
+	a.So(dev.Channels.Confirmed, ShouldHaveLength, 5)
+
+	// Nothing is requested when all channels are configured
+	message = downlink()
+	err = ns.handleDownlinkChannels(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+
+	// Requests without answer are retried until the maximum number of attempts
+	dev.Channels.Override = []device.Channel{{Index: 3, Frequency: 868900000, MaxDataRate: 5}}
+	for i := 0; i < MaxChannelAttempts; i++ {
+		message = downlink()
+		err = ns.handleDownlinkChannels(message, dev)
+		a.So(err, ShouldBeNil)
+		a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldNotBeEmpty)
+		a.So(handleChannelAnswers(dev, nil, nil), ShouldBeFalse)
+	}
+	message = downlink()
+	err = ns.handleDownlinkChannels(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+	a.So(dev.Channels.Failed, ShouldEqual, MaxChannelAttempts)
+}
+
+func TestSetChannelOverride(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+	dev.Channels.Failed = MaxChannelAttempts
+
+	override := channelsFromPb([]*pb_manager.Channel{{Index: 3, Frequency: 868900000, MaxDataRate: 5}})
+	a.So(setChannelOverride(dev, override), ShouldBeNil)
+	a.So(dev.Channels.Override, ShouldResemble, []device.Channel{{Index: 3, Frequency: 868900000, MaxDataRate: 5}})
+	a.So(dev.Channels.Failed, ShouldEqual, 0)
+	a.So(channelsToPb(dev.Channels.Override), ShouldResemble, []*pb_manager.Channel{{Index: 3, Frequency: 868900000, MaxDataRate: 5}})
+
+	override = channelsFromPb([]*pb_manager.Channel{{Index: 0, Frequency: 868900000, MaxDataRate: 5}})
+	a.So(setChannelOverride(dev, override), ShouldNotBeNil)
+
+	a.So(setChannelOverride(dev, channelsFromPb(nil)), ShouldBeNil)
+	a.So(dev.Channels.Override, ShouldBeEmpty)
+}
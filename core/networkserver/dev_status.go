@@ -0,0 +1,75 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"time"
+
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// parseDevStatusInterval parses the status interval of a device
+func parseDevStatusInterval(input string) (time.Duration, error) {
+	if input == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(input)
+	if err != nil {
+		return 0, errors.NewErrInvalidArgument("Interval", err.Error())
+	}
+	return interval, nil
+}
+
+func buildDevStatus(dev *device.Device) *pb_manager.NetworkServerDeviceStatus {
+	status := new(pb_manager.NetworkServerDeviceStatus)
+	if dev.DevStatus.Interval != 0 {
+		status.Interval = dev.DevStatus.Interval.String()
+	}
+	if !dev.DevStatus.UpdatedAt.IsZero() {
+		status.Battery = uint32(dev.DevStatus.Battery)
+		status.Margin = int32(dev.DevStatus.Margin)
+		status.UpdatedAt = dev.DevStatus.UpdatedAt.UnixNano()
+	}
+	return status
+}
+
+func (n *networkServerManager) GetDevStatus(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceStatus, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	dev, err := n.getDevice(ctx, deviceIdentifier(in.AppEUI, in.DevEUI))
+	if err != nil {
+		return nil, err
+	}
+	status := buildDevStatus(dev)
+	status.AppEUI, status.DevEUI = in.AppEUI, in.DevEUI
+	return status, nil
+}
+
+func (n *networkServerManager) SetDevStatus(ctx context.Context, in *pb_manager.NetworkServerDeviceStatus) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Status")
+	}
+	interval, err := parseDevStatusInterval(in.Interval)
+	if err != nil {
+		return nil, err
+	}
+	id := deviceIdentifier(in.AppEUI, in.DevEUI)
+	unlock := n.lockDevice(id)
+	defer unlock()
+	dev, err := n.getDevice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+	dev.DevStatus.Interval = interval
+	if err := n.networkServer.devices.Set(dev); err != nil {
+		return nil, errors.Wrap(err, "Could not update status interval")
+	}
+	return &gogo.Empty{}, nil
+}
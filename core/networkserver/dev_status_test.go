@@ -0,0 +1,43 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParseDevStatusInterval(t *testing.T) {
+	a := New(t)
+
+	interval, err := parseDevStatusInterval("1h")
+	a.So(err, ShouldBeNil)
+	a.So(interval, ShouldEqual, time.Hour)
+
+	interval, err = parseDevStatusInterval("")
+	a.So(err, ShouldBeNil)
+	a.So(interval, ShouldEqual, 0)
+
+	_, err = parseDevStatusInterval("often")
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestBuildDevStatus(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	a.So(buildDevStatus(dev).Interval, ShouldBeEmpty)
+	a.So(buildDevStatus(dev).UpdatedAt, ShouldEqual, 0)
+
+	updatedAt := time.Now()
+	dev.DevStatus = device.DevStatus{Interval: time.Hour, Battery: 254, Margin: -5, UpdatedAt: updatedAt}
+	status := buildDevStatus(dev)
+	a.So(status.Interval, ShouldEqual, "1h0m0s")
+	a.So(status.Battery, ShouldEqual, 254)
+	a.So(status.Margin, ShouldEqual, -5)
+	a.So(status.UpdatedAt, ShouldEqual, updatedAt.UnixNano())
+}
@@ -32,9 +32,28 @@ type Device struct {
 	NwkSKey  types.NwkSKey `redis:"nwk_s_key"`
 	FCntUp   uint32        `redis:"f_cnt_up"`
 	FCntDown uint32        `redis:"f_cnt_down"`
-	LastSeen time.Time     `redis:"last_seen"`
-	Options  Options       `redis:"options"`
-	ADR      ADRSettings   `redis:"adr,include"`
+
+	// ConfirmedDownlinkPending indicates that a confirmed downlink with FCntDown was sent, but not acknowledged yet
+	ConfirmedDownlinkPending bool `redis:"confirmed_downlink_pending"`
+
+	LastSeen time.Time   `redis:"last_seen"`
+	Options  Options     `redis:"options"`
+	ADR      ADRSettings `redis:"adr,include"`
+
+	// DownlinkGatewayID is the gateway of the best downlink option of the last uplink, which is used for downlink
+	// that is not a response to an uplink (Class B and C)
+	DownlinkGatewayID string `redis:"downlink_gateway_id"`
+
+	// Class is the class of the device, which is set with the NetworkServerManager
+	Class types.DeviceClass `redis:"class"`
+
+	ClassB ClassBSettings `redis:"class_b,include"`
+
+	DevStatus DevStatus `redis:"dev_status,include"`
+
+	Channels ChannelSettings `redis:"channels,include"`
+
+	RX RXState `redis:"rx,include"`
 
 	CreatedAt time.Time `redis:"created_at"`
 	UpdatedAt time.Time `redis:"updated_at"`
@@ -55,6 +74,79 @@ type ADRSettings struct {
 	NbTrans  int    `redis:"nb_trans,omitempty"`
 }
 
+// ClassBSettings contains the Class B state of a device
+type ClassBSettings struct {
+	// Indicates whether the device listens to ping slots, which it reports in the FCtrl of its uplink
+	Enabled bool `redis:"enabled"`
+
+	// The ping slot periodicity and data rate (index) that the device reported in a PingSlotInfoReq
+	PingSlotInfo        bool `redis:"ping_slot_info"`
+	PingSlotPeriodicity int  `redis:"ping_slot_periodicity"`
+	PingSlotDataRate    int  `redis:"ping_slot_data_rate"`
+}
+
+// DevStatus contains the status that the device reported in its last DevStatusAns, and when it is requested
+type DevStatus struct {
+	// Interval at which the NetworkServer sends a DevStatusReq; the default interval is used if 0, and
+	// no DevStatusReq is sent if it is negative
+	Interval    time.Duration `redis:"interval,omitempty"`
+	RequestedAt time.Time     `redis:"requested_at"`
+
+	// Battery level (0: external power source, 1-254: battery level, 255: unknown) and demodulation margin (in dB)
+	// that the device reported at UpdatedAt
+	Battery   int       `redis:"battery"`
+	Margin    int       `redis:"margin"`
+	UpdatedAt time.Time `redis:"updated_at"`
+}
+
+// Channel is an uplink channel of a device. The data rates are indexes in the data rates of the band.
+type Channel struct {
+	Index             int `json:"index"`
+	Frequency         int `json:"frequency"` // A channel with frequency 0 is disabled
+	MinDataRate       int `json:"min_data_rate"`
+	MaxDataRate       int `json:"max_data_rate"`
+	DownlinkFrequency int `json:"downlink_frequency,omitempty"` // Only if the device receives RX1 on another frequency
+}
+
+// ChannelSettings contains the (desired) extra channels of a device, which the NetworkServer configures with
+// NewChannelReq and DlChannelReq MAC commands
+type ChannelSettings struct {
+	// The band of the device, of which the extra channels are used if there is no Override
+	Band     string    `redis:"band"`
+	Override []Channel `redis:"override"`
+
+	// The channels that the device acknowledged or received in the CFList of the JoinAccept
+	Confirmed []Channel `redis:"confirmed"`
+
+	// The channels that were requested in the last downlink, but were not answered yet
+	Pending []Channel `redis:"pending"`
+	Failed  int       `redis:"failed,omitempty"` // number of failed attempts
+}
+
+// RXSettings contains the settings of the receive windows of a device. The data rates are indexes in the data
+// rates of the band.
+type RXSettings struct {
+	RX1DROffset  int `json:"rx1_dr_offset"`
+	RX2DataRate  int `json:"rx2_data_rate"`
+	RX2Frequency int `json:"rx2_frequency"`
+	RX1Delay     int `json:"rx1_delay"` // in seconds; RX2 opens one second later
+}
+
+// RXState contains the (desired) receive window settings of a device, which the NetworkServer configures with
+// RXParamSetupReq and RXTimingSetupReq MAC commands
+type RXState struct {
+	// The settings that the device uses, or nil if these are the defaults of the frequency plan. They are set by
+	// the JoinAccept, and by the MAC commands when the device acknowledged them.
+	Current *RXSettings `redis:"current"`
+	// The settings that the device should use, or nil to keep the current settings
+	Desired *RXSettings `redis:"desired"`
+
+	// Indicates that a RXParamSetupReq or RXTimingSetupReq was sent, but not answered yet
+	ParamSetupPending  bool `redis:"param_setup_pending,omitempty"`
+	TimingSetupPending bool `redis:"timing_setup_pending,omitempty"`
+	Failed             int  `redis:"failed,omitempty"` // number of failed attempts
+}
+
 // StartUpdate stores the state of the device
 func (d *Device) StartUpdate() {
 	old := *d
@@ -4,12 +4,24 @@
 package networkserver
 
 import (
+	"time"
+
 	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/api/trace"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/classb"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/brocaar/lorawan"
 )
 
+// PingSlotDelay is the minimum time until the ping slot of a Class B downlink, which leaves time to send the
+// downlink to the gateway
+var PingSlotDelay = 2 * time.Second
+
 func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_broker.DownlinkMessage, error) {
 	err := message.UnmarshalPayload()
 	if err != nil {
@@ -22,6 +34,9 @@ func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_
 
 	n.status.downlink.Mark(1)
 
+	unlock := n.lockDevice(*message.AppEUI, *message.DevEUI)
+	defer unlock()
+
 	// Get Device
 	dev, err := n.devices.Get(*message.AppEUI, *message.DevEUI)
 	if err != nil {
@@ -49,13 +64,49 @@ func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_
 		return nil, errors.NewErrInvalidArgument("Downlink", "DevAddr does not match device")
 	}
 
+	// Downlink without a downlink option identifier is not a response to an uplink, and is sent through the gateway
+	// that last received an uplink from the device: on the RX2 parameters of the device for Class C devices, or in
+	// a ping slot of the device for Class B devices.
+	if option := message.DownlinkOption; option != nil && option.Identifier == "" {
+		if dev.DownlinkGatewayID == "" {
+			return nil, errors.NewErrNotFound("Gateway for downlink that is not a response to an uplink")
+		}
+		switch dev.Class {
+		case types.ClassC:
+			if err = setRX2Configuration(option, dev); err != nil {
+				return nil, err
+			}
+		case types.ClassB:
+			if err = setPingSlotConfiguration(option, dev, time.Now().Add(PingSlotDelay)); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.NewErrInvalidArgument("Downlink", "device only receives downlink after an uplink")
+		}
+		option.GatewayID = dev.DownlinkGatewayID
+	}
+
 	err = n.handleDownlinkMAC(message, dev)
 	if err != nil {
 		return nil, err
 	}
 
+	// The Handler uses the FCnt after that of a pending confirmed downlink for a downlink that is not a
+	// retransmission of it, which means that the pending confirmed downlink was given up or replaced
+	if dev.ConfirmedDownlinkPending && lorawanDownlinkMAC.FCnt&0xffff == (dev.FCntDown+1)&0xffff {
+		dev.FCntDown++
+		dev.ConfirmedDownlinkPending = false
+	}
+
 	lorawanDownlinkMAC.FCnt = dev.FCntDown // Use full 32-bit FCnt for setting MIC
-	dev.FCntDown++                         // TODO: For confirmed downlink, FCntDown should be incremented AFTER ACK
+
+	// For confirmed downlink, FCntDown is incremented after the ACK, so that retransmissions use the same FCnt
+	if message.Message.GetLoRaWAN().MType == pb_lorawan.MType_CONFIRMED_DOWN {
+		dev.ConfirmedDownlinkPending = true
+	} else {
+		dev.FCntDown++
+		dev.ConfirmedDownlinkPending = false
+	}
 
 	phyPayload := message.Message.GetLoRaWAN().PHYPayload()
 	phyPayload.SetMIC(lorawan.AES128Key(dev.NwkSKey))
@@ -67,3 +118,29 @@ func (n *networkServer) HandleDownlink(message *pb_broker.DownlinkMessage) (*pb_
 
 	return message, nil
 }
+
+// setPingSlotConfiguration sets the ping slot data rate of the device on the downlink option, and sets the Deadline
+// of the option to the start of the first ping slot of the device after t. The Router sends the downlink in that
+// ping slot, on the ping slot frequency of the gateway.
+func setPingSlotConfiguration(option *pb_broker.DownlinkOption, dev *device.Device, t time.Time) error {
+	if !dev.ClassB.Enabled || !dev.ClassB.PingSlotInfo {
+		return errors.NewErrInvalidArgument("Downlink", "device does not listen to ping slots")
+	}
+	fp, err := band.Get(dev.Channels.Band)
+	if err != nil {
+		return err
+	}
+	if dev.ClassB.PingSlotDataRate >= len(fp.DataRates) {
+		return errors.NewErrInvalidArgument("Ping Slot Data Rate", "does not exist in the frequency plan")
+	}
+	if option.GetProtocolConfiguration().GetLoRaWAN() == nil {
+		option.ProtocolConfiguration = &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{
+			LoRaWAN: new(pb_lorawan.TxConfiguration),
+		}}
+	}
+	if err := option.ProtocolConfiguration.GetLoRaWAN().SetDataRate(fp.DataRates[dev.ClassB.PingSlotDataRate]); err != nil {
+		return err
+	}
+	option.Deadline = classb.NextPingSlot(dev.DevAddr, uint8(dev.ClassB.PingSlotPeriodicity), t).UnixNano()
+	return nil
+}
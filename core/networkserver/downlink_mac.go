@@ -12,5 +12,11 @@ func (n *networkServer) handleDownlinkMAC(message *pb_broker.DownlinkMessage, de
 	if err := n.handleDownlinkADR(message, dev); err != nil {
 		return err
 	}
+	if err := n.handleDownlinkChannels(message, dev); err != nil {
+		return err
+	}
+	if err := n.handleDownlinkRX(message, dev); err != nil {
+		return err
+	}
 	return nil
 }
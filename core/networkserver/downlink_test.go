@@ -5,12 +5,14 @@ package networkserver
 
 import (
 	"testing"
+	"time"
 
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	pb_protocol "github.com/TheThingsNetwork/api/protocol"
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/classb"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	"github.com/brocaar/lorawan"
 	. "github.com/smartystreets/assertions"
@@ -100,4 +102,196 @@ func TestHandleDownlink(t *testing.T) {
 	dev, _ := ns.devices.Get(appEUI, devEUI)
 	a.So(dev.FCntDown, ShouldEqual, 1)
 
+	// Confirmed downlink
+	phy.MHDR.MType = lorawan.ConfirmedDataDown
+	bytes, _ = phy.MarshalBinary()
+	for i := 0; i < 2; i++ {
+		message = &pb_broker.DownlinkMessage{
+			AppEUI:         &appEUI,
+			DevEUI:         &devEUI,
+			Payload:        bytes,
+			DownlinkOption: downlinkOption,
+		}
+		res, err = ns.HandleDownlink(message)
+		a.So(err, ShouldBeNil)
+		phyPayload.UnmarshalBinary(res.Payload)
+		macPayload, _ = phyPayload.MACPayload.(*lorawan.MACPayload)
+		a.So(macPayload.FHDR.FCnt, ShouldEqual, 1) // Retransmissions use the same FCnt
+	}
+
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.FCntDown, ShouldEqual, 1)
+	a.So(dev.ConfirmedDownlinkPending, ShouldBeTrue)
+
+	// The Handler gave up the confirmed downlink (NACK), and sends a new downlink with the next FCnt
+	phy.MHDR.MType = lorawan.UnconfirmedDataDown
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 2
+	bytes, _ = phy.MarshalBinary()
+	message = &pb_broker.DownlinkMessage{
+		AppEUI:         &appEUI,
+		DevEUI:         &devEUI,
+		Payload:        bytes,
+		DownlinkOption: downlinkOption,
+	}
+	res, err = ns.HandleDownlink(message)
+	a.So(err, ShouldBeNil)
+	phyPayload.UnmarshalBinary(res.Payload)
+	macPayload, _ = phyPayload.MACPayload.(*lorawan.MACPayload)
+	a.So(macPayload.FHDR.FCnt, ShouldEqual, 2)
+
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.FCntDown, ShouldEqual, 3)
+	a.So(dev.ConfirmedDownlinkPending, ShouldBeFalse)
+}
+
+func TestHandleClassCDownlink(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "test-handle-class-c-downlink"),
+	}
+	ns.InitStatus()
+
+	appEUI := types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	devEUI := types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	devAddr := getDevAddr(1, 2, 3, 4)
+
+	ns.devices.Set(&device.Device{
+		DevAddr:           devAddr,
+		AppEUI:            appEUI,
+		DevEUI:            devEUI,
+		DownlinkGatewayID: "gateway",
+	})
+	defer func() {
+		ns.devices.Delete(appEUI, devEUI)
+	}()
+
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataDown,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr(devAddr),
+			},
+		},
+	}
+	bytes, _ := phy.MarshalBinary()
+
+	// Class A devices only receive downlink after an uplink
+	_, err := ns.HandleDownlink(&pb_broker.DownlinkMessage{
+		AppEUI:         &appEUI,
+		DevEUI:         &devEUI,
+		Payload:        bytes,
+		DownlinkOption: &pb_broker.DownlinkOption{},
+	})
+	a.So(err, ShouldNotBeNil)
+
+	dev, _ := ns.devices.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	dev.Class = types.ClassC
+	ns.devices.Set(dev)
+
+	// The gateway of the last uplink is used for downlink without downlink option identifier
+	res, err := ns.HandleDownlink(&pb_broker.DownlinkMessage{
+		AppEUI:         &appEUI,
+		DevEUI:         &devEUI,
+		Payload:        bytes,
+		DownlinkOption: &pb_broker.DownlinkOption{},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.DownlinkOption.GatewayID, ShouldEqual, "gateway")
+	a.So(res.DownlinkOption.Identifier, ShouldBeEmpty)
+
+	// The downlink option of responses to uplink is not changed
+	res, err = ns.HandleDownlink(&pb_broker.DownlinkMessage{
+		AppEUI:         &appEUI,
+		DevEUI:         &devEUI,
+		Payload:        bytes,
+		DownlinkOption: &pb_broker.DownlinkOption{GatewayID: "other-gateway", Identifier: "other-router:id"},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.DownlinkOption.GatewayID, ShouldEqual, "other-gateway")
+	a.So(res.DownlinkOption.Identifier, ShouldEqual, "other-router:id")
+
+	// Downlink that is not a response to uplink uses the RX2 parameters of the device
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	dev.Channels.Band = "EU_863_870"
+	dev.RX.Current = &device.RXSettings{RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 2}
+	ns.devices.Set(dev)
+	res, err = ns.HandleDownlink(&pb_broker.DownlinkMessage{
+		AppEUI:         &appEUI,
+		DevEUI:         &devEUI,
+		Payload:        bytes,
+		DownlinkOption: &pb_broker.DownlinkOption{},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(res.DownlinkOption.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+	a.So(res.DownlinkOption.GatewayConfiguration.Frequency, ShouldEqual, 869525000)
+}
+
+func TestHandleClassBDownlink(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "test-handle-class-b-downlink"),
+	}
+	ns.InitStatus()
+
+	appEUI := types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	devEUI := types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8))
+	devAddr := getDevAddr(1, 2, 3, 4)
+
+	ns.devices.Set(&device.Device{
+		DevAddr:           devAddr,
+		AppEUI:            appEUI,
+		DevEUI:            devEUI,
+		DownlinkGatewayID: "gateway",
+		Class:             types.ClassB,
+	})
+	defer func() {
+		ns.devices.Delete(appEUI, devEUI)
+	}()
+
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataDown,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr(devAddr),
+			},
+		},
+	}
+	bytes, _ := phy.MarshalBinary()
+
+	buildDownlink := func() *pb_broker.DownlinkMessage {
+		return &pb_broker.DownlinkMessage{
+			AppEUI:         &appEUI,
+			DevEUI:         &devEUI,
+			Payload:        bytes,
+			DownlinkOption: &pb_broker.DownlinkOption{},
+		}
+	}
+
+	// The device does not listen to ping slots
+	_, err := ns.HandleDownlink(buildDownlink())
+	a.So(err, ShouldNotBeNil)
+
+	dev, _ := ns.devices.Get(appEUI, devEUI)
+	dev.StartUpdate()
+	dev.ClassB = device.ClassBSettings{Enabled: true, PingSlotInfo: true, PingSlotPeriodicity: 4, PingSlotDataRate: 3}
+	dev.Channels.Band = "EU_863_870"
+	ns.devices.Set(dev)
+
+	// The downlink is sent in the next ping slot of the device, on its ping slot data rate
+	res, err := ns.HandleDownlink(buildDownlink())
+	a.So(err, ShouldBeNil)
+	a.So(res.DownlinkOption.GatewayID, ShouldEqual, "gateway")
+	a.So(res.DownlinkOption.Identifier, ShouldBeEmpty)
+	a.So(res.DownlinkOption.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+	pingSlot := time.Unix(0, res.DownlinkOption.Deadline)
+	a.So(pingSlot, ShouldHappenAfter, time.Now().Add(PingSlotDelay-time.Second))
+	a.So(res.DownlinkOption.Deadline, ShouldEqual, classb.NextPingSlot(devAddr, 4, pingSlot.Add(-classb.SlotLength)).UnixNano())
 }
@@ -11,6 +11,18 @@ import (
 
 const macCMD = "cmd" // For Tracing
 
+// Class B MAC commands (LoRaWAN 1.0.2)
+const (
+	pingSlotInfoReq = 0x10
+	pingSlotInfoAns = 0x10
+)
+
+// Channel MAC commands that were added in LoRaWAN 1.0.2
+const (
+	dlChannelReq = 0x0A
+	dlChannelAns = 0x0A
+)
+
 type bySNR []*pb_gateway.RxMetadata
 
 func (a bySNR) Len() int           { return len(a) }
@@ -11,6 +11,7 @@ import (
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	"github.com/TheThingsNetwork/go-account-lib/claims"
 	"github.com/TheThingsNetwork/go-account-lib/rights"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/api/ratelimit"
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
@@ -154,6 +155,55 @@ func (n *networkServerManager) DeleteDevice(ctx context.Context, in *pb_lorawan.
 	return &gogo.Empty{}, nil
 }
 
+// deviceIdentifier converts the EUIs of a manager request, that were validated, to a DeviceIdentifier
+func deviceIdentifier(appEUI, devEUI []byte) *pb_lorawan.DeviceIdentifier {
+	id := &pb_lorawan.DeviceIdentifier{AppEUI: new(types.AppEUI), DevEUI: new(types.DevEUI)}
+	copy(id.AppEUI[:], appEUI)
+	copy(id.DevEUI[:], devEUI)
+	return id
+}
+
+// lockDevice locks the device of a manager request against changes by uplinks and downlinks, and returns the
+// function that unlocks it
+func (n *networkServerManager) lockDevice(id *pb_lorawan.DeviceIdentifier) (unlock func()) {
+	return n.networkServer.lockDevice(*id.AppEUI, *id.DevEUI)
+}
+
+func (n *networkServerManager) GetDeviceClass(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceClass, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	dev, err := n.getDevice(ctx, deviceIdentifier(in.AppEUI, in.DevEUI))
+	if err != nil {
+		return nil, err
+	}
+	class, _ := types.ParseDeviceClass(string(dev.Class))
+	return &pb_manager.NetworkServerDeviceClass{AppEUI: in.AppEUI, DevEUI: in.DevEUI, Class: string(class)}, nil
+}
+
+func (n *networkServerManager) SetDeviceClass(ctx context.Context, in *pb_manager.NetworkServerDeviceClass) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Class")
+	}
+	class, err := types.ParseDeviceClass(in.Class)
+	if err != nil {
+		return nil, err
+	}
+	id := deviceIdentifier(in.AppEUI, in.DevEUI)
+	unlock := n.lockDevice(id)
+	defer unlock()
+	dev, err := n.getDevice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+	dev.Class = class
+	if err := n.networkServer.devices.Set(dev); err != nil {
+		return nil, err
+	}
+	return &gogo.Empty{}, nil
+}
+
 func (n *networkServerManager) GetPrefixes(ctx context.Context, in *pb_lorawan.PrefixesRequest) (*pb_lorawan.PrefixesResponse, error) {
 	var mapping []*pb_lorawan.PrefixesResponse_PrefixMapping
 	for prefix, usage := range n.networkServer.prefixes {
@@ -200,4 +250,5 @@ func (n *networkServer) RegisterManager(s *grpc.Server) {
 	pb.RegisterNetworkServerManagerServer(s, server)
 	pb_lorawan.RegisterDeviceManagerServer(s, server)
 	pb_lorawan.RegisterDevAddrManagerServer(s, server)
+	pb_manager.RegisterNetworkServerManagerServer(s, server)
 }
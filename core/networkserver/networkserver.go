@@ -15,6 +15,7 @@ import (
 	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/TheThingsNetwork/ttn/utils/keylock"
 	"google.golang.org/grpc"
 	"gopkg.in/redis.v5"
 )
@@ -51,6 +52,13 @@ type networkServer struct {
 	prefixes      map[types.DevAddrPrefix][]string
 	status        *status
 	monitorStream monitorclient.Stream
+	deviceLocks   keylock.Locks
+}
+
+// lockDevice serializes the changes to the state of a device, which are made by uplinks, downlinks and the
+// management API. It returns the function that unlocks the device.
+func (n *networkServer) lockDevice(appEUI types.AppEUI, devEUI types.DevEUI) (unlock func()) {
+	return n.deviceLocks.Lock(appEUI.String() + ":" + devEUI.String())
 }
 
 func (n *networkServer) UsePrefix(prefix types.DevAddrPrefix, usage []string) error {
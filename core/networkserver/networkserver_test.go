@@ -5,8 +5,10 @@ package networkserver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
 	"gopkg.in/redis.v5"
 )
@@ -0,0 +1,355 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"fmt"
+	"time"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb "github.com/TheThingsNetwork/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/brocaar/lorawan"
+	gogo "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
+)
+
+// MaxRXAttempts is the number of times the NetworkServer tries to configure the receive windows of a device. After
+// that, it stops sending requests until the device is activated again.
+var MaxRXAttempts = 3
+
+// defaultRXSettings returns the receive window settings of the frequency plan, which the Router uses
+func defaultRXSettings(fp band.FrequencyPlan) device.RXSettings {
+	return device.RXSettings(fp.DefaultRXSettings())
+}
+
+// currentRXSettings returns the receive window settings that the device uses
+func currentRXSettings(dev *device.Device) (device.RXSettings, error) {
+	if dev.RX.Current != nil {
+		return *dev.RX.Current, nil
+	}
+	fp, err := band.Get(dev.Channels.Band)
+	if err != nil {
+		return device.RXSettings{}, err
+	}
+	return defaultRXSettings(fp), nil
+}
+
+// setCurrentRXSettings stores the receive window settings that the device uses
+func setCurrentRXSettings(dev *device.Device, rx device.RXSettings) {
+	if fp, err := band.Get(dev.Channels.Band); err == nil && rx == defaultRXSettings(fp) {
+		dev.RX.Current = nil
+		return
+	}
+	dev.RX.Current = &rx
+}
+
+// handleRXParamSetupAns handles a RXParamSetupAns. The device repeats the answer in its uplinks until it receives
+// a downlink, so only the first answer is used.
+func handleRXParamSetupAns(dev *device.Device, ok bool) error {
+	if !dev.RX.ParamSetupPending || dev.RX.Desired == nil {
+		return nil
+	}
+	dev.RX.ParamSetupPending = false
+	if !ok {
+		dev.RX.Failed++
+		return nil
+	}
+	rx, err := currentRXSettings(dev)
+	if err != nil {
+		return err
+	}
+	rx.RX1DROffset, rx.RX2DataRate, rx.RX2Frequency = dev.RX.Desired.RX1DROffset, dev.RX.Desired.RX2DataRate, dev.RX.Desired.RX2Frequency
+	setCurrentRXSettings(dev, rx)
+	dev.RX.Failed = 0
+	return nil
+}
+
+// handleRXTimingSetupAns handles a RXTimingSetupAns, which the device also repeats until it receives a downlink
+func handleRXTimingSetupAns(dev *device.Device) error {
+	if !dev.RX.TimingSetupPending || dev.RX.Desired == nil {
+		return nil
+	}
+	dev.RX.TimingSetupPending = false
+	rx, err := currentRXSettings(dev)
+	if err != nil {
+		return err
+	}
+	rx.RX1Delay = dev.RX.Desired.RX1Delay
+	if rx.RX1Delay == 0 {
+		rx.RX1Delay = 1
+	}
+	setCurrentRXSettings(dev, rx)
+	dev.RX.Failed = 0
+	return nil
+}
+
+// handleUnansweredRXRequests counts the RXParamSetupReq and RXTimingSetupReq of the last downlink that the device
+// did not answer in its uplink as a failed attempt. It returns false if there were such requests.
+func handleUnansweredRXRequests(dev *device.Device) bool {
+	if !dev.RX.ParamSetupPending && !dev.RX.TimingSetupPending {
+		return true
+	}
+	dev.RX.ParamSetupPending, dev.RX.TimingSetupPending = false, false
+	dev.RX.Failed++
+	return false
+}
+
+// handleUplinkRX checks that the downlink option of the response is in a receive window of the device. The Router
+// builds the option with the receive window settings of the frequency plan. If the device uses other settings, the
+// data rate, frequency and timestamp of the option are moved to the receive window of the device. The Router then
+// schedules the downlink at its timestamp instead of in the slot that it reserved for the option.
+func handleUplinkRX(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
+	option := message.GetResponseTemplate().GetDownlinkOption()
+	gatewayConfig := option.GetGatewayConfiguration()
+	lorawanConfig := option.GetProtocolConfiguration().GetLoRaWAN()
+	lorawanMeta := message.GetProtocolMetadata().GetLoRaWAN()
+	if gatewayConfig == nil || lorawanConfig == nil || lorawanMeta == nil {
+		return nil
+	}
+	var uplink *pb.RxMetadata
+	for _, gateway := range message.GetGatewayMetadata() {
+		if gateway.GatewayID == option.GatewayID {
+			uplink = gateway
+			break
+		}
+	}
+	if uplink == nil {
+		return nil
+	}
+	fp, err := band.Get(lorawanMeta.GetFrequencyPlan().String())
+	if err != nil {
+		return err
+	}
+
+	rx := defaultRXSettings(fp)
+	if dev.RX.Current != nil {
+		rx = *dev.RX.Current
+	}
+	if rx.RX1Delay == 0 {
+		rx.RX1Delay = 1 // A delay of 0 is the same as 1 second
+	}
+	rx1Delay := time.Duration(rx.RX1Delay) * time.Second
+
+	// The Router built the option for RX1 on the RX1 frequency of the uplink, or for RX2
+	var downDR int
+	frequency := gatewayConfig.Frequency
+	timestamp := uplink.Timestamp
+	if rx1Frequency, err := fp.GetRX1Frequency(int(uplink.Frequency)); err == nil && uint64(rx1Frequency) == gatewayConfig.Frequency {
+		dataRate, err := lorawanMeta.GetLoRaWANDataRate()
+		if err != nil {
+			return err
+		}
+		upDR, err := fp.GetDataRate(dataRate)
+		if err != nil {
+			return err
+		}
+		if downDR, err = fp.GetRX1DataRate(upDR, rx.RX1DROffset); err != nil {
+			return err
+		}
+		timestamp += uint32(rx1Delay / time.Microsecond)
+	} else {
+		if rx.RX2DataRate >= len(fp.DataRates) {
+			return nil
+		}
+		downDR = rx.RX2DataRate
+		frequency = uint64(rx.RX2Frequency)
+		timestamp += uint32((rx1Delay + time.Second) / time.Microsecond)
+	}
+	if optionDR, err := fp.GetDataRateIndexFor(lorawanConfig.DataRate); err == nil && optionDR == downDR &&
+		frequency == gatewayConfig.Frequency && timestamp == gatewayConfig.Timestamp {
+		return nil
+	}
+
+	if err := lorawanConfig.SetDataRate(fp.DataRates[downDR]); err != nil {
+		return err
+	}
+	gatewayConfig.FrequencyDeviation = uint32(lorawanConfig.BitRate / 2)
+	gatewayConfig.Frequency = frequency
+	gatewayConfig.Timestamp = timestamp
+	return nil
+}
+
+// setRX2Configuration sets the RX2 data rate and frequency of the device on the downlink option of a downlink that
+// is not a response to an uplink, if those differ from the frequency plan. The Router uses these instead of the RX2
+// parameters of the frequency plan of the gateway.
+func setRX2Configuration(option *pb_broker.DownlinkOption, dev *device.Device) error {
+	rx := dev.RX.Current
+	if rx == nil {
+		return nil
+	}
+	fp, err := band.Get(dev.Channels.Band)
+	if err != nil {
+		return err
+	}
+	if rx.RX2DataRate >= len(fp.DataRates) {
+		return errors.NewErrInvalidArgument("RX2 data rate", "does not exist in the frequency plan")
+	}
+	if option.GetProtocolConfiguration().GetLoRaWAN() == nil {
+		option.ProtocolConfiguration = &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{
+			LoRaWAN: new(pb_lorawan.TxConfiguration),
+		}}
+	}
+	if err := option.ProtocolConfiguration.GetLoRaWAN().SetDataRate(fp.DataRates[rx.RX2DataRate]); err != nil {
+		return err
+	}
+	if option.GatewayConfiguration == nil {
+		option.GatewayConfiguration = new(pb.TxConfiguration)
+	}
+	option.GatewayConfiguration.Frequency = uint64(rx.RX2Frequency)
+	return nil
+}
+
+// handleDownlinkRX adds a RXParamSetupReq and RXTimingSetupReq if the desired receive window settings of the
+// device differ from the current settings
+func (n *networkServer) handleDownlinkRX(message *pb_broker.DownlinkMessage, dev *device.Device) error {
+	if dev.RX.ParamSetupPending || dev.RX.TimingSetupPending {
+		// The device answers the requests of the last downlink in its next uplink
+		return nil
+	}
+	if dev.RX.Desired == nil || dev.RX.Failed >= MaxRXAttempts {
+		return nil
+	}
+	current, err := currentRXSettings(dev)
+	if err != nil {
+		return nil // The band of the device is not known yet
+	}
+	desired := *dev.RX.Desired
+	if desired.RX1Delay == 0 {
+		desired.RX1Delay = 1 // A delay of 0 is the same as 1 second
+	}
+
+	lorawanDownlinkMAC := message.GetMessage().GetLoRaWAN().GetMACPayload()
+	var length int
+	for _, cmd := range lorawanDownlinkMAC.FOpts {
+		length += 1 + len(cmd.Payload)
+	}
+	if desired.RX1DROffset != current.RX1DROffset || desired.RX2DataRate != current.RX2DataRate || desired.RX2Frequency != current.RX2Frequency {
+		frequency := desired.RX2Frequency / 100
+		cmd := pb_lorawan.MACCommand{
+			CID:     uint32(lorawan.RXParamSetupReq),
+			Payload: []byte{byte(desired.RX1DROffset&0x07)<<4 | byte(desired.RX2DataRate&0x0f), byte(frequency), byte(frequency >> 8), byte(frequency >> 16)},
+		}
+		if length+1+len(cmd.Payload) <= maxFOptsLength {
+			length += 1 + len(cmd.Payload)
+			lorawanDownlinkMAC.FOpts = append(lorawanDownlinkMAC.FOpts, cmd)
+			dev.RX.ParamSetupPending = true
+		}
+	}
+	if desired.RX1Delay != current.RX1Delay {
+		cmd := pb_lorawan.MACCommand{
+			CID:     uint32(lorawan.RXTimingSetupReq),
+			Payload: []byte{byte(desired.RX1Delay & 0x0f)},
+		}
+		if length+1+len(cmd.Payload) <= maxFOptsLength {
+			lorawanDownlinkMAC.FOpts = append(lorawanDownlinkMAC.FOpts, cmd)
+			dev.RX.TimingSetupPending = true
+		}
+	}
+	return nil
+}
+
+// validateRXSettings checks the receive window settings that a device should use, which must fit in the
+// RXParamSetupReq and RXTimingSetupReq
+func validateRXSettings(dev *device.Device, rx device.RXSettings) error {
+	if rx.RX1DROffset < 0 || rx.RX1DROffset > 7 {
+		return errors.NewErrInvalidArgument("RX1 data rate offset", "must be between 0 and 7")
+	}
+	maxDataRate := 15
+	if dev.Channels.Band != "" {
+		fp, err := band.Get(dev.Channels.Band)
+		if err != nil {
+			return err
+		}
+		maxDataRate = len(fp.DataRates) - 1
+	}
+	if rx.RX2DataRate < 0 || rx.RX2DataRate > maxDataRate {
+		return errors.NewErrInvalidArgument("RX2 data rate", fmt.Sprintf("must be between 0 and %d", maxDataRate))
+	}
+	if rx.RX2Frequency <= 0 || rx.RX2Frequency/100 >= 1<<24 {
+		return errors.NewErrInvalidArgument("RX2 frequency", "is not valid")
+	}
+	if rx.RX1Delay < 0 || rx.RX1Delay > 15 {
+		return errors.NewErrInvalidArgument("RX1 delay", "must be between 0 and 15 seconds")
+	}
+	return nil
+}
+
+// setDesiredRXSettings sets the receive window settings that the device should use, or stops changing the settings
+// of the device if desired is nil. The attempts to configure the settings start over.
+func setDesiredRXSettings(dev *device.Device, desired *device.RXSettings) error {
+	if desired != nil {
+		if err := validateRXSettings(dev, *desired); err != nil {
+			return err
+		}
+	}
+	dev.RX.Desired = desired
+	dev.RX.Failed = 0
+	return nil
+}
+
+func rxSettingsToPb(rx *device.RXSettings) *pb_manager.RXSettings {
+	if rx == nil {
+		return nil
+	}
+	return &pb_manager.RXSettings{
+		RX1DROffset:  uint32(rx.RX1DROffset),
+		RX2DataRate:  uint32(rx.RX2DataRate),
+		RX2Frequency: uint64(rx.RX2Frequency),
+		RX1Delay:     uint32(rx.RX1Delay),
+	}
+}
+
+func rxSettingsFromPb(rx *pb_manager.RXSettings) *device.RXSettings {
+	if rx == nil {
+		return nil
+	}
+	return &device.RXSettings{
+		RX1DROffset:  int(rx.RX1DROffset),
+		RX2DataRate:  int(rx.RX2DataRate),
+		RX2Frequency: int(rx.RX2Frequency),
+		RX1Delay:     int(rx.RX1Delay),
+	}
+}
+
+func (n *networkServerManager) GetRXSettings(ctx context.Context, in *pb_manager.NetworkServerDeviceIdentifier) (*pb_manager.NetworkServerDeviceRXSettings, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Device Identifier")
+	}
+	dev, err := n.getDevice(ctx, deviceIdentifier(in.AppEUI, in.DevEUI))
+	if err != nil {
+		return nil, err
+	}
+	res := &pb_manager.NetworkServerDeviceRXSettings{AppEUI: in.AppEUI, DevEUI: in.DevEUI, Desired: rxSettingsToPb(dev.RX.Desired)}
+	if rx, err := currentRXSettings(dev); err == nil {
+		res.Current = rxSettingsToPb(&rx)
+	}
+	return res, nil
+}
+
+func (n *networkServerManager) SetRXSettings(ctx context.Context, in *pb_manager.NetworkServerDeviceRXSettings) (*gogo.Empty, error) {
+	if err := in.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid RX Settings")
+	}
+	id := deviceIdentifier(in.AppEUI, in.DevEUI)
+	unlock := n.lockDevice(id)
+	defer unlock()
+	dev, err := n.getDevice(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dev.StartUpdate()
+	if err := setDesiredRXSettings(dev, rxSettingsFromPb(in.Desired)); err != nil {
+		return nil, err
+	}
+	if err := n.networkServer.devices.Set(dev); err != nil {
+		return nil, errors.Wrap(err, "Could not update receive window settings")
+	}
+	return &gogo.Empty{}, nil
+}
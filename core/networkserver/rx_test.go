@@ -0,0 +1,233 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package networkserver
+
+import (
+	"testing"
+
+	pb_broker "github.com/TheThingsNetwork/api/broker"
+	pb "github.com/TheThingsNetwork/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/component"
+	"github.com/TheThingsNetwork/ttn/core/networkserver/device"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	"github.com/brocaar/lorawan"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestCurrentRXSettings(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	_, err := currentRXSettings(dev)
+	a.So(err, ShouldNotBeNil)
+
+	dev.Channels.Band = "EU_863_870"
+	rx, err := currentRXSettings(dev)
+	a.So(err, ShouldBeNil)
+	a.So(rx, ShouldResemble, device.RXSettings{RX2DataRate: 0, RX2Frequency: 869525000, RX1Delay: 1})
+
+	// The defaults of the band are not stored
+	setCurrentRXSettings(dev, rx)
+	a.So(dev.RX.Current, ShouldBeNil)
+
+	rx.RX2DataRate = 3
+	setCurrentRXSettings(dev, rx)
+	a.So(dev.RX.Current, ShouldNotBeNil)
+	a.So(dev.RX.Current.RX2DataRate, ShouldEqual, 3)
+}
+
+func TestHandleRX(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleRX"),
+		},
+	}
+
+	downlink := func() *pb_broker.DownlinkMessage {
+		message := &pb_broker.DownlinkMessage{Message: new(pb_protocol.Message)}
+		message.Message.InitLoRaWAN().InitDownlink()
+		return message
+	}
+
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+
+	// Nothing is requested without desired settings
+	message := downlink()
+	err := ns.handleDownlinkRX(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+
+	dev.RX.Desired = &device.RXSettings{RX1DROffset: 1, RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 2}
+	message = downlink()
+	err = ns.handleDownlinkRX(message, dev)
+	a.So(err, ShouldBeNil)
+	fOpts := message.Message.GetLoRaWAN().GetMACPayload().FOpts
+	a.So(fOpts, ShouldHaveLength, 2)
+	a.So(fOpts[0].CID, ShouldEqual, uint32(lorawan.RXParamSetupReq))
+	a.So(fOpts[0].Payload, ShouldResemble, []byte{0x13, 0xD2, 0xAD, 0x84}) // 8695250 = 0x84ADD2
+	a.So(fOpts[1].CID, ShouldEqual, uint32(lorawan.RXTimingSetupReq))
+	a.So(fOpts[1].Payload, ShouldResemble, []byte{0x02})
+	a.So(dev.RX.ParamSetupPending, ShouldBeTrue)
+	a.So(dev.RX.TimingSetupPending, ShouldBeTrue)
+
+	// Nothing is requested until the device answers in an uplink
+	message = downlink()
+	err = ns.handleDownlinkRX(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+	a.So(dev.RX.Failed, ShouldEqual, 0)
+
+	// A negative answer is retried
+	a.So(handleRXParamSetupAns(dev, false), ShouldBeNil)
+	a.So(handleRXTimingSetupAns(dev), ShouldBeNil)
+	a.So(dev.RX.Failed, ShouldEqual, 1)
+	a.So(dev.RX.Current, ShouldNotBeNil)
+	a.So(dev.RX.Current.RX1Delay, ShouldEqual, 2)
+	a.So(dev.RX.Current.RX2DataRate, ShouldEqual, 0)
+
+	message = downlink()
+	err = ns.handleDownlinkRX(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldHaveLength, 1)
+	a.So(dev.RX.ParamSetupPending, ShouldBeTrue)
+	a.So(dev.RX.TimingSetupPending, ShouldBeFalse)
+
+	a.So(handleRXParamSetupAns(dev, true), ShouldBeNil)
+	a.So(dev.RX.Failed, ShouldEqual, 0)
+	a.So(*dev.RX.Current, ShouldResemble, *dev.RX.Desired)
+
+	// Repeated answers are ignored
+	a.So(handleRXParamSetupAns(dev, false), ShouldBeNil)
+	a.So(dev.RX.Failed, ShouldEqual, 0)
+
+	// Nothing is requested when the device uses the desired settings
+	message = downlink()
+	err = ns.handleDownlinkRX(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+
+	// Requests without answer are retried until the maximum number of attempts
+	dev.RX.Desired = &device.RXSettings{RX2DataRate: 0, RX2Frequency: 869525000, RX1Delay: 1}
+	for i := 0; i < MaxRXAttempts; i++ {
+		message = downlink()
+		err = ns.handleDownlinkRX(message, dev)
+		a.So(err, ShouldBeNil)
+		a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldNotBeEmpty)
+		a.So(handleUnansweredRXRequests(dev), ShouldBeFalse)
+	}
+	message = downlink()
+	err = ns.handleDownlinkRX(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+	a.So(dev.RX.Failed, ShouldEqual, MaxRXAttempts)
+}
+
+func TestHandleUplinkRX(t *testing.T) {
+	a := New(t)
+
+	uplink := func(timestamp uint32, frequency uint64, dataRate string) *pb_broker.DeduplicatedUplinkMessage {
+		return &pb_broker.DeduplicatedUplinkMessage{
+			ProtocolMetadata: &pb_protocol.RxMetadata{Protocol: &pb_protocol.RxMetadata_LoRaWAN{LoRaWAN: &pb_lorawan.Metadata{
+				FrequencyPlan: pb_lorawan.FrequencyPlan_EU_863_870,
+				DataRate:      "SF7BW125",
+			}}},
+			GatewayMetadata: []*pb.RxMetadata{{GatewayID: "gateway", Timestamp: 1000, Frequency: 868100000}},
+			ResponseTemplate: &pb_broker.DownlinkMessage{DownlinkOption: &pb_broker.DownlinkOption{
+				GatewayID:  "gateway",
+				Identifier: "router:id",
+				ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+					Modulation: pb_lorawan.Modulation_LORA,
+					DataRate:   dataRate,
+				}}},
+				GatewayConfiguration: &pb.TxConfiguration{Timestamp: timestamp, Frequency: frequency},
+			}},
+		}
+	}
+
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+
+	// The option is not changed for the default settings
+	message := uplink(1001000, 868100000, "SF7BW125")
+	a.So(handleUplinkRX(message, dev), ShouldBeNil)
+	a.So(message.ResponseTemplate.DownlinkOption.GatewayConfiguration.Timestamp, ShouldEqual, 1001000)
+	a.So(message.ResponseTemplate.DownlinkOption.Identifier, ShouldEqual, "router:id")
+
+	dev.RX.Current = &device.RXSettings{RX1DROffset: 2, RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 2}
+
+	// The option is not changed if the Router used the settings of the device
+	message = uplink(2001000, 868100000, "SF9BW125")
+	a.So(handleUplinkRX(message, dev), ShouldBeNil)
+	a.So(message.ResponseTemplate.DownlinkOption.GatewayConfiguration.Timestamp, ShouldEqual, 2001000)
+	a.So(message.ResponseTemplate.DownlinkOption.Identifier, ShouldEqual, "router:id")
+
+	// An option for other settings is moved to the receive window of the device
+	message = uplink(1001000, 868100000, "SF7BW125")
+	a.So(handleUplinkRX(message, dev), ShouldBeNil)
+	option := message.ResponseTemplate.DownlinkOption
+	a.So(option.GatewayConfiguration.Timestamp, ShouldEqual, 2001000)
+	a.So(option.GatewayConfiguration.Frequency, ShouldEqual, 868100000)
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+	a.So(option.Identifier, ShouldEqual, "router:id")
+
+	message = uplink(2001000, 869525000, "SF12BW125")
+	a.So(handleUplinkRX(message, dev), ShouldBeNil)
+	option = message.ResponseTemplate.DownlinkOption
+	a.So(option.GatewayConfiguration.Timestamp, ShouldEqual, 3001000)
+	a.So(option.GatewayConfiguration.Frequency, ShouldEqual, 869525000)
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+	a.So(option.Identifier, ShouldEqual, "router:id")
+}
+
+func TestSetRX2Configuration(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+
+	// The Router uses the RX2 parameters of the frequency plan
+	option := &pb_broker.DownlinkOption{}
+	a.So(setRX2Configuration(option, dev), ShouldBeNil)
+	a.So(option.ProtocolConfiguration, ShouldBeNil)
+	a.So(option.GatewayConfiguration, ShouldBeNil)
+
+	dev.RX.Current = &device.RXSettings{RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 1}
+	a.So(setRX2Configuration(option, dev), ShouldBeNil)
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+	a.So(option.GatewayConfiguration.Frequency, ShouldEqual, 869525000)
+
+	dev.RX.Current.RX2DataRate = 42
+	a.So(setRX2Configuration(option, dev), ShouldNotBeNil)
+}
+
+func TestSetDesiredRXSettings(t *testing.T) {
+	a := New(t)
+
+	dev := &device.Device{}
+	dev.Channels.Band = "EU_863_870"
+	dev.RX.Failed = MaxRXAttempts
+
+	desired := rxSettingsFromPb(&pb_manager.RXSettings{RX1DROffset: 1, RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 2})
+	a.So(setDesiredRXSettings(dev, desired), ShouldBeNil)
+	a.So(dev.RX.Desired, ShouldResemble, &device.RXSettings{RX1DROffset: 1, RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 2})
+	a.So(dev.RX.Failed, ShouldEqual, 0)
+	a.So(rxSettingsToPb(dev.RX.Desired), ShouldResemble, &pb_manager.RXSettings{RX1DROffset: 1, RX2DataRate: 3, RX2Frequency: 869525000, RX1Delay: 2})
+
+	for _, rx := range []*pb_manager.RXSettings{
+		{RX1DROffset: 8, RX2Frequency: 869525000},
+		{RX2DataRate: 42, RX2Frequency: 869525000},
+		{RX2Frequency: 0},
+		{RX2Frequency: 869525000, RX1Delay: 16},
+	} {
+		a.So(setDesiredRXSettings(dev, rxSettingsFromPb(rx)), ShouldNotBeNil)
+	}
+
+	a.So(setDesiredRXSettings(dev, nil), ShouldBeNil)
+	a.So(dev.RX.Desired, ShouldBeNil)
+}
@@ -23,6 +23,9 @@ func (n *networkServer) HandleUplink(message *pb_broker.DeduplicatedUplinkMessag
 
 	n.status.uplink.Mark(1)
 
+	unlock := n.lockDevice(*message.AppEUI, *message.DevEUI)
+	defer unlock()
+
 	// Get Device
 	dev, err := n.devices.Get(*message.AppEUI, *message.DevEUI)
 	if err != nil {
@@ -45,6 +48,20 @@ func (n *networkServer) HandleUplink(message *pb_broker.DeduplicatedUplinkMessag
 	dev.FCntUp = lorawanUplinkMAC.FCnt
 	dev.LastSeen = time.Now()
 
+	// The FPending bit in the FCtrl of an uplink is the ClassB bit, that the device sets when it listens to ping slots
+	dev.ClassB.Enabled = lorawanUplinkMAC.FPending
+
+	// The confirmed downlink was acknowledged, so the next downlink gets a new FCnt
+	if dev.ConfirmedDownlinkPending && lorawanUplinkMAC.Ack {
+		dev.FCntDown++
+		dev.ConfirmedDownlinkPending = false
+	}
+
+	// Remember the gateway for downlink that is not a response to an uplink
+	if option := message.GetResponseTemplate().GetDownlinkOption(); option != nil && option.GatewayID != "" {
+		dev.DownlinkGatewayID = option.GatewayID
+	}
+
 	// Prepare Downlink
 	message.InitResponseTemplate()
 	lorawanDownlinkMsg := message.ResponseTemplate.Message.InitLoRaWAN()
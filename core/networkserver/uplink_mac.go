@@ -5,6 +5,7 @@ package networkserver
 
 import (
 	"fmt"
+	"time"
 
 	pb_broker "github.com/TheThingsNetwork/api/broker"
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
@@ -14,6 +15,9 @@ import (
 	"github.com/brocaar/lorawan"
 )
 
+// DefaultDevStatusInterval is the interval at which a DevStatusReq is sent to devices that have no interval set
+var DefaultDevStatusInterval = 24 * time.Hour
+
 func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMessage, dev *device.Device) error {
 	lorawanUplinkMsg := message.GetMessage().GetLoRaWAN()
 	lorawanUplinkMAC := lorawanUplinkMsg.GetMACPayload()
@@ -38,6 +42,12 @@ func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMes
 		return err
 	}
 
+	// Channels
+	if lorawanMeta := message.GetProtocolMetadata().GetLoRaWAN(); lorawanMeta != nil && dev.Channels.Band == "" {
+		dev.Channels.Band = lorawanMeta.GetFrequencyPlan().String()
+	}
+	var newChannelAnswers, dlChannelAnswers []bool
+
 	// MAC Commands
 	for _, cmd := range lorawanUplinkMAC.FOpts {
 		switch cmd.CID {
@@ -71,10 +81,106 @@ func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMes
 					WithField("Answer", fmt.Sprintf("%v/%v/%v", answer.DataRateACK, answer.PowerACK, answer.ChannelMaskACK)).
 					Warn("Negative LinkADRAns")
 			}
+		case pingSlotInfoReq:
+			if len(cmd.Payload) != 1 {
+				break
+			}
+			dev.ClassB.PingSlotInfo = true
+			dev.ClassB.PingSlotPeriodicity = int(cmd.Payload[0] >> 4 & 0x07)
+			dev.ClassB.PingSlotDataRate = int(cmd.Payload[0] & 0x0f)
+			lorawanDownlinkMAC.FOpts = append(lorawanDownlinkMAC.FOpts, pb_lorawan.MACCommand{
+				CID: pingSlotInfoAns,
+			})
+			message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "ping-slot-info",
+				"periodicity", dev.ClassB.PingSlotPeriodicity,
+				"data-rate", dev.ClassB.PingSlotDataRate,
+			)
+		case uint32(lorawan.DevStatusAns):
+			var answer lorawan.DevStatusAnsPayload
+			if err := answer.UnmarshalBinary(cmd.Payload); err != nil {
+				break
+			}
+			dev.DevStatus.Battery = int(answer.Battery)
+			dev.DevStatus.Margin = int(answer.Margin)
+			dev.DevStatus.UpdatedAt = time.Now()
+			message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "dev-status",
+				"battery", answer.Battery,
+				"margin", answer.Margin,
+			)
+		case uint32(lorawan.NewChannelAns):
+			var answer lorawan.NewChannelAnsPayload
+			if err := answer.UnmarshalBinary(cmd.Payload); err != nil {
+				break
+			}
+			message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "new-channel",
+				"frequency-ack", answer.ChannelFrequencyOK,
+				"data-rate-ack", answer.DataRateRangeOK,
+			)
+			newChannelAnswers = append(newChannelAnswers, answer.ChannelFrequencyOK && answer.DataRateRangeOK)
+		case dlChannelAns:
+			if len(cmd.Payload) != 1 {
+				break
+			}
+			frequencyOK, uplinkFrequencyExists := cmd.Payload[0]&0x01 != 0, cmd.Payload[0]&0x02 != 0
+			message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "dl-channel",
+				"frequency-ack", frequencyOK,
+				"uplink-frequency-ack", uplinkFrequencyExists,
+			)
+			dlChannelAnswers = append(dlChannelAnswers, frequencyOK && uplinkFrequencyExists)
+		case uint32(lorawan.RXParamSetupAns):
+			if len(cmd.Payload) != 1 {
+				break
+			}
+			channelACK, rx2DataRateACK, rx1DROffsetACK := cmd.Payload[0]&0x01 != 0, cmd.Payload[0]&0x02 != 0, cmd.Payload[0]&0x04 != 0
+			message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "rx-param-setup",
+				"channel-ack", channelACK,
+				"rx2-data-rate-ack", rx2DataRateACK,
+				"rx1-dr-offset-ack", rx1DROffsetACK,
+			)
+			pending := dev.RX.ParamSetupPending
+			if err := handleRXParamSetupAns(dev, channelACK && rx2DataRateACK && rx1DROffsetACK); err != nil {
+				return err
+			}
+			if pending && !(channelACK && rx2DataRateACK && rx1DROffsetACK) {
+				ctx.
+					WithField("Answer", fmt.Sprintf("%v/%v/%v", channelACK, rx2DataRateACK, rx1DROffsetACK)).
+					Warn("Negative RXParamSetupAns")
+			}
+		case uint32(lorawan.RXTimingSetupAns):
+			message.Trace = message.Trace.WithEvent(trace.HandleMACEvent, macCMD, "rx-timing-setup")
+			if err := handleRXTimingSetupAns(dev); err != nil {
+				return err
+			}
 		default:
 		}
 	}
 
+	// Channel requests of the last downlink that are not answered in this uplink count as failed
+	if !handleChannelAnswers(dev, newChannelAnswers, dlChannelAnswers) {
+		ctx.WithField("Failed", dev.Channels.Failed).Warn("Channel requests were rejected or not answered")
+	}
+
+	// Receive window requests of the last downlink that are not answered in this uplink count as failed
+	if !handleUnansweredRXRequests(dev) {
+		ctx.WithField("Failed", dev.RX.Failed).Warn("Receive window requests were not answered")
+	}
+
+	// Receive Windows, which are changed by the answers above
+	if err := handleUplinkRX(message, dev); err != nil {
+		ctx.WithError(err).Warn("Could not use the receive window settings of the device")
+	}
+
+	// Device Status; if it is not known when the device was created, the interval starts now
+	if dev.CreatedAt.IsZero() && dev.DevStatus.RequestedAt.IsZero() {
+		dev.DevStatus.RequestedAt = time.Now()
+	} else if devStatusRequestDue(dev) {
+		lorawanDownlinkMAC.FOpts = append(lorawanDownlinkMAC.FOpts, pb_lorawan.MACCommand{
+			CID: uint32(lorawan.DevStatusReq),
+		})
+		dev.DevStatus.RequestedAt = time.Now()
+		message.Trace = message.Trace.WithEvent("request dev-status")
+	}
+
 	// We can't send MAC on port 0; send them on port 1
 	if len(lorawanDownlinkMAC.FOpts) != 0 && lorawanDownlinkMAC.FPort == 0 {
 		lorawanDownlinkMAC.FPort = 1
@@ -82,3 +188,23 @@ func (n *networkServer) handleUplinkMAC(message *pb_broker.DeduplicatedUplinkMes
 
 	return nil
 }
+
+// devStatusRequestDue returns true if the interval since the last DevStatusReq (or since the device was created)
+// has passed
+func devStatusRequestDue(dev *device.Device) bool {
+	interval := dev.DevStatus.Interval
+	if interval == 0 {
+		interval = DefaultDevStatusInterval
+	}
+	if interval < 0 {
+		return false
+	}
+	last := dev.DevStatus.RequestedAt
+	if dev.CreatedAt.After(last) {
+		last = dev.CreatedAt
+	}
+	if last.IsZero() {
+		return false
+	}
+	return time.Since(last) >= interval
+}
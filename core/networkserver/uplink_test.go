@@ -119,4 +119,136 @@ func TestHandleUplink(t *testing.T) {
 	dev, _ := ns.devices.Get(appEUI, devEUI)
 	a.So(dev.FCntUp, ShouldEqual, 1)
 	a.So(time.Now().Sub(dev.LastSeen), ShouldBeLessThan, 1*time.Second)
+
+	// ACK of a confirmed downlink
+	dev.StartUpdate()
+	dev.FCntDown = 5
+	dev.ConfirmedDownlinkPending = true
+	ns.devices.Set(dev)
+
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCnt = 2
+	phy.MACPayload.(*lorawan.MACPayload).FHDR.FCtrl.ACK = true
+	bytes, _ = phy.MarshalBinary()
+	message.Payload = bytes
+	message.Message = nil
+	message.ResponseTemplate = &pb_broker.DownlinkMessage{DownlinkOption: &pb_broker.DownlinkOption{
+		GatewayID:  "gateway",
+		Identifier: "router:id",
+	}}
+	res, err = ns.HandleUplink(message)
+	a.So(err, ShouldBeNil)
+
+	phyPayload.UnmarshalBinary(res.ResponseTemplate.Payload)
+	macPayload, _ = phyPayload.MACPayload.(*lorawan.MACPayload)
+	a.So(macPayload.FHDR.FCnt, ShouldEqual, 6)
+
+	dev, _ = ns.devices.Get(appEUI, devEUI)
+	a.So(dev.FCntDown, ShouldEqual, 6)
+	a.So(dev.ConfirmedDownlinkPending, ShouldBeFalse)
+
+	// The gateway of the downlink option is kept for downlink that is not a response to an uplink
+	a.So(dev.DownlinkGatewayID, ShouldEqual, "gateway")
+}
+
+func TestHandleUplinkPingSlotInfo(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleUplinkPingSlotInfo"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-uplink-ping-slot-info"),
+	}
+
+	dev := &device.Device{
+		AppEUI: types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8)),
+		DevEUI: types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8)),
+	}
+	message := &pb_broker.DeduplicatedUplinkMessage{
+		Message:          new(pb_protocol.Message),
+		ResponseTemplate: &pb_broker.DownlinkMessage{Message: new(pb_protocol.Message)},
+	}
+	uplinkMAC := message.Message.InitLoRaWAN().InitUplink()
+	uplinkMAC.FOpts = []pb_lorawan.MACCommand{
+		pb_lorawan.MACCommand{CID: pingSlotInfoReq, Payload: []byte{0x43}},
+	}
+	downlinkMAC := message.ResponseTemplate.Message.InitLoRaWAN().InitDownlink()
+
+	err := ns.handleUplinkMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(dev.ClassB.PingSlotInfo, ShouldBeTrue)
+	a.So(dev.ClassB.PingSlotPeriodicity, ShouldEqual, 4)
+	a.So(dev.ClassB.PingSlotDataRate, ShouldEqual, 3)
+	a.So(downlinkMAC.FOpts, ShouldHaveLength, 1)
+	a.So(downlinkMAC.FOpts[0].CID, ShouldEqual, pingSlotInfoAns)
+	a.So(downlinkMAC.FPort, ShouldEqual, 1)
+}
+
+func TestHandleUplinkDevStatus(t *testing.T) {
+	a := New(t)
+	ns := &networkServer{
+		Component: &component.Component{
+			Ctx: GetLogger(t, "TestHandleUplinkDevStatus"),
+		},
+		devices: device.NewRedisDeviceStore(GetRedisClient(), "ns-test-handle-uplink-dev-status"),
+	}
+
+	newMessage := func() *pb_broker.DeduplicatedUplinkMessage {
+		message := &pb_broker.DeduplicatedUplinkMessage{
+			Message:          new(pb_protocol.Message),
+			ResponseTemplate: &pb_broker.DownlinkMessage{Message: new(pb_protocol.Message)},
+		}
+		message.Message.InitLoRaWAN().InitUplink()
+		message.ResponseTemplate.Message.InitLoRaWAN().InitDownlink()
+		return message
+	}
+
+	dev := &device.Device{
+		AppEUI:    types.AppEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8)),
+		DevEUI:    types.DevEUI(getEUI(1, 2, 3, 4, 5, 6, 7, 8)),
+		CreatedAt: time.Now(),
+	}
+
+	// Not requested right after the device was created
+	message := newMessage()
+	err := ns.handleUplinkMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.ResponseTemplate.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+
+	// Requested when the interval passed
+	dev.CreatedAt = time.Now().Add(-1 * DefaultDevStatusInterval)
+	message = newMessage()
+	err = ns.handleUplinkMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	downlinkMAC := message.ResponseTemplate.Message.GetLoRaWAN().GetMACPayload()
+	a.So(downlinkMAC.FOpts, ShouldHaveLength, 1)
+	a.So(downlinkMAC.FOpts[0].CID, ShouldEqual, uint32(lorawan.DevStatusReq))
+	a.So(downlinkMAC.FPort, ShouldEqual, 1)
+	a.So(dev.DevStatus.RequestedAt, ShouldHappenWithin, time.Second, time.Now())
+
+	// Not requested again until the next interval
+	message = newMessage()
+	err = ns.handleUplinkMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.ResponseTemplate.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+
+	// The device interval overrides the default
+	dev.DevStatus.Interval = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	message = newMessage()
+	err = ns.handleUplinkMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.ResponseTemplate.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldHaveLength, 1)
+
+	// The answer is stored
+	dev.DevStatus.Interval = -1
+	message = newMessage()
+	message.Message.GetLoRaWAN().GetMACPayload().FOpts = []pb_lorawan.MACCommand{
+		pb_lorawan.MACCommand{CID: uint32(lorawan.DevStatusAns), Payload: []byte{200, 0x3b}},
+	}
+	err = ns.handleUplinkMAC(message, dev)
+	a.So(err, ShouldBeNil)
+	a.So(message.ResponseTemplate.Message.GetLoRaWAN().GetMACPayload().FOpts, ShouldBeEmpty)
+	a.So(dev.DevStatus.Battery, ShouldEqual, 200)
+	a.So(dev.DevStatus.Margin, ShouldEqual, -5)
+	a.So(dev.DevStatus.UpdatedAt, ShouldHappenWithin, time.Second, time.Now())
 }
@@ -88,9 +88,80 @@ func (r *router) HandleDownlink(downlink *pb_broker.DownlinkMessage) (err error)
 	}
 
 	gateway = r.getGateway(downlink.DownlinkOption.GatewayID)
+
+	// Downlink without a slot identifier is not a response to an uplink. It is sent in the ping slot that starts at
+	// the Deadline of the option if the NetworkServer set it (Class B), or else on RX2 (Class C). The NetworkServer
+	// sets the ping slot data rate or the RX2 data rate and frequency of the device in the option.
+	var classOption *pb_broker.DownlinkOption
+	var pingSlot time.Time
+	if identifier == "" && option.Deadline != 0 {
+		pingSlot = time.Unix(0, option.Deadline)
+		classOption, err = r.buildClassBDownlinkOption(gateway, option)
+	} else if identifier == "" {
+		classOption, err = r.buildClassCDownlinkOption(gateway, option)
+	}
+	if err != nil {
+		return err
+	}
+	if classOption != nil {
+		if lorawan := downlinkMessage.ProtocolConfiguration.GetLoRaWAN(); lorawan != nil {
+			classOption.ProtocolConfiguration.GetLoRaWAN().FCnt = lorawan.FCnt
+		}
+		downlinkMessage.ProtocolConfiguration = classOption.ProtocolConfiguration
+		downlinkMessage.GatewayConfiguration = classOption.GatewayConfiguration
+	}
+
+	if !pingSlot.IsZero() {
+		return gateway.HandlePingSlotDownlink(pingSlot, downlinkMessage)
+	}
 	return gateway.HandleDownlink(identifier, downlinkMessage)
 }
 
+// buildClassCDownlinkOption builds a DownlinkOption on the RX2 parameters of the frequency plan of the gateway. The
+// RX2 data rate and frequency of the device, if the NetworkServer set those in the device option, are used instead.
+func (r *router) buildClassCDownlinkOption(gateway *gateway.Gateway, device *pb_broker.DownlinkOption) (*pb_broker.DownlinkOption, error) {
+	frequencyPlan := gateway.FrequencyPlan()
+	if frequencyPlan == "" {
+		return nil, errors.NewErrNotFound(fmt.Sprintf("Frequency plan of gateway %s", gateway.ID))
+	}
+	band, err := band.Get(frequencyPlan)
+	if err != nil {
+		return nil, err
+	}
+	option := r.buildDownlinkOption(gateway.ID, band)
+	if frequencyPlan == "EU_863_870" {
+		option.GatewayConfiguration.Power = 27 // The EU RX2 frequency allows up to 27dBm
+	}
+	if lorawan := device.GetProtocolConfiguration().GetLoRaWAN(); lorawan != nil && lorawan.DataRate != "" {
+		optionLoRaWAN := option.ProtocolConfiguration.GetLoRaWAN()
+		optionLoRaWAN.Modulation, optionLoRaWAN.DataRate, optionLoRaWAN.BitRate = lorawan.Modulation, lorawan.DataRate, lorawan.BitRate
+	}
+	if frequency := device.GetGatewayConfiguration().GetFrequency(); frequency != 0 && frequency != option.GatewayConfiguration.Frequency {
+		option.GatewayConfiguration.Frequency = frequency
+		option.GatewayConfiguration.Power = int32(band.DefaultTXPower)
+	}
+	return option, nil
+}
+
+// buildClassBDownlinkOption builds a DownlinkOption on the ping slot frequency of the frequency plan of the gateway,
+// with the ping slot data rate that the NetworkServer set in the device option
+func (r *router) buildClassBDownlinkOption(gateway *gateway.Gateway, device *pb_broker.DownlinkOption) (*pb_broker.DownlinkOption, error) {
+	band, err := gateway.ClassBFrequencyPlan()
+	if err != nil {
+		return nil, err
+	}
+	lorawan := device.GetProtocolConfiguration().GetLoRaWAN()
+	if lorawan == nil || lorawan.DataRate == "" {
+		return nil, errors.NewErrInvalidArgument("Ping Slot Data Rate", "can not be empty")
+	}
+	option := r.buildDownlinkOption(gateway.ID, band)
+	optionLoRaWAN := option.ProtocolConfiguration.GetLoRaWAN()
+	optionLoRaWAN.Modulation, optionLoRaWAN.DataRate, optionLoRaWAN.BitRate = lorawan.Modulation, lorawan.DataRate, lorawan.BitRate
+	option.GatewayConfiguration.Frequency = uint64(band.ClassB.PingSlotFrequency)
+	option.GatewayConfiguration.Power = int32(band.ClassB.TXPower)
+	return option, nil
+}
+
 // buildDownlinkOption builds a DownlinkOption with default values
 func (r *router) buildDownlinkOption(gatewayID string, band band.FrequencyPlan) *pb_broker.DownlinkOption {
 	dataRate, _ := types.ConvertDataRate(band.DataRates[band.RX2DataRate])
@@ -17,6 +17,8 @@ import (
 	pb "github.com/TheThingsNetwork/api/router"
 	"github.com/TheThingsNetwork/ttn/core/component"
 	"github.com/TheThingsNetwork/ttn/core/router/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/classb"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/assertions"
@@ -69,6 +71,162 @@ func TestHandleDownlink(t *testing.T) {
 	a.So(err, ShouldBeNil)
 }
 
+func TestHandleMovedDownlink(t *testing.T) {
+	a := New(t)
+
+	logger := GetLogger(t, "TestHandleMovedDownlink")
+	r := &router{
+		Component: &component.Component{
+			Context: context.Background(),
+			Ctx:     logger,
+			Monitor: monitorclient.NewMonitorClient(),
+		},
+		gateways: map[string]*gateway.Gateway{},
+	}
+	r.InitStatus()
+
+	gtwID := "eui-0102030405060708"
+	gtw := r.getGateway(gtwID)
+	gtw.Schedule.Sync(0)
+
+	buildDownlink := func(identifier string, timestamp uint32) *pb_broker.DownlinkMessage {
+		return &pb_broker.DownlinkMessage{
+			Payload: make([]byte, 20),
+			DownlinkOption: &pb_broker.DownlinkOption{
+				GatewayID:  gtwID,
+				Identifier: identifier,
+				ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+					Modulation: pb_lorawan.Modulation_LORA,
+					DataRate:   "SF9BW125",
+					CodingRate: "4/5",
+				}}},
+				GatewayConfiguration: &pb_gateway.TxConfiguration{Timestamp: timestamp},
+			},
+		}
+	}
+
+	// The NetworkServer moved the response to another receive window of the device
+	id, _ := gtw.Schedule.GetOption(2000000, 10*1000)
+	err := r.HandleDownlink(buildDownlink(id, 3000000))
+	a.So(err, ShouldBeNil)
+
+	// That receive window is taken now
+	id, _ = gtw.Schedule.GetOption(2000000, 10*1000)
+	err = r.HandleDownlink(buildDownlink(id, 3000000))
+	a.So(err, ShouldEqual, gateway.ErrSlotTaken)
+}
+
+func TestHandleClassCDownlink(t *testing.T) {
+	a := New(t)
+
+	logger := GetLogger(t, "TestHandleClassCDownlink")
+	r := &router{
+		Component: &component.Component{
+			Context: context.Background(),
+			Ctx:     logger,
+			Monitor: monitorclient.NewMonitorClient(),
+		},
+		gateways: map[string]*gateway.Gateway{},
+	}
+	r.InitStatus()
+
+	gtwID := "eui-0102030405060708"
+	buildDownlink := func() *pb_broker.DownlinkMessage {
+		return &pb_broker.DownlinkMessage{
+			Payload: make([]byte, 20),
+			DownlinkOption: &pb_broker.DownlinkOption{
+				GatewayID: gtwID,
+			},
+		}
+	}
+
+	// Unknown frequency plan
+	err := r.HandleDownlink(buildDownlink())
+	a.So(err, ShouldNotBeNil)
+
+	gtw := r.getGateway(gtwID)
+	gtw.Status.Update(&pb_gateway.Status{FrequencyPlan: "EU_863_870"})
+
+	option, err := r.buildClassCDownlinkOption(gtw, nil)
+	a.So(err, ShouldBeNil)
+	a.So(option.GatewayConfiguration.Frequency, ShouldEqual, 869525000)
+	a.So(option.GatewayConfiguration.Power, ShouldEqual, 27)
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+
+	// Device with other RX2 parameters
+	option, err = r.buildClassCDownlinkOption(gtw, &pb_broker.DownlinkOption{
+		ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   "SF12BW125",
+		}}},
+		GatewayConfiguration: &pb_gateway.TxConfiguration{Frequency: 868100000},
+	})
+	a.So(err, ShouldBeNil)
+	a.So(option.GatewayConfiguration.Frequency, ShouldEqual, 868100000)
+	a.So(option.GatewayConfiguration.Power, ShouldEqual, 14)
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF12BW125")
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().CodingRate, ShouldEqual, "4/5")
+
+	// Not synchronized with the gateway
+	err = r.HandleDownlink(buildDownlink())
+	a.So(err, ShouldNotBeNil)
+
+	gtw.Schedule.Sync(0)
+	err = r.HandleDownlink(buildDownlink())
+	a.So(err, ShouldBeNil)
+}
+
+func TestHandleClassBDownlink(t *testing.T) {
+	a := New(t)
+
+	logger := GetLogger(t, "TestHandleClassBDownlink")
+	r := &router{
+		Component: &component.Component{
+			Context: context.Background(),
+			Ctx:     logger,
+			Monitor: monitorclient.NewMonitorClient(),
+		},
+		gateways: map[string]*gateway.Gateway{},
+	}
+	r.InitStatus()
+
+	gtwID := "eui-0102030405060708"
+	buildDownlink := func() *pb_broker.DownlinkMessage {
+		return &pb_broker.DownlinkMessage{
+			Payload: []byte{0x60, 0x04, 0x03, 0x02, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			DownlinkOption: &pb_broker.DownlinkOption{
+				GatewayID: gtwID,
+				Deadline:  classb.NextPingSlot(types.DevAddr{1, 2, 3, 4}, 0, time.Now().Add(gateway.ImmediateDelay)).UnixNano(),
+				ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+					Modulation: pb_lorawan.Modulation_LORA,
+					DataRate:   "SF9BW125",
+				}}},
+			},
+		}
+	}
+
+	gtw := r.getGateway(gtwID)
+	gtw.Status.Update(&pb_gateway.Status{FrequencyPlan: "EU_863_870"})
+
+	option, err := r.buildClassBDownlinkOption(gtw, buildDownlink().DownlinkOption)
+	a.So(err, ShouldBeNil)
+	a.So(option.GatewayConfiguration.Frequency, ShouldEqual, 869525000)
+	a.So(option.GatewayConfiguration.Power, ShouldEqual, 27)
+	a.So(option.ProtocolConfiguration.GetLoRaWAN().DataRate, ShouldEqual, "SF9BW125")
+
+	_, err = r.buildClassBDownlinkOption(gtw, &pb_broker.DownlinkOption{GatewayID: gtwID})
+	a.So(err, ShouldNotBeNil)
+
+	// Not synchronized with GPS time
+	gtw.Schedule.Sync(0)
+	err = r.HandleDownlink(buildDownlink())
+	a.So(err, ShouldNotBeNil)
+
+	gtw.Schedule.SyncGPS(0, time.Now())
+	err = r.HandleDownlink(buildDownlink())
+	a.So(err, ShouldBeNil)
+}
+
 func TestSubscribeUnsubscribeDownlink(t *testing.T) {
 	a := New(t)
 	ctrl := gomock.NewController(t)
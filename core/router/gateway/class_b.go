@@ -0,0 +1,98 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/TheThingsNetwork/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
+	pb_router "github.com/TheThingsNetwork/api/router"
+	"github.com/TheThingsNetwork/ttn/core/band"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/classb"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// BeaconLead is how long before the start of a beacon period the beacon is scheduled
+var BeaconLead = 5 * time.Second
+
+// ClassBFrequencyPlan returns the frequency plan of the gateway, if it supports Class B
+func (g *Gateway) ClassBFrequencyPlan() (frequencyPlan band.FrequencyPlan, err error) {
+	region := g.FrequencyPlan()
+	if region == "" {
+		return frequencyPlan, errors.NewErrNotFound(fmt.Sprintf("Frequency plan of gateway %s", g.ID))
+	}
+	frequencyPlan, err = band.Get(region)
+	if err != nil {
+		return frequencyPlan, err
+	}
+	if frequencyPlan.ClassB == nil {
+		return frequencyPlan, errors.NewErrInvalidArgument("Frequency plan", fmt.Sprintf("%s does not support Class B", region))
+	}
+	return frequencyPlan, nil
+}
+
+// startBeacons starts scheduling beacons if that was not started yet. Beacons are scheduled while the schedule
+// of the gateway is synchronized with GPS time.
+func (g *Gateway) startBeacons() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.beacons {
+		return
+	}
+	g.beacons = true
+	go func() {
+		for {
+			beacon := classb.NextBeacon(time.Now().Add(BeaconLead))
+			<-time.After(beacon.Add(-1 * BeaconLead).Sub(time.Now()))
+			g.mu.Lock()
+			if !g.Schedule.IsGPSSynced() {
+				g.beacons = false
+				g.mu.Unlock()
+				return
+			}
+			g.mu.Unlock()
+			if !g.Schedule.IsActive() {
+				continue
+			}
+			if err := g.scheduleBeacon(beacon); err != nil {
+				g.Ctx.WithError(err).Debug("Could not schedule beacon")
+			}
+		}
+	}()
+}
+
+// scheduleBeacon schedules the beacon of the beacon period that starts at beacon
+func (g *Gateway) scheduleBeacon(beacon time.Time) error {
+	frequencyPlan, err := g.ClassBFrequencyPlan()
+	if err != nil {
+		return err
+	}
+	dataRate, err := types.ConvertDataRate(frequencyPlan.DataRates[frequencyPlan.ClassB.BeaconDataRate])
+	if err != nil {
+		return err
+	}
+	var latitude, longitude float32
+	if status, err := g.Status.Get(); err == nil {
+		latitude, longitude = status.GetLocation().GetLatitude(), status.GetLocation().GetLongitude()
+	}
+	downlink := &pb_router.DownlinkMessage{
+		Payload: classb.BeaconPayload(beacon, latitude, longitude),
+		ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   dataRate.String(),
+			CodingRate: "4/5",
+		}}},
+		GatewayConfiguration: &pb.TxConfiguration{
+			RfChain:               0,
+			PolarizationInversion: false, // Beacons are not inverted
+			Frequency:             uint64(frequencyPlan.ClassB.BeaconFrequency),
+			Power:                 int32(frequencyPlan.ClassB.TXPower),
+		},
+	}
+	return g.Schedule.ScheduleAt(beacon, downlink)
+}
@@ -0,0 +1,48 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/TheThingsNetwork/api/gateway"
+	"github.com/TheThingsNetwork/ttn/core/types"
+	"github.com/TheThingsNetwork/ttn/utils/classb"
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestClassB(t *testing.T) {
+	a := New(t)
+	gtw := NewGateway(GetLogger(t, "TestClassB"), "eui-0102030405060708")
+
+	_, err := gtw.ClassBFrequencyPlan()
+	a.So(err, ShouldNotBeNil)
+
+	gtw.Status.Update(&pb.Status{FrequencyPlan: "US_902_928"})
+	_, err = gtw.ClassBFrequencyPlan()
+	a.So(err, ShouldNotBeNil)
+
+	gtw.Status.Update(&pb.Status{FrequencyPlan: "EU_863_870"})
+	_, err = gtw.ClassBFrequencyPlan()
+	a.So(err, ShouldBeNil)
+
+	gtw.Schedule.Sync(0)
+	gtw.Schedule.SyncGPS(0, time.Now())
+
+	beacon := classb.NextBeacon(time.Now().Add(BeaconLead))
+	err = gtw.scheduleBeacon(beacon)
+	a.So(err, ShouldBeNil)
+
+	// Only one downlink is scheduled in a ping slot
+	devAddr := types.DevAddr{1, 2, 3, 4}
+	slot := classb.NextPingSlot(devAddr, 0, time.Now().Add(ImmediateDelay))
+	err = gtw.HandlePingSlotDownlink(slot, buildScheduleDownlink())
+	a.So(err, ShouldBeNil)
+	err = gtw.HandlePingSlotDownlink(slot, buildScheduleDownlink())
+	a.So(err, ShouldEqual, ErrSlotTaken)
+	err = gtw.HandlePingSlotDownlink(classb.NextPingSlot(devAddr, 0, slot), buildScheduleDownlink())
+	a.So(err, ShouldBeNil)
+}
@@ -13,6 +13,7 @@ import (
 	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	pb_router "github.com/TheThingsNetwork/api/router"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/band"
 )
 
 // NewGateway creates a new in-memory Gateway structure
@@ -37,9 +38,11 @@ type Gateway struct {
 	Schedule    Schedule
 	LastSeen    time.Time
 
-	mu            sync.RWMutex // Protect token and authenticated
+	mu            sync.RWMutex // Protect token, authenticated, lastFrequency and beacons
 	token         string
 	authenticated bool
+	lastFrequency uint64 // Frequency of the last uplink
+	beacons       bool   // Whether beacons are scheduled
 
 	MonitorStream monitorclient.Stream
 
@@ -100,20 +103,59 @@ func (g *Gateway) HandleUplink(uplink *pb_router.UplinkMessage) (err error) {
 		}
 	}
 
+	// Gateways with GPS report the time of the uplink, which is used for beacons and ping slots (Class B)
+	if uplink.GatewayMetadata.Time != 0 && uplink.GatewayMetadata.GetLocation().GetSource() == pb.LocationMetadata_GPS {
+		g.Schedule.SyncGPS(uplink.GatewayMetadata.Timestamp, time.Unix(0, uplink.GatewayMetadata.Time))
+		g.startBeacons()
+	}
+
 	// Inject authenticated as GatewayTrusted
-	g.mu.RLock()
-	defer g.mu.RUnlock()
+	g.mu.Lock()
+	defer g.mu.Unlock()
 	uplink.GatewayMetadata.GatewayTrusted = g.authenticated
 	uplink.GatewayMetadata.GatewayID = g.ID
+	g.lastFrequency = uplink.GatewayMetadata.Frequency
 	return nil
 }
 
+// FrequencyPlan returns the frequency plan of the gateway from its status, or guessed from the frequency of the
+// last uplink if the status does not contain it
+func (g *Gateway) FrequencyPlan() string {
+	if status, err := g.Status.Get(); err == nil && status.FrequencyPlan != "" {
+		return status.FrequencyPlan
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.lastFrequency == 0 {
+		return ""
+	}
+	return band.Guess(g.lastFrequency)
+}
+
+// HandleDownlink schedules the downlink on the slot with the identifier, or as soon as possible if the identifier
+// is empty
 func (g *Gateway) HandleDownlink(identifier string, downlink *pb_router.DownlinkMessage) (err error) {
 	ctx := g.Ctx.WithField("Identifier", identifier).WithFields(logfields.ForMessage(downlink))
-	if err = g.Schedule.Schedule(identifier, downlink); err != nil {
+	if identifier == "" {
+		err = g.Schedule.ScheduleImmediate(downlink)
+	} else {
+		err = g.Schedule.Schedule(identifier, downlink)
+	}
+	if err != nil {
 		ctx.WithError(err).Warn("Could not schedule downlink")
 		return err
 	}
 	ctx.Debug("Scheduled downlink")
 	return nil
 }
+
+// HandlePingSlotDownlink schedules the downlink in the ping slot (Class B) that starts at pingSlot
+func (g *Gateway) HandlePingSlotDownlink(pingSlot time.Time, downlink *pb_router.DownlinkMessage) error {
+	ctx := g.Ctx.WithField("PingSlot", pingSlot).WithFields(logfields.ForMessage(downlink))
+	if err := g.Schedule.ScheduleAt(pingSlot, downlink); err != nil {
+		ctx.WithError(err).Warn("Could not schedule downlink in ping slot")
+		return err
+	}
+	ctx.Debug("Scheduled downlink in ping slot")
+	return nil
+}
@@ -24,8 +24,23 @@ type Schedule interface {
 	Sync(timestamp uint32)
 	// Get an "option" on a transmission slot at timestamp for the maximum duration of length (both in microseconds)
 	GetOption(timestamp uint32, length uint32) (id string, score uint)
-	// Schedule a transmission on a slot
+	// Schedule a transmission on a slot. A response to an uplink that the NetworkServer moved to another receive
+	// window of the device is scheduled at the timestamp of the downlink instead, with ScheduleTimestamp.
 	Schedule(id string, downlink *router_pb.DownlinkMessage) error
+	// Schedule a transmission on the first free slot after ImmediateDelay, for downlink that is not a response to
+	// an uplink (Class C). The timestamp of the downlink is set to that of the slot.
+	ScheduleImmediate(downlink *router_pb.DownlinkMessage) error
+	// Schedule a transmission at the timestamp of the downlink, in a slot that was not reserved with GetOption. If
+	// the slot is taken, ErrSlotTaken is returned.
+	ScheduleTimestamp(downlink *router_pb.DownlinkMessage) error
+	// Synchronize the schedule with the GPS time of the gateway: the gateway timestamp (in microseconds) of the
+	// time t
+	SyncGPS(timestamp uint32, t time.Time)
+	// Whether the schedule was recently synchronized with the GPS time of the gateway
+	IsGPSSynced() bool
+	// Schedule a transmission at time t, for beacons and ping slots (Class B). This requires GPS synchronization.
+	// The timestamp of the downlink is set to that of t. If the slot is taken, ErrSlotTaken is returned.
+	ScheduleAt(t time.Time, downlink *router_pb.DownlinkMessage) error
 	// Subscribe to downlink messages
 	Subscribe(subscriptionID string) <-chan *router_pb.DownlinkMessage
 	// Whether the gateway has active downlink
@@ -72,7 +87,9 @@ type scheduledItem struct {
 }
 
 type schedule struct {
-	offset int64 // should be on top to ensure memory alignment needed for sync/atomic
+	offset      int64 // should be on top to ensure memory alignment needed for sync/atomic
+	gpsOffset   int64
+	gpsSyncedAt int64
 
 	sync.RWMutex
 	ctx                       ttnlog.Interface
@@ -126,6 +143,30 @@ func (s *schedule) getConflicts(timestamp uint32, length uint32) (conflicts uint
 	return
 }
 
+// airtime computes the time on air of a LoRaWAN downlink
+func airtime(downlink *router_pb.DownlinkMessage) (time time.Duration) {
+	lorawan := downlink.GetProtocolConfiguration().GetLoRaWAN()
+	if lorawan == nil {
+		return 0
+	}
+	if lorawan.Modulation == pb_lorawan.Modulation_LORA {
+		// Calculate max ToA
+		time, _ = toa.ComputeLoRa(
+			uint(len(downlink.Payload)),
+			lorawan.DataRate,
+			lorawan.CodingRate,
+		)
+	}
+	if lorawan.Modulation == pb_lorawan.Modulation_FSK {
+		// Calculate max ToA
+		time, _ = toa.ComputeFSK(
+			uint(len(downlink.Payload)),
+			int(lorawan.BitRate),
+		)
+	}
+	return time
+}
+
 // realtime gets the synchronized time for a timestamp (in microseconds). Time
 // should first be syncronized using func Sync()
 func (s *schedule) realtime(timestamp uint32) (t time.Time) {
@@ -138,6 +179,13 @@ func (s *schedule) realtime(timestamp uint32) (t time.Time) {
 	return
 }
 
+// timestamp gets the gateway timestamp (in microseconds) for a time. Time should first be syncronized using
+// func Sync()
+func (s *schedule) timestamp(t time.Time) uint32 {
+	offset := atomic.LoadInt64(&s.offset)
+	return uint32((t.UnixNano() - offset) / 1000)
+}
+
 // see interface
 func (s *schedule) Sync(timestamp uint32) {
 	atomic.StoreInt64(&s.offset, time.Now().UnixNano()-int64(timestamp)*1000)
@@ -160,8 +208,28 @@ func (s *schedule) GetOption(timestamp uint32, length uint32) (id string, score
 	return id, score
 }
 
+// moved returns true if the downlink is not at the timestamp of the slot with the id, because the NetworkServer
+// moved it to another receive window of the device. The slot is released in that case.
+func (s *schedule) moved(id string, downlink *router_pb.DownlinkMessage) bool {
+	if downlink.GatewayConfiguration == nil || downlink.GatewayConfiguration.Timestamp == 0 {
+		return false
+	}
+	s.Lock()
+	defer s.Unlock()
+	item, ok := s.items[id]
+	if !ok || item.payload != nil || item.timestamp == downlink.GatewayConfiguration.Timestamp {
+		return false
+	}
+	delete(s.items, id)
+	return true
+}
+
 // see interface
 func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) error {
+	if s.moved(id, downlink) {
+		return s.ScheduleTimestamp(downlink)
+	}
+
 	ctx := s.ctx.WithField("Identifier", id)
 
 	s.Lock()
@@ -169,24 +237,8 @@ func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) erro
 	if item, ok := s.items[id]; ok {
 		item.payload = downlink
 
-		if lorawan := downlink.GetProtocolConfiguration().GetLoRaWAN(); lorawan != nil {
-			var time time.Duration
-			if lorawan.Modulation == pb_lorawan.Modulation_LORA {
-				// Calculate max ToA
-				time, _ = toa.ComputeLoRa(
-					uint(len(downlink.Payload)),
-					lorawan.DataRate,
-					lorawan.CodingRate,
-				)
-			}
-			if lorawan.Modulation == pb_lorawan.Modulation_FSK {
-				// Calculate max ToA
-				time, _ = toa.ComputeFSK(
-					uint(len(downlink.Payload)),
-					int(lorawan.BitRate),
-				)
-			}
-			item.length = uint32(time / 1000)
+		if downlink.GetProtocolConfiguration().GetLoRaWAN() != nil {
+			item.length = uint32(airtime(downlink) / 1000)
 		}
 
 		if time.Now().Before(item.deadlineAt) {
@@ -226,6 +278,85 @@ func (s *schedule) Schedule(id string, downlink *router_pb.DownlinkMessage) erro
 	return errors.NewErrNotFound(id)
 }
 
+// ImmediateDelay is the time between scheduling an immediate downlink and the earliest transmission, which
+// should be larger than the Deadline
+var ImmediateDelay = Deadline + 200*time.Millisecond
+
+// see interface
+func (s *schedule) ScheduleImmediate(downlink *router_pb.DownlinkMessage) error {
+	if atomic.LoadInt64(&s.offset) == 0 {
+		return errors.NewErrInternal("Schedule not synchronized with gateway")
+	}
+	if downlink.GatewayConfiguration == nil {
+		return errors.NewErrInvalidArgument("Downlink", "does not contain a gateway configuration")
+	}
+	length := uint32(airtime(downlink) / 1000)
+	timestamp := s.timestamp(time.Now().Add(ImmediateDelay))
+	// Move the transmission after the scheduled transmissions it conflicts with
+	for i := 0; s.getConflicts(timestamp, length) >= 100; i++ {
+		if i == 100 {
+			return errors.NewErrInternal("No free slot for downlink")
+		}
+		timestamp += length
+	}
+	downlink.GatewayConfiguration.Timestamp = timestamp
+	id, _ := s.GetOption(timestamp, length)
+	return s.Schedule(id, downlink)
+}
+
+// see interface
+func (s *schedule) ScheduleTimestamp(downlink *router_pb.DownlinkMessage) error {
+	if downlink.GatewayConfiguration == nil {
+		return errors.NewErrInvalidArgument("Downlink", "does not contain a gateway configuration")
+	}
+	length := uint32(airtime(downlink) / 1000)
+	timestamp := downlink.GatewayConfiguration.Timestamp
+	if s.getConflicts(timestamp, length) >= 100 {
+		return ErrSlotTaken
+	}
+	id, _ := s.GetOption(timestamp, length)
+	return s.Schedule(id, downlink)
+}
+
+// GPSSyncValidity is how long a GPS synchronization of the schedule is used
+var GPSSyncValidity = 10 * time.Minute
+
+// ErrSlotTaken is returned by ScheduleAt if another transmission is scheduled at the time
+var ErrSlotTaken = errors.NewErrAlreadyExists("Transmission at the time")
+
+// see interface
+func (s *schedule) SyncGPS(timestamp uint32, t time.Time) {
+	atomic.StoreInt64(&s.gpsOffset, t.UnixNano()-int64(timestamp)*1000)
+	atomic.StoreInt64(&s.gpsSyncedAt, time.Now().UnixNano())
+}
+
+// see interface
+func (s *schedule) IsGPSSynced() bool {
+	syncedAt := atomic.LoadInt64(&s.gpsSyncedAt)
+	return syncedAt != 0 && time.Since(time.Unix(0, syncedAt)) < GPSSyncValidity
+}
+
+// see interface
+func (s *schedule) ScheduleAt(t time.Time, downlink *router_pb.DownlinkMessage) error {
+	if !s.IsGPSSynced() {
+		return errors.NewErrInternal("Schedule not synchronized with GPS time")
+	}
+	if downlink.GatewayConfiguration == nil {
+		return errors.NewErrInvalidArgument("Downlink", "does not contain a gateway configuration")
+	}
+	if t.Before(time.Now().Add(Deadline)) {
+		return errors.NewErrInvalidArgument("Time", "is before the deadline")
+	}
+	length := uint32(airtime(downlink) / 1000)
+	timestamp := uint32((t.UnixNano() - atomic.LoadInt64(&s.gpsOffset)) / 1000)
+	if s.getConflicts(timestamp, length) >= 100 {
+		return ErrSlotTaken
+	}
+	downlink.GatewayConfiguration.Timestamp = timestamp
+	id, _ := s.GetOption(timestamp, length)
+	return s.Schedule(id, downlink)
+}
+
 func (s *schedule) Stop(subscriptionID string) {
 	s.downlinkSubscriptionsLock.Lock()
 	defer s.downlinkSubscriptionsLock.Unlock()
@@ -8,6 +8,9 @@ import (
 	"testing"
 	"time"
 
+	pb_gateway "github.com/TheThingsNetwork/api/gateway"
+	pb_protocol "github.com/TheThingsNetwork/api/protocol"
+	pb_lorawan "github.com/TheThingsNetwork/api/protocol/lorawan"
 	router_pb "github.com/TheThingsNetwork/api/router"
 	. "github.com/TheThingsNetwork/ttn/utils/testing"
 	. "github.com/smartystreets/assertions"
@@ -118,6 +121,102 @@ func TestScheduleSchedule(t *testing.T) {
 	a.So(conflicts, ShouldEqual, 100)
 }
 
+func buildScheduleDownlink() *router_pb.DownlinkMessage {
+	return &router_pb.DownlinkMessage{
+		Payload: make([]byte, 20),
+		ProtocolConfiguration: &pb_protocol.TxConfiguration{Protocol: &pb_protocol.TxConfiguration_LoRaWAN{LoRaWAN: &pb_lorawan.TxConfiguration{
+			Modulation: pb_lorawan.Modulation_LORA,
+			DataRate:   "SF9BW125",
+			CodingRate: "4/5",
+		}}},
+		GatewayConfiguration: &pb_gateway.TxConfiguration{},
+	}
+}
+
+func TestScheduleImmediate(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleImmediate")).(*schedule)
+
+	// Not synchronized
+	err := s.ScheduleImmediate(buildScheduleDownlink())
+	a.So(err, ShouldNotBeNil)
+
+	s.Sync(0)
+
+	downlink1 := buildScheduleDownlink()
+	err = s.ScheduleImmediate(downlink1)
+	a.So(err, ShouldBeNil)
+	a.So(downlink1.GatewayConfiguration.Timestamp, ShouldAlmostEqual, uint32(ImmediateDelay/1000), 1000)
+
+	// The next downlink is scheduled after the first
+	downlink2 := buildScheduleDownlink()
+	err = s.ScheduleImmediate(downlink2)
+	a.So(err, ShouldBeNil)
+	a.So(downlink2.GatewayConfiguration.Timestamp, ShouldBeGreaterThanOrEqualTo, downlink1.GatewayConfiguration.Timestamp+uint32(airtime(downlink1)/1000))
+}
+
+func TestScheduleAt(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleAt")).(*schedule)
+
+	at := time.Now().Add(2 * time.Second)
+
+	// Not synchronized with GPS time
+	a.So(s.IsGPSSynced(), ShouldBeFalse)
+	err := s.ScheduleAt(at, buildScheduleDownlink())
+	a.So(err, ShouldNotBeNil)
+
+	s.Sync(0)
+	s.SyncGPS(0, time.Now())
+	a.So(s.IsGPSSynced(), ShouldBeTrue)
+
+	downlink := buildScheduleDownlink()
+	err = s.ScheduleAt(at, downlink)
+	a.So(err, ShouldBeNil)
+	a.So(downlink.GatewayConfiguration.Timestamp, ShouldAlmostEqual, uint32(2*time.Second/1000), 1000)
+
+	// The slot is taken
+	err = s.ScheduleAt(at, buildScheduleDownlink())
+	a.So(err, ShouldEqual, ErrSlotTaken)
+
+	// Too late
+	err = s.ScheduleAt(time.Now(), buildScheduleDownlink())
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestScheduleTimestamp(t *testing.T) {
+	a := New(t)
+	s := NewSchedule(GetLogger(t, "TestScheduleTimestamp")).(*schedule)
+	s.Sync(0)
+
+	downlink := buildScheduleDownlink()
+	downlink.GatewayConfiguration.Timestamp = uint32(2 * time.Second / 1000)
+	err := s.ScheduleTimestamp(downlink)
+	a.So(err, ShouldBeNil)
+	a.So(downlink.GatewayConfiguration.Timestamp, ShouldEqual, uint32(2*time.Second/1000))
+
+	// The slot is taken
+	downlink = buildScheduleDownlink()
+	downlink.GatewayConfiguration.Timestamp = uint32(2 * time.Second / 1000)
+	err = s.ScheduleTimestamp(downlink)
+	a.So(err, ShouldEqual, ErrSlotTaken)
+
+	err = s.ScheduleTimestamp(&router_pb.DownlinkMessage{})
+	a.So(err, ShouldNotBeNil)
+
+	// A downlink that was moved from the slot that was reserved for it is scheduled at its timestamp
+	id, _ := s.GetOption(uint32(4*time.Second/1000), 100)
+	downlink = buildScheduleDownlink()
+	downlink.GatewayConfiguration.Timestamp = uint32(5 * time.Second / 1000)
+	err = s.Schedule(id, downlink)
+	a.So(err, ShouldBeNil)
+	s.RLock()
+	_, ok := s.items[id]
+	s.RUnlock()
+	a.So(ok, ShouldBeFalse)
+	a.So(s.getConflicts(uint32(5*time.Second/1000), 100), ShouldBeGreaterThanOrEqualTo, 100)
+}
+
 func TestScheduleSubscribe(t *testing.T) {
 	a := New(t)
 	s := NewSchedule(GetLogger(t, "TestScheduleSubscribe")).(*schedule)
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"gopkg.in/redis.v5"
 )
 
@@ -180,3 +181,19 @@ func (s *RedisQueueStore) Trim(key string, length int) error {
 	}
 	return err
 }
+
+// Remove removes the first occurrence of value from the queue, prepending the prefix to the key if necessary
+// This function returns an error if the value is not in the queue
+func (s *RedisQueueStore) Remove(key string, value string) error {
+	if !strings.HasPrefix(key, s.prefix) {
+		key = s.prefix + key
+	}
+	removed, err := s.client.LRem(key, 1, value).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	if removed == 0 {
+		return errors.NewErrNotFound(key)
+	}
+	return nil
+}
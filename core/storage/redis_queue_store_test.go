@@ -86,6 +86,27 @@ func TestRedisQueueStore(t *testing.T) {
 	a.So(err, ShouldBeNil)
 	a.So(res, ShouldResemble, []string{"value1", "value3"})
 
+	err = s.AddEnd("test", "value3")
+	a.So(err, ShouldBeNil)
+
+	// Only one item is removed, also if other items are equal
+	err = s.Remove("test", "value3")
+	a.So(err, ShouldBeNil)
+
+	res, err = s.Get("test")
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldResemble, []string{"value1", "value3"})
+
+	err = s.Remove("test", "value1")
+	a.So(err, ShouldBeNil)
+
+	err = s.Remove("test", "value1")
+	a.So(err, ShouldNotBeNil)
+
+	res, err = s.Get("test")
+	a.So(err, ShouldBeNil)
+	a.So(res, ShouldResemble, []string{"value3"})
+
 	err = s.Delete("test")
 	a.So(err, ShouldBeNil)
 
@@ -5,6 +5,7 @@ package storage
 
 import (
 	"strings"
+	"time"
 
 	"gopkg.in/redis.v5"
 )
@@ -66,3 +67,11 @@ func (s *RedisStore) Delete(key string) error {
 	}
 	return s.client.Del(key).Err()
 }
+
+// Expire sets the time to live of an existing record, prepending the prefix to the key if necessary
+func (s *RedisStore) Expire(key string, ttl time.Duration) error {
+	if !strings.HasPrefix(key, s.prefix) {
+		key = s.prefix + key
+	}
+	return s.client.Expire(key, ttl).Err()
+}
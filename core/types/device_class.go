@@ -0,0 +1,39 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"strings"
+
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+)
+
+// DeviceClass is the LoRaWAN class of a device, which determines when it can receive downlink
+type DeviceClass string
+
+// Device classes
+const (
+	// ClassA devices only receive downlink in the receive windows after an uplink
+	ClassA DeviceClass = "A"
+	// ClassB devices also receive downlink in ping slots, that are synchronized with the beacons of gateways
+	ClassB DeviceClass = "B"
+	// ClassC devices continuously receive downlink on the RX2 parameters
+	ClassC DeviceClass = "C"
+)
+
+// ParseDeviceClass parses a device class. An empty string is parsed as ClassA.
+func ParseDeviceClass(input string) (DeviceClass, error) {
+	switch class := DeviceClass(strings.ToUpper(input)); class {
+	case "", ClassA:
+		return ClassA, nil
+	case ClassB, ClassC:
+		return class, nil
+	}
+	return "", errors.NewErrInvalidArgument("Device Class", "must be A, B or C")
+}
+
+// ReceivesDownlinkWithoutUplink returns true for classes that receive downlink that is not a response to an uplink
+func (c DeviceClass) ReceivesDownlinkWithoutUplink() bool {
+	return c == ClassB || c == ClassC
+}
@@ -0,0 +1,33 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParseDeviceClass(t *testing.T) {
+	a := New(t)
+
+	class, err := ParseDeviceClass("")
+	a.So(err, ShouldBeNil)
+	a.So(class, ShouldEqual, ClassA)
+
+	class, err = ParseDeviceClass("b")
+	a.So(err, ShouldBeNil)
+	a.So(class, ShouldEqual, ClassB)
+
+	class, err = ParseDeviceClass("c")
+	a.So(err, ShouldBeNil)
+	a.So(class, ShouldEqual, ClassC)
+
+	_, err = ParseDeviceClass("D")
+	a.So(err, ShouldNotBeNil)
+
+	a.So(ClassA.ReceivesDownlinkWithoutUplink(), ShouldBeFalse)
+	a.So(ClassB.ReceivesDownlinkWithoutUplink(), ShouldBeTrue)
+	a.So(ClassC.ReceivesDownlinkWithoutUplink(), ShouldBeTrue)
+}
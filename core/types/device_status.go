@@ -0,0 +1,26 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package types
+
+import "github.com/TheThingsNetwork/ttn/utils/errors"
+
+// DeviceStatus is the status that a device reports in a DevStatusAns MAC command
+type DeviceStatus struct {
+	// Battery level: 0 if the device is connected to an external power source, 1 (minimum) to 254 (maximum) for the
+	// battery level, or 255 if the device was not able to measure the battery level
+	Battery uint8 `json:"battery"`
+	// Margin is the demodulation margin (in dB) of the last DevStatusReq that the device received
+	Margin int8 `json:"margin"`
+}
+
+// ParseDeviceStatus parses the payload of a DevStatusAns MAC command
+func ParseDeviceStatus(payload []byte) (*DeviceStatus, error) {
+	if len(payload) != 2 {
+		return nil, errors.NewErrInvalidArgument("DevStatusAns", "must be 2 bytes")
+	}
+	return &DeviceStatus{
+		Battery: payload[0],
+		Margin:  int8(payload[1]<<2) >> 2, // 6 bit signed integer
+	}, nil
+}
@@ -0,0 +1,25 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestParseDeviceStatus(t *testing.T) {
+	a := New(t)
+
+	status, err := ParseDeviceStatus([]byte{254, 0x1f})
+	a.So(err, ShouldBeNil)
+	a.So(status, ShouldResemble, &DeviceStatus{Battery: 254, Margin: 31})
+
+	status, err = ParseDeviceStatus([]byte{0, 0x20})
+	a.So(err, ShouldBeNil)
+	a.So(status, ShouldResemble, &DeviceStatus{Battery: 0, Margin: -32})
+
+	_, err = ParseDeviceStatus([]byte{1})
+	a.So(err, ShouldNotBeNil)
+}
@@ -3,6 +3,8 @@
 
 package types
 
+import "time"
+
 // ScheduleType can be "replace" (default), "first", "last"
 type ScheduleType string
 
@@ -15,11 +17,21 @@ const (
 
 // DownlinkMessage represents an application-layer downlink message
 type DownlinkMessage struct {
-	AppID         string                 `json:"app_id,omitempty"`
-	DevID         string                 `json:"dev_id,omitempty"`
-	FPort         uint8                  `json:"port"`
-	Confirmed     bool                   `json:"confirmed,omitempty"`
-	Schedule      ScheduleType           `json:"schedule,omitempty"` // allowed values: "replace" (default), "first", "last"
-	PayloadRaw    []byte                 `json:"payload_raw,omitempty"`
-	PayloadFields map[string]interface{} `json:"payload_fields,omitempty"`
+	ID              string                 `json:"id,omitempty"` // set when the message is added to the downlink queue
+	AppID           string                 `json:"app_id,omitempty"`
+	DevID           string                 `json:"dev_id,omitempty"`
+	FPort           uint8                  `json:"port"`
+	Confirmed       bool                   `json:"confirmed,omitempty"`
+	Schedule        ScheduleType           `json:"schedule,omitempty"` // allowed values: "replace" (default), "first", "last"
+	PayloadRaw      []byte                 `json:"payload_raw,omitempty"`
+	PayloadFields   map[string]interface{} `json:"payload_fields,omitempty"`
+	TTL             string                 `json:"ttl,omitempty"`               // duration after which the message expires, for example "1h"
+	ExpiresAt       *time.Time             `json:"expires_at,omitempty"`        // time at which the message expires
+	MaxRetries      *int                   `json:"max_retries,omitempty"`       // maximum number of retransmissions of a confirmed message
+	GroupDownlinkID string                 `json:"group_downlink_id,omitempty"` // set if the message was sent to a device group
+}
+
+// Expired returns true if the message has an expiry time that is before now
+func (m *DownlinkMessage) Expired(now time.Time) bool {
+	return m.ExpiresAt != nil && m.ExpiresAt.Before(now)
 }
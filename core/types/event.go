@@ -3,17 +3,24 @@
 
 package types
 
+import "time"
+
 // EventType represents the type of event
 type EventType string
 
 // Event types
 const (
-	UplinkErrorEvent EventType = "up/errors"
+	UplinkErrorEvent           EventType = "up/errors"
+	UplinkFragmentTimeoutEvent EventType = "up/fragment-timeout"
 
 	DownlinkScheduledEvent EventType = "down/scheduled"
 	DownlinkSentEvent      EventType = "down/sent"
 	DownlinkErrorEvent     EventType = "down/errors"
 	DownlinkAckEvent       EventType = "down/acks"
+	DownlinkExpiredEvent   EventType = "down/expired"
+	DownlinkNackEvent      EventType = "down/nack"
+
+	QuotaExceededEvent EventType = "quota/exceeded"
 
 	ActivationEvent      EventType = "activations"
 	ActivationErrorEvent EventType = "activations/errors"
@@ -28,10 +35,14 @@ func (e EventType) Data() interface{} {
 	switch e {
 	case UplinkErrorEvent:
 		return new(ErrorEventData)
-	case DownlinkScheduledEvent, DownlinkSentEvent, DownlinkErrorEvent, DownlinkAckEvent:
+	case UplinkFragmentTimeoutEvent:
+		return new(FragmentTimeoutEventData)
+	case DownlinkScheduledEvent, DownlinkSentEvent, DownlinkErrorEvent, DownlinkAckEvent, DownlinkExpiredEvent, DownlinkNackEvent:
 		return new(DownlinkEventData)
 	case ActivationEvent, ActivationErrorEvent:
 		return new(ActivationEventData)
+	case QuotaExceededEvent:
+		return new(QuotaExceededEventData)
 	case CreateEvent, UpdateEvent, DeleteEvent:
 		return nil
 	}
@@ -78,3 +89,18 @@ type DownlinkEventData struct {
 	GatewayID string                  `json:"gateway_id,omitempty"`
 	Config    DownlinkEventConfigInfo `json:"config,omitempty"`
 }
+
+// FragmentTimeoutEventData is added to fragment timeout events
+type FragmentTimeoutEventData struct {
+	Session   uint8     `json:"session"`
+	Received  int       `json:"received"`
+	Total     int       `json:"total"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// QuotaExceededEventData is added to quota exceeded events
+type QuotaExceededEventData struct {
+	Quota string `json:"quota"`
+	Limit int64  `json:"limit"`
+	Usage int64  `json:"usage"`
+}
@@ -9,10 +9,12 @@ type GatewayMetadata struct {
 	GtwTrusted bool     `json:"gtw_trusted,omitempty"`
 	Timestamp  uint32   `json:"timestamp,omitempty"`
 	Time       JSONTime `json:"time,omitempty"`
-	Antenna    uint8    `json:"antenna,omitempty"`
-	Channel    uint32   `json:"channel"`
-	RSSI       float32  `json:"rssi"`
-	SNR        float32  `json:"snr"`
-	RFChain    uint32   `json:"rf_chain"`
+	// FineTimestamp is the nanosecond within the second of Time at which the message was received
+	FineTimestamp int64   `json:"fine_timestamp,omitempty"`
+	Antenna       uint8   `json:"antenna,omitempty"`
+	Channel       uint32  `json:"channel"`
+	RSSI          float32 `json:"rssi"`
+	SNR           float32 `json:"snr"`
+	RFChain       uint32  `json:"rf_chain"`
 	LocationMetadata
 }
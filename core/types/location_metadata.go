@@ -10,7 +10,7 @@ type LocationMetadata struct {
 	Altitude  int32   `json:"altitude,omitempty"`
 	Accuracy  int32   `json:"location_accuracy,omitempty"`
 
-	// The source can be: gps, config, registry, ip_geolocation or unknown (unknown may be left out)
+	// The source can be: gps, config, registry, ip_geolocation, lora_rssi_geolocation, lora_tdoa_geolocation or unknown (unknown may be left out)
 	// See proto definition for more info
 	Source string `json:"location_source,omitempty"`
 }
@@ -12,5 +12,7 @@ type Metadata struct {
 	Bitrate    uint32            `json:"bit_rate,omitempty"`
 	CodingRate string            `json:"coding_rate,omitempty"`
 	Gateways   []GatewayMetadata `json:"gateways,omitempty"`
+	// DeviceStatus is set if the device reported its status in the message
+	DeviceStatus *DeviceStatus `json:"device_status,omitempty"`
 	LocationMetadata
 }
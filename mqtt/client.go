@@ -49,6 +49,11 @@ type Client interface {
 	UnsubscribeAppDownlink(appID string) Token
 	UnsubscribeDownlink() Token
 
+	// Group downlink pub/sub
+	PublishGroupDownlink(appID string, groupID string, payload types.DownlinkMessage) Token
+	SubscribeGroupDownlink(appID string, groupID string, handler GroupDownlinkHandler) Token
+	UnsubscribeGroupDownlink(appID string, groupID string) Token
+
 	// Event pub/sub
 	PublishAppEvent(appID string, eventType types.EventType, payload interface{}) Token
 	PublishDeviceEvent(appID string, devID string, eventType types.EventType, payload interface{}) Token
@@ -150,3 +150,42 @@ func TestPubSubAppDownlink(t *testing.T) {
 	unsubToken := c.UnsubscribeAppDownlink("app3")
 	waitForOK(unsubToken, a)
 }
+
+// Group downlink pub/sub
+
+func TestPublishGroupDownlink(t *testing.T) {
+	a := New(t)
+	c := NewClient(getLogger(t, "Test"), "test", "", "", fmt.Sprintf("tcp://%s", host))
+	c.Connect()
+	defer c.Disconnect()
+
+	token := c.PublishGroupDownlink("someid", "somegroup", types.DownlinkMessage{PayloadRaw: []byte{0x01, 0x02}})
+	waitForOK(token, a)
+	a.So(token.Error(), ShouldBeNil)
+}
+
+func TestPubSubGroupDownlink(t *testing.T) {
+	a := New(t)
+	c := NewClient(getLogger(t, "Test"), "test", "", "", fmt.Sprintf("tcp://%s", host))
+	c.Connect()
+	defer c.Disconnect()
+
+	var wg WaitGroup
+	wg.Add(1)
+
+	token := c.SubscribeGroupDownlink("app5", "group1", func(client Client, appID string, groupID string, req types.DownlinkMessage) {
+		a.So(appID, ShouldEqual, "app5")
+		a.So(groupID, ShouldEqual, "group1")
+		a.So(req.PayloadRaw, ShouldResemble, []byte{0x01, 0x02, 0x03, 0x04})
+		wg.Done()
+	})
+	waitForOK(token, a)
+
+	pubToken := c.PublishGroupDownlink("app5", "group1", types.DownlinkMessage{PayloadRaw: []byte{0x01, 0x02, 0x03, 0x04}})
+	waitForOK(pubToken, a)
+
+	a.So(wg.WaitFor(200*time.Millisecond), ShouldBeNil)
+
+	unsubToken := c.UnsubscribeGroupDownlink("app5", "group1")
+	waitForOK(unsubToken, a)
+}
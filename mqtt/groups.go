@@ -0,0 +1,59 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package mqtt
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	MQTT "github.com/eclipse/paho.mqtt.golang"
+)
+
+// GroupDownlinkHandler is called for downlink messages to device groups
+type GroupDownlinkHandler func(client Client, appID string, groupID string, req types.DownlinkMessage)
+
+// PublishGroupDownlink publishes a downlink message to all devices in the given group
+func (c *DefaultClient) PublishGroupDownlink(appID string, groupID string, dataDown types.DownlinkMessage) Token {
+	topic := GroupTopic{appID, groupID, GroupDownlink}
+	dataDown.AppID = ""
+	dataDown.DevID = ""
+	msg, err := json.Marshal(dataDown)
+	if err != nil {
+		return &simpleToken{fmt.Errorf("Unable to marshal the message payload: %s", err)}
+	}
+	return c.publish(topic.String(), msg)
+}
+
+// SubscribeGroupDownlink subscribes to the downlink messages for the given application and group. In order to
+// subscribe to the downlink messages of all groups the user has access to, pass empty strings.
+func (c *DefaultClient) SubscribeGroupDownlink(appID string, groupID string, handler GroupDownlinkHandler) Token {
+	topic := GroupTopic{appID, groupID, GroupDownlink}
+	return c.subscribe(topic.String(), func(mqtt MQTT.Client, msg MQTT.Message) {
+		// Determine the actual topic
+		topic, err := ParseGroupTopic(msg.Topic())
+		if err != nil {
+			c.ctx.Warnf("mqtt: received message on invalid group downlink topic: %s", msg.Topic())
+			return
+		}
+
+		// Unmarshal the payload
+		dataDown := &types.DownlinkMessage{}
+		err = json.Unmarshal(msg.Payload(), dataDown)
+		if err != nil {
+			c.ctx.Warnf("mqtt: could not unmarshal group downlink: %s", err)
+			return
+		}
+		dataDown.AppID = topic.AppID
+
+		// Call the Downlink handler
+		handler(c, topic.AppID, topic.GroupID, *dataDown)
+	})
+}
+
+// UnsubscribeGroupDownlink unsubscribes from the downlink messages for the given application and group
+func (c *DefaultClient) UnsubscribeGroupDownlink(appID string, groupID string) Token {
+	topic := GroupTopic{appID, groupID, GroupDownlink}
+	return c.unsubscribe(topic.String())
+}
@@ -122,3 +122,49 @@ func (t ApplicationTopic) String() string {
 	}
 	return topic
 }
+
+// GroupTopicType represents the type of a device group topic
+type GroupTopicType string
+
+// Topic types for device groups
+const (
+	GroupDownlink GroupTopicType = "down"
+)
+
+// GroupTopic represents an MQTT topic for device groups
+type GroupTopic struct {
+	AppID   string
+	GroupID string
+	Type    GroupTopicType
+}
+
+// ParseGroupTopic parses an MQTT device group topic string to a GroupTopic struct
+func ParseGroupTopic(topic string) (*GroupTopic, error) {
+	pattern := regexp.MustCompile("^([0-9a-z](?:[_-]?[0-9a-z]){1,35}|\\+)/(groups)/([0-9a-z](?:[_-]?[0-9a-z]){1,35}|\\+)/(down)$")
+	matches := pattern.FindStringSubmatch(topic)
+	if len(matches) < 5 {
+		return nil, fmt.Errorf("Invalid topic format")
+	}
+	var appID string
+	if matches[1] != simpleWildcard {
+		appID = matches[1]
+	}
+	var groupID string
+	if matches[3] != simpleWildcard {
+		groupID = matches[3]
+	}
+	return &GroupTopic{appID, groupID, GroupTopicType(matches[4])}, nil
+}
+
+// String implements the Stringer interface
+func (t GroupTopic) String() string {
+	appID := simpleWildcard
+	if t.AppID != "" {
+		appID = t.AppID
+	}
+	groupID := simpleWildcard
+	if t.GroupID != "" {
+		groupID = t.GroupID
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", appID, "groups", groupID, t.Type)
+}
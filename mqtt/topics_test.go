@@ -139,3 +139,28 @@ func TestAppTopicParseAndString(t *testing.T) {
 	}
 
 }
+
+func TestParseGroupTopic(t *testing.T) {
+	a := New(t)
+
+	got, err := ParseGroupTopic("appid-1/groups/group-1/down")
+	a.So(err, ShouldBeNil)
+	a.So(got, ShouldResemble, &GroupTopic{AppID: "appid-1", GroupID: "group-1", Type: GroupDownlink})
+
+	got, err = ParseGroupTopic("+/groups/+/down")
+	a.So(err, ShouldBeNil)
+	a.So(got, ShouldResemble, &GroupTopic{Type: GroupDownlink})
+
+	_, err = ParseGroupTopic("appid-1/groups/group-1/up")
+	a.So(err, ShouldNotBeNil)
+
+	_, err = ParseGroupTopic("appid-1/devices/devid-1/down")
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestGroupTopicString(t *testing.T) {
+	a := New(t)
+
+	a.So(GroupTopic{AppID: "appid-1", GroupID: "group-1", Type: GroupDownlink}.String(), ShouldEqual, "appid-1/groups/group-1/down")
+	a.So(GroupTopic{Type: GroupDownlink}.String(), ShouldEqual, "+/groups/+/down")
+}
@@ -6,8 +6,13 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/TheThingsNetwork/api/handler"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
 var applicationsPayloadFormatCmd = &cobra.Command{
@@ -75,6 +80,13 @@ function Decoder(bytes, port) {
 			} else {
 				ctx.Info("No custom encoder function")
 			}
+		case "binary":
+			res, err := pb_manager.NewApplicationManagerClient(conn).GetBinaryFormat(util.GetApplicationManagerContext(ctx, appID), &pb_manager.ApplicationIdentifier{AppID: appID})
+			if err != nil {
+				ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not get binary format")
+			}
+			ctx.Info("Binary format")
+			fmt.Println(res.Format)
 		default:
 			ctx.Infof("Payload format set to %s", app.PayloadFormat)
 		}
@@ -84,3 +96,63 @@ function Decoder(bytes, port) {
 func init() {
 	applicationsCmd.AddCommand(applicationsPayloadFormatCmd)
 }
+
+// portFunctionsFromPb converts the port functions proto
+func portFunctionsFromPb(pb *pb_manager.PortFunctions) application.PortFunctions {
+	if pb == nil {
+		return application.PortFunctions{}
+	}
+	return application.PortFunctions{
+		Decoder:   pb.Decoder,
+		Converter: pb.Converter,
+		Validator: pb.Validator,
+		Encoder:   pb.Encoder,
+	}
+}
+
+// portFunctionsToPb converts port functions to their proto
+func portFunctionsToPb(functions application.PortFunctions) *pb_manager.PortFunctions {
+	return &pb_manager.PortFunctions{
+		Decoder:   functions.Decoder,
+		Converter: functions.Converter,
+		Validator: functions.Validator,
+		Encoder:   functions.Encoder,
+	}
+}
+
+// getPortFunctions gets the payload functions of the application per FPort from the Handler
+func getPortFunctions(conn *grpc.ClientConn, appID string) map[uint8]application.PortFunctions {
+	res, err := pb_manager.NewApplicationManagerClient(conn).ListPortFunctions(
+		util.GetApplicationManagerContext(ctx, appID),
+		&pb_manager.ApplicationIdentifier{AppID: appID},
+	)
+	if err != nil {
+		ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not get payload functions per port")
+	}
+	ports := make(map[uint8]application.PortFunctions, len(res.Ports))
+	for port, functions := range res.Ports {
+		ports[uint8(port)] = portFunctionsFromPb(functions)
+	}
+	return ports
+}
+
+// applicationForPort returns a copy of the application with the payload functions that the Handler uses for the
+// FPort, which are the functions of the port with the default functions of the application filled in
+func applicationForPort(app *handler.Application, ports map[uint8]application.PortFunctions, port uint8) *handler.Application {
+	return applicationWithFunctions(app, ports[port].WithDefaults(application.PortFunctions{
+		Decoder:   app.Decoder,
+		Converter: app.Converter,
+		Validator: app.Validator,
+		Encoder:   app.Encoder,
+	}))
+}
+
+// applicationWithFunctions returns a copy of the application with the given payload functions
+func applicationWithFunctions(app *handler.Application, functions application.PortFunctions) *handler.Application {
+	functionsApp := *app
+	functionsApp.Decoder = functions.Decoder
+	functionsApp.Converter = functions.Converter
+	functionsApp.Validator = functions.Validator
+	functionsApp.Encoder = functions.Encoder
+	return &functionsApp
+}
@@ -0,0 +1,178 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/TheThingsNetwork/api/handler"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var applicationsPayloadFormatTestCmd = &cobra.Command{
+	Use:   "test [fixtures.yml]",
+	Short: "Test the payload functions with a file of cases",
+	Long: `ttnctl applications pf test runs the cases in a YAML file against the payload functions of the
+application, or against local function files. It exits with a non-zero status if a case fails.
+The deployed functions are tested with the functions for the port of each case. Applications with the
+binary payload format are tested with their binary format, or with a local one.
+
+Uplink cases have a port and a hex payload, and optionally the expected fields and validity.
+Downlink cases have a port and fields, and optionally the expected hex payload:
+
+  uplink:
+    - name: temperature
+      port: 1
+      payload: 01F4
+      fields: { temperature: 50 }
+      valid: true
+  downlink:
+    - name: led on
+      port: 1
+      fields: { led: true }
+      payload: "01"`,
+	Example: `$ ttnctl applications pf test fixtures.yml --decoder decoder.js
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Case       	Result	Details                    	Logs
+temperature	pass  	                           	decoder: ["temperature",50]
+led on     	FAIL  	expected payload 01, got 00
+
+ FATAL 1 of 2 cases failed                      AppID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		fixtures, err := util.ReadPayloadFunctionFixtures(args[0])
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not read fixtures")
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager := util.GetHandlerManager(ctx, appID)
+		defer conn.Close()
+
+		app := &handler.Application{AppID: appID, PayloadFormat: "custom"}
+		local := false
+		for _, function := range []struct {
+			flag   string
+			source *string
+		}{
+			{"decoder", &app.Decoder},
+			{"converter", &app.Converter},
+			{"validator", &app.Validator},
+			{"encoder", &app.Encoder},
+		} {
+			fileName, _ := cmd.Flags().GetString(function.flag)
+			if fileName == "" {
+				continue
+			}
+			content, err := ioutil.ReadFile(fileName)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not read function file")
+			}
+			*function.source = string(content)
+			local = true
+		}
+		if fileName, _ := cmd.Flags().GetString("binary-format"); fileName != "" {
+			if local {
+				ctx.Fatal("A binary format can not be tested together with payload functions")
+			}
+			content, err := ioutil.ReadFile(fileName)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not read binary format file")
+			}
+			app.PayloadFormat = "binary"
+			app.Decoder = string(content)
+			local = true
+		}
+		var ports map[uint8]application.PortFunctions
+		if !local {
+			app, err = manager.GetApplication(appID)
+			if err != nil {
+				ctx.WithError(err).Fatal("Could not get application.")
+			}
+			if app.PayloadFormat == "binary" {
+				// Without functions, the Handler tests the binary format of the application
+				app.Decoder, app.Converter, app.Validator, app.Encoder = "", "", "", ""
+			} else {
+				ports = getPortFunctions(conn, appID)
+			}
+		}
+
+		table := uitable.New()
+		table.MaxColWidth = 70
+		table.AddRow("Case", "Result", "Details", "Logs")
+
+		var failed int
+		addResult := func(name string, err error, logs []*handler.LogEntry) {
+			result := "pass"
+			var details string
+			if err != nil {
+				failed++
+				result = "FAIL"
+				details = err.Error()
+			}
+			var logLines []string
+			for _, log := range logs {
+				logLines = append(logLines, fmt.Sprintf("%s: [%s]", log.Function, strings.Join(log.Fields, ",")))
+			}
+			table.AddRow(name, result, details, strings.Join(logLines, "; "))
+		}
+
+		for _, c := range fixtures.Uplink {
+			payload, err := c.PayloadBytes()
+			if err != nil {
+				addResult(c.Name, err, nil)
+				continue
+			}
+			result, err := manager.DryUplink(payload, applicationForPort(app, ports, c.Port), uint32(c.Port))
+			if err != nil {
+				addResult(c.Name, err, nil)
+				continue
+			}
+			addResult(c.Name, c.CheckUplink(result.Fields, result.Valid), result.Logs)
+		}
+
+		for _, c := range fixtures.Downlink {
+			result, err := manager.DryDownlinkWithFields(c.FieldsMap(), applicationForPort(app, ports, c.Port), uint32(c.Port))
+			if err != nil {
+				addResult(c.Name, err, nil)
+				continue
+			}
+			addResult(c.Name, c.CheckDownlink(result.Payload), result.Logs)
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+
+		total := len(fixtures.Uplink) + len(fixtures.Downlink)
+		if failed > 0 {
+			ctx.WithField("AppID", appID).Fatalf("%d of %d cases failed", failed, total)
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+		}).Infof("Passed %d cases", total)
+	},
+}
+
+func init() {
+	applicationsPayloadFormatCmd.AddCommand(applicationsPayloadFormatTestCmd)
+	applicationsPayloadFormatTestCmd.Flags().String("decoder", "", "Decoder function file to test instead of the deployed functions")
+	applicationsPayloadFormatTestCmd.Flags().String("converter", "", "Converter function file to test instead of the deployed functions")
+	applicationsPayloadFormatTestCmd.Flags().String("validator", "", "Validator function file to test instead of the deployed functions")
+	applicationsPayloadFormatTestCmd.Flags().String("encoder", "", "Encoder function file to test instead of the deployed functions")
+	applicationsPayloadFormatTestCmd.Flags().String("binary-format", "", "Binary format file to test instead of the deployed payload format")
+}
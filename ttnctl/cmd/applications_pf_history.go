@@ -0,0 +1,107 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var applicationsPayloadFormatHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the revisions of the payload functions",
+	Long: `ttnctl applications pf history shows the revisions of the payload format and functions
+of an application. A revision is recorded for each change.`,
+	Example: `$ ttnctl applications pf history --diff
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Revision	Time                	Author	Changed
+1       	2017-07-07T16:50:12Z	      	payload_format, decoder
+2       	2017-07-14T17:02:45Z	alice 	decoder
+
+Revision 1:
+--- payload_format
++++ payload_format
++custom
+--- decoder
++++ decoder
++function Decoder(bytes, port) {
++  return { temperature: bytes[0] };
++}
+
+Revision 2:
+--- decoder
++++ decoder
+ function Decoder(bytes, port) {
+-  return { temperature: bytes[0] };
++  return { temperature: bytes[0] / 10 };
+ }
+
+  INFO Listed 2 revisions                       AppID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 0, 0)
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		res, err := manager.ListRevisions(callCtx, &pb_manager.ApplicationIdentifier{AppID: appID})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not get revisions")
+		}
+		revisions := res.Revisions
+
+		table := uitable.New()
+		table.MaxColWidth = 70
+		table.AddRow("Revision", "Time", "Author", "Changed")
+		for _, revision := range revisions {
+			changed := strings.Join(changedFunctions(revision.Diff), ", ")
+			if revision.RollbackOf != 0 {
+				changed = fmt.Sprintf("rollback to %d: %s", revision.RollbackOf, changed)
+			}
+			table.AddRow(revision.Number, time.Unix(0, revision.CreatedAt).UTC().Format(time.RFC3339), revision.Author, changed)
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+
+		if showDiff, _ := cmd.Flags().GetBool("diff"); showDiff {
+			for _, revision := range revisions {
+				fmt.Printf("Revision %d:\n%s\n", revision.Number, revision.Diff)
+			}
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+		}).Infof("Listed %d revisions", len(revisions))
+	},
+}
+
+// changedFunctions returns the names of the payload functions that were changed in the diff
+func changedFunctions(diff string) (changed []string) {
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "--- ") {
+			changed = append(changed, strings.TrimPrefix(line, "--- "))
+		}
+	}
+	return
+}
+
+func init() {
+	applicationsPayloadFormatCmd.AddCommand(applicationsPayloadFormatHistoryCmd)
+	applicationsPayloadFormatHistoryCmd.Flags().Bool("diff", false, "Show the changes of each revision")
+}
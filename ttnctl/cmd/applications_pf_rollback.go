@@ -0,0 +1,72 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/spf13/cobra"
+)
+
+var applicationsPayloadFormatRollbackCmd = &cobra.Command{
+	Use:   "rollback [Revision]",
+	Short: "Roll back the payload functions to a revision",
+	Long: `ttnctl applications pf rollback restores the payload format and functions of a revision.
+The rollback is recorded as a new revision. Use ttnctl applications pf history to list the revisions.`,
+	Example: `$ ttnctl applications pf rollback 1
+  INFO Using Application                        AppID=test
+Are you sure you want to roll back the payload functions of application test to revision 1?
+> yes
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+--- decoder
++++ decoder
+ function Decoder(bytes, port) {
+-  return { temperature: bytes[0] / 10 };
++  return { temperature: bytes[0] };
+ }
+
+  INFO Rolled back payload functions            AppID=test Revision=3 RollbackOf=1
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		number, err := strconv.Atoi(args[0])
+		if err != nil || number < 1 {
+			ctx.Fatal("Revision must be a positive number")
+		}
+
+		appID := util.GetAppID(ctx)
+
+		if !confirm(fmt.Sprintf("Are you sure you want to roll back the payload functions of application %s to revision %d?", appID, number)) {
+			ctx.Info("Not doing anything")
+			return
+		}
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		revision, err := manager.RollbackPayloadFunctions(callCtx, &pb_manager.RevisionIdentifier{AppID: appID, Number: uint32(number)})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not roll back payload functions")
+		}
+
+		fmt.Println(revision.Diff)
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":      appID,
+			"Revision":   revision.Number,
+			"RollbackOf": revision.RollbackOf,
+		}).Info("Rolled back payload functions")
+	},
+}
+
+func init() {
+	applicationsPayloadFormatCmd.AddCommand(applicationsPayloadFormatRollbackCmd)
+}
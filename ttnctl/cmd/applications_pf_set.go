@@ -11,15 +11,26 @@ import (
 
 	"github.com/TheThingsNetwork/api/handler"
 	"github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/application"
+	"github.com/TheThingsNetwork/ttn/core/handler/binaryformat"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/spf13/cobra"
 )
 
 var applicationsPayloadFormatSetCmd = &cobra.Command{
-	Use:   "set [decoder/converter/validator/encoder/cayennelpp] [file.js]",
+	Use:   "set [decoder/converter/validator/encoder/cayennelpp/binary] [file.js/file.json]",
 	Short: "Set payload format of an application",
 	Long: `ttnctl pf set can be used to get or set the payload format and functions of an application.
-When using payload functions, you can load a file or provide them through stdin.`,
+When using payload functions, you can load a file or provide them through stdin.
+The Decoder, Converter and Encoder receive the device as third argument, with its dev_id, attributes, counter,
+confirmed, the metadata of uplink messages, and a state object that is kept between messages.
+With --port, the function is only used for messages on that FPort. Functions that are not set for the port
+are taken from the default functions of the application.
+With --function-set, the function is added to a named function set, that devices can use with
+ttnctl devices set --payload-format custom:<name>.
+When using the binary payload format, you can load the JSON field layout from a file or provide it through stdin.`,
 	Example: `$ ttnctl applications pf set decoder
   INFO Discovering Handler...
   INFO Connecting with Handler...
@@ -72,6 +83,37 @@ Port: 1
 
 		format := args[0]
 
+		var functions application.PortFunctions
+		port, _ := cmd.Flags().GetInt("port")
+		functionSet, _ := cmd.Flags().GetString("function-set")
+		if port != 0 || functionSet != "" {
+			switch format {
+			case "decoder", "converter", "validator", "encoder":
+			default:
+				ctx.Fatal("The --port and --function-set flags can only be used for payload functions")
+			}
+			if port != 0 && functionSet != "" {
+				ctx.Fatal("The --port and --function-set flags can not be used together")
+			}
+			if port != 0 {
+				if port < 1 || port > 223 {
+					ctx.Fatal("The port must be between 1 and 223")
+				}
+				ports := getPortFunctions(conn, appID)
+				functions = ports[uint8(port)]
+				// Test the function together with the other functions that are used for the port
+				app = applicationForPort(app, ports, uint8(port))
+			} else {
+				res, err := pb_manager.NewApplicationManagerClient(conn).ListFunctionSets(util.GetApplicationManagerContext(ctx, appID), &pb_manager.ApplicationIdentifier{AppID: appID})
+				if err != nil {
+					ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not get function sets")
+				}
+				functions = portFunctionsFromPb(res.Sets[functionSet])
+				// Test the function together with the other functions of the set
+				app = applicationWithFunctions(app, functions)
+			}
+		}
+
 		switch format {
 		case "decoder", "converter", "validator", "encoder":
 			app.PayloadFormat = "custom"
@@ -207,10 +249,110 @@ Function read from %s:
 					ctx.Fatalf("Function %s does not exist", format)
 				}
 			}
+		case "binary":
+			app.PayloadFormat = format
+			var content string
+			if len(args) == 2 {
+				file, err := ioutil.ReadFile(args[1])
+				if err != nil {
+					ctx.WithError(err).Fatal("Could not read binary format file")
+				}
+				content = string(file)
+			} else {
+				fmt.Println(`{
+  "uplink": {
+    "1": [
+      { "name": "temperature", "offset": 0, "width": 2, "signed": true, "scale": 0.01 },
+      { "name": "battery", "offset": 2, "width": 1, "bits": 4 },
+      { "name": "mode", "offset": 2, "width": 1, "bit_offset": 4, "bits": 2, "enum": { "off": 0, "on": 1 } }
+    ]
+  },
+  "downlink": {
+    "1": [
+      { "name": "interval", "offset": 0, "width": 2, "endian": "little" }
+    ]
+  }
+}
+########## Write your binary format here and end with Ctrl+D (EOF):`)
+				content = readFunction(ctx)
+			}
+			if _, err := binaryformat.Parse(content); err != nil {
+				ctx.WithError(err).Fatal("Invalid binary format")
+			}
+
+			if skipTest, _ := cmd.Flags().GetBool("skip-test"); !skipTest {
+				fmt.Printf("\nDo you want to test the payload format? (Y/n)\n")
+				var response string
+				fmt.Scanln(&response)
+
+				if strings.ToLower(response) == "y" || strings.ToLower(response) == "yes" || response == "" {
+					payload, err := util.ReadPayload()
+					if err != nil {
+						ctx.WithError(err).Fatal("Could not parse the payload")
+					}
+
+					port, err := util.ReadPort()
+					if err != nil {
+						ctx.WithError(err).Fatal("Could not parse the port")
+					}
+
+					binaryApp := &handler.Application{AppID: appID, PayloadFormat: "binary", Decoder: content}
+					result, err := manager.DryUplink(payload, binaryApp, uint32(port))
+					if err != nil {
+						ctx.WithError(err).Fatal("Could not set the binary format")
+					}
+					ctx.Infof("Binary format tested successfully. Decoded fields: %s", result.Fields)
+				}
+			}
+
+			_, err = pb_manager.NewApplicationManagerClient(conn).SetBinaryFormat(util.GetApplicationManagerContext(ctx, appID), &pb_manager.BinaryFormat{
+				AppID:  appID,
+				Format: content,
+			})
+			if err != nil {
+				ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not set the binary format")
+			}
 		default:
 			app.PayloadFormat = format
 		}
 
+		if port != 0 || functionSet != "" {
+			switch format {
+			case "decoder":
+				functions.Decoder = app.Decoder
+			case "converter":
+				functions.Converter = app.Converter
+			case "validator":
+				functions.Validator = app.Validator
+			case "encoder":
+				functions.Encoder = app.Encoder
+			}
+			fields := log.Fields{"AppID": appID}
+			if port != 0 {
+				_, err := pb_manager.NewApplicationManagerClient(conn).SetPortFunctions(util.GetApplicationManagerContext(ctx, appID), &pb_manager.SetPortFunctionsRequest{
+					AppID:     appID,
+					FPort:     uint32(port),
+					Functions: portFunctionsToPb(functions),
+				})
+				if err != nil {
+					ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not update payload functions")
+				}
+				fields["Port"] = port
+			} else {
+				_, err := pb_manager.NewApplicationManagerClient(conn).SetFunctionSet(util.GetApplicationManagerContext(ctx, appID), &pb_manager.SetFunctionSetRequest{
+					AppID:     appID,
+					Name:      functionSet,
+					Functions: portFunctionsToPb(functions),
+				})
+				if err != nil {
+					ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not update payload functions")
+				}
+				fields["FunctionSet"] = functionSet
+			}
+			ctx.WithFields(fields).Info("Updated payload functions")
+			return
+		}
+
 		err = manager.SetApplication(app)
 		if err != nil {
 			ctx.WithError(err).Fatal("Could not update application")
@@ -224,6 +366,8 @@ Function read from %s:
 
 func init() {
 	applicationsPayloadFormatSetCmd.Flags().Bool("skip-test", false, "skip payload format test")
+	applicationsPayloadFormatSetCmd.Flags().Int("port", 0, "only use the function for messages on this FPort")
+	applicationsPayloadFormatSetCmd.Flags().String("function-set", "", "add the function to this function set")
 	applicationsPayloadFormatCmd.AddCommand(applicationsPayloadFormatSetCmd)
 }
 
@@ -0,0 +1,75 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"io"
+	"os"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/spf13/cobra"
+)
+
+var devicesExportCmd = &cobra.Command{
+	Use:   "export [File]",
+	Short: "Export all devices to a file",
+	Long: `ttnctl devices export can be used to export all devices of the current application to a CSV or
+JSON Lines file. The file can be imported with ttnctl devices import.`,
+	Example: `$ ttnctl devices export devices.csv
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Exported devices                         AppID=test File=devices.csv
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "" {
+			format = bulkFormat(args[0])
+		}
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		stream, err := manager.ExportDevices(callCtx, &pb_manager.ExportDevicesRequest{AppID: appID, Format: format})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not export devices")
+		}
+
+		file, err := os.Create(args[0])
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not create file")
+		}
+		defer file.Close()
+
+		for {
+			data, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not export devices")
+			}
+			if _, err := file.Write(data.Data); err != nil {
+				ctx.WithError(err).Fatal("Could not write devices")
+			}
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"File":  args[0],
+		}).Info("Exported devices")
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesExportCmd)
+	devicesExportCmd.Flags().String("format", "", "File format: csv/jsonl (default based on the file extension)")
+}
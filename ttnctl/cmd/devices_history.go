@@ -0,0 +1,100 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TheThingsNetwork/api"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var devicesHistoryCmd = &cobra.Command{
+	Use:   "history [Device ID]",
+	Short: "Show the recent uplink messages and events of a device",
+	Long: `ttnctl devices history can be used to show the recent uplink messages and events of a device.
+The history has to be enabled on the Handler.`,
+	Example: `$ ttnctl devices history test --limit 3
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Time                	Type          	Data
+2017-07-03T14:01:12Z	uplink        	port=1 counter=41 payload=0102 fields={"led":true}
+2017-07-03T14:01:13Z	down/scheduled	{"message":{"port":1,"payload_raw":"AQ=="}}
+2017-07-03T14:11:12Z	uplink        	port=1 counter=42 payload=0103 fields={"led":true}
+
+  INFO Listed 3 history entries                 AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		devID := strings.ToLower(args[0])
+		if err := api.NotEmptyAndValidID(devID, "Device ID"); err != nil {
+			ctx.Fatal(err.Error())
+		}
+
+		appID := util.GetAppID(ctx)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		since, _ := cmd.Flags().GetDuration("since")
+
+		req := &pb_manager.HistoryRequest{AppID: appID, DevID: devID}
+		if limit > 0 {
+			req.Limit = uint32(limit)
+		}
+		if since > 0 {
+			req.Since = time.Now().Add(-1 * since).UnixNano()
+		}
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		res, err := manager.GetHistory(callCtx, req)
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not get history")
+		}
+		entries := res.Entries
+
+		table := uitable.New()
+		table.MaxColWidth = 100
+		table.AddRow("Time", "Type", "Data")
+		for _, entry := range entries {
+			entryTime := time.Unix(0, entry.Time).UTC().Format(time.RFC3339)
+			switch {
+			case entry.Uplink != nil:
+				up := entry.Uplink
+				data := fmt.Sprintf("port=%d counter=%d payload=%X", up.FPort, up.FCnt, up.PayloadRaw)
+				if up.PayloadFields != "" {
+					data += fmt.Sprintf(" fields=%s", up.PayloadFields)
+				}
+				table.AddRow(entryTime, "uplink", data)
+			case entry.Event != nil:
+				table.AddRow(entryTime, entry.Event.Event, entry.Event.Data)
+			}
+		}
+
+		fmt.Println()
+		fmt.Println(table)
+		fmt.Println()
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Infof("Listed %d history entries", len(entries))
+	},
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesHistoryCmd)
+	devicesHistoryCmd.Flags().Int("limit", 10, "Maximum number of entries to show")
+	devicesHistoryCmd.Flags().Duration("since", 0, "Only show entries of this period, for example 24h")
+}
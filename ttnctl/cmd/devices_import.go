@@ -0,0 +1,128 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/core/handler/device"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var devicesImportCmd = &cobra.Command{
+	Use:   "import [File]",
+	Short: "Import devices from a file",
+	Long: `ttnctl devices import can be used to create or update the devices in a CSV or JSON Lines file.
+
+A CSV file starts with a header that names the columns. The columns are dev_id, description, app_eui, dev_eui,
+app_key, dev_addr, nwk_s_key, app_s_key, latitude, longitude, altitude, activation_constraints,
+disable_fcnt_check and uses_32_bit_fcnt. Attributes are given in columns named attributes.<key>.
+A JSON Lines file contains a JSON object with the same fields on each line. Attributes are given as an object.
+
+Devices that already exist are updated, and their frame counters are reset.`,
+	Example: `$ ttnctl devices import devices.csv --dry-run
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Row	DevID	Error
+3  	test3	Device test1 with AppEUI and DevEUI already exists
+
+  INFO Validated devices                        AppID=test Created=1 Failed=1 Updated=1
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "" {
+			format = bulkFormat(args[0])
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			ctx.WithError(err).Fatal("Could not open file")
+		}
+		defer file.Close()
+
+		appID := util.GetAppID(ctx)
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		stream, err := manager.ImportDevices(callCtx)
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not import devices")
+		}
+		req := &pb_manager.ImportDevicesRequest{AppID: appID, Format: format, DryRun: dryRun}
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := file.Read(buf)
+			if err != nil && err != io.EOF {
+				ctx.WithError(err).Fatal("Could not read file")
+			}
+			// The first request is sent even if the file is empty, as it selects the application
+			if n > 0 || req.AppID != "" {
+				req.Data = buf[:n]
+				if err := stream.Send(req); err != nil {
+					break // The error is returned by CloseAndRecv
+				}
+			}
+			if err == io.EOF {
+				break
+			}
+			req = &pb_manager.ImportDevicesRequest{}
+		}
+		result, err := stream.CloseAndRecv()
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not import devices")
+		}
+
+		if len(result.Errors) > 0 {
+			table := uitable.New()
+			table.MaxColWidth = 100
+			table.AddRow("Row", "DevID", "Error")
+			for _, importErr := range result.Errors {
+				table.AddRow(importErr.Row, importErr.DevID, importErr.Error)
+			}
+			fmt.Println()
+			fmt.Println(table)
+			fmt.Println()
+		}
+
+		message := "Imported devices"
+		if dryRun {
+			message = "Validated devices"
+		}
+		ctx.WithFields(ttnlog.Fields{
+			"AppID":   appID,
+			"Created": result.Created,
+			"Updated": result.Updated,
+			"Failed":  result.Failed,
+		}).Info(message)
+	},
+}
+
+// bulkFormat returns the bulk import/export format for the file name
+func bulkFormat(fileName string) string {
+	if strings.ToLower(filepath.Ext(fileName)) == ".csv" {
+		return device.FormatCSV
+	}
+	return device.FormatJSONLines
+}
+
+func init() {
+	devicesCmd.AddCommand(devicesImportCmd)
+	devicesImportCmd.Flags().String("format", "", "File format: csv/jsonl (default based on the file extension)")
+	devicesImportCmd.Flags().Bool("dry-run", false, "Only validate the devices")
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/TheThingsNetwork/api"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
 	"github.com/spf13/cobra"
 )
@@ -38,6 +39,8 @@ var devicesInfoCmd = &cobra.Command{
      FCntUp: 0
    FCntDown: 0
     Options:
+    Battery: 84%
+     Margin: 7 dB (at 2017-08-01T12:34:56.789Z)
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		assertArgsLength(cmd, args, 1, 1)
@@ -109,6 +112,16 @@ var devicesInfoCmd = &cobra.Command{
 				options = append(options, "16BitFCnt")
 			}
 			fmt.Printf("    Options: %s\n", strings.Join(options, ", "))
+
+			// The status is only known if the device answered a DevStatusReq of the Network Server
+			status, err := pb_manager.NewApplicationManagerClient(conn).GetDeviceStatus(util.GetApplicationManagerContext(ctx, appID), &pb_manager.DeviceIdentifier{
+				AppID: appID,
+				DevID: devID,
+			})
+			if err == nil {
+				fmt.Printf("    Battery: %s\n", formatBattery(status.Battery))
+				fmt.Printf("     Margin: %d dB (at %s)\n", status.Margin, time.Unix(0, status.Time).UTC().Format(time.RFC3339Nano))
+			}
 		}
 
 		if len(dev.Attributes) != 0 {
@@ -121,6 +134,17 @@ var devicesInfoCmd = &cobra.Command{
 	},
 }
 
+// formatBattery formats the battery level that a device reported in a DevStatusAns
+func formatBattery(battery uint32) string {
+	switch battery {
+	case 0:
+		return "external power source"
+	case 255:
+		return "unknown"
+	}
+	return fmt.Sprintf("%d%%", (int(battery)-1)*100/253)
+}
+
 type formattableBytes interface {
 	IsEmpty() bool
 	Bytes() []byte
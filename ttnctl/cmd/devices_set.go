@@ -10,8 +10,10 @@ import (
 
 	"github.com/TheThingsNetwork/api"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/core/types"
 	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -179,6 +181,32 @@ var devicesSetCmd = &cobra.Command{
 			ctx.WithError(err).Fatal("Could not update Device")
 		}
 
+		if in, err := cmd.Flags().GetString("payload-format"); err == nil && in != "" {
+			format := &pb_manager.DevicePayloadFormat{AppID: appID, DevID: devID}
+			switch {
+			case in == "default":
+			case strings.HasPrefix(in, "custom:"):
+				format.PayloadFormat, format.FunctionSet = "custom", strings.TrimPrefix(in, "custom:")
+			default:
+				format.PayloadFormat = in
+			}
+			_, err = pb_manager.NewApplicationManagerClient(conn).SetDevicePayloadFormat(util.GetApplicationManagerContext(ctx, appID), format)
+			if err != nil {
+				ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not set payload format of Device")
+			}
+		}
+
+		if in, err := cmd.Flags().GetString("class"); err == nil && in != "" {
+			_, err = pb_manager.NewApplicationManagerClient(conn).SetDeviceClass(util.GetApplicationManagerContext(ctx, appID), &pb_manager.DeviceClass{
+				AppID: appID,
+				DevID: devID,
+				Class: in,
+			})
+			if err != nil {
+				ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not set class of Device")
+			}
+		}
+
 		ctx.WithFields(ttnlog.Fields{
 			"AppID": appID,
 			"DevID": devID,
@@ -212,6 +240,9 @@ func init() {
 
 	devicesSetCmd.Flags().String("description", "", "Set Description")
 
+	devicesSetCmd.Flags().String("class", "", "Set the LoRaWAN class of the device (A, B or C)")
+	devicesSetCmd.Flags().String("payload-format", "", "Set payload format (custom, cayennelpp, binary or custom:<function set>), or default to use the payload format of the application")
+
 	devicesSetCmd.Flags().StringSlice("attr-set", nil, "Add a device attribute (key:value)")
 	devicesSetCmd.Flags().StringSlice("attr-remove", nil, "Remove device attribute")
 }
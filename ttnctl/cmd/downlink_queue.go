@@ -0,0 +1,170 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TheThingsNetwork/api"
+	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
+	"github.com/TheThingsNetwork/ttn/ttnctl/util"
+	"github.com/TheThingsNetwork/ttn/utils/errors"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+)
+
+var downlinkQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Manage the downlink queue of a device",
+	Long:  `ttnctl downlink queue can be used to manage the downlink queue of a device.`,
+}
+
+var downlinkQueueListCmd = &cobra.Command{
+	Use:     "list [DevID]",
+	Aliases: []string{"ls"},
+	Short:   "List the downlink queue of a device",
+	Long:    `ttnctl downlink queue list can be used to list the current downlink and the queued downlinks of a device.`,
+	Example: `$ ttnctl downlink queue list test
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+
+Current downlink: FPort=1 Confirmed=true Payload=AABC Attempts=2
+
+ID              	FPort	Confirmed	Payload
+3f2a9c41d07be815	1    	false    	{"led":"on"}
+b61e07d29a4c3f50	2    	false    	0102
+
+  INFO Listed 2 queued downlinks                AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		appID, devID := getQueueDevice(args[0])
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		queue, err := manager.GetDownlinkQueue(callCtx, &pb_manager.DeviceIdentifier{AppID: appID, DevID: devID})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not get downlink queue")
+		}
+
+		fmt.Println()
+		if queue.Current != nil {
+			fmt.Printf("Current downlink: FPort=%d Confirmed=%t Payload=%s Attempts=%d\n", queue.Current.FPort, queue.Current.Confirmed, formatDownlinkPayload(queue.Current), queue.Attempts)
+		} else {
+			fmt.Println("Current downlink: none")
+		}
+		fmt.Println()
+
+		table := uitable.New()
+		table.MaxColWidth = 70
+		table.AddRow("ID", "FPort", "Confirmed", "Payload")
+		for _, msg := range queue.Queue {
+			table.AddRow(msg.ID, msg.FPort, msg.Confirmed, formatDownlinkPayload(msg))
+		}
+		fmt.Println(table)
+		fmt.Println()
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Infof("Listed %d queued downlinks", len(queue.Queue))
+	},
+}
+
+var downlinkQueueDeleteCmd = &cobra.Command{
+	Use:   "delete [DevID] [ID]",
+	Short: "Delete a downlink from the queue of a device",
+	Long:  `ttnctl downlink queue delete can be used to delete a downlink from the queue of a device. The ID is shown by ttnctl downlink queue list.`,
+	Example: `$ ttnctl downlink queue delete test b61e07d29a4c3f50
+  INFO Using Application                        AppID=test
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Deleted queued downlink                  AppID=test DevID=test ID=b61e07d29a4c3f50
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 2, 2)
+
+		appID, devID := getQueueDevice(args[0])
+
+		id := args[1]
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		_, err := manager.DeleteQueuedDownlink(callCtx, &pb_manager.QueuedDownlinkIdentifier{AppID: appID, DevID: devID, ID: id})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not delete queued downlink")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+			"ID":    id,
+		}).Info("Deleted queued downlink")
+	},
+}
+
+var downlinkQueueFlushCmd = &cobra.Command{
+	Use:   "flush [DevID]",
+	Short: "Flush the downlink queue of a device",
+	Long:  `ttnctl downlink queue flush can be used to remove the current downlink and all queued downlinks of a device.`,
+	Example: `$ ttnctl downlink queue flush test
+  INFO Using Application                        AppID=test
+Are you sure you want to flush the downlink queue of device test?
+> yes
+  INFO Discovering Handler...
+  INFO Connecting with Handler...
+  INFO Flushed downlink queue                   AppID=test DevID=test
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		assertArgsLength(cmd, args, 1, 1)
+
+		appID, devID := getQueueDevice(args[0])
+
+		if !confirm(fmt.Sprintf("Are you sure you want to flush the downlink queue of device %s?", devID)) {
+			ctx.Info("Not doing anything")
+			return
+		}
+
+		conn, manager, callCtx := util.GetApplicationManager(ctx, appID)
+		defer conn.Close()
+
+		_, err := manager.FlushDownlinkQueue(callCtx, &pb_manager.DeviceIdentifier{AppID: appID, DevID: devID})
+		if err != nil {
+			ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not flush downlink queue")
+		}
+
+		ctx.WithFields(ttnlog.Fields{
+			"AppID": appID,
+			"DevID": devID,
+		}).Info("Flushed downlink queue")
+	},
+}
+
+func getQueueDevice(arg string) (appID, devID string) {
+	devID = strings.ToLower(arg)
+	if err := api.NotEmptyAndValidID(devID, "Device ID"); err != nil {
+		ctx.Fatal(err.Error())
+	}
+	return util.GetAppID(ctx), devID
+}
+
+func formatDownlinkPayload(msg *pb_manager.DownlinkMessage) string {
+	if msg.PayloadFields != "" {
+		return msg.PayloadFields
+	}
+	return fmt.Sprintf("%X", msg.PayloadRaw)
+}
+
+func init() {
+	downlinkCmd.AddCommand(downlinkQueueCmd)
+	downlinkQueueCmd.AddCommand(downlinkQueueListCmd)
+	downlinkQueueCmd.AddCommand(downlinkQueueDeleteCmd)
+	downlinkQueueCmd.AddCommand(downlinkQueueFlushCmd)
+}
@@ -7,14 +7,17 @@ import (
 	"github.com/TheThingsNetwork/api/discovery"
 	"github.com/TheThingsNetwork/api/handler/handlerclient"
 	"github.com/TheThingsNetwork/go-account-lib/scope"
+	"github.com/TheThingsNetwork/go-utils/grpc/ttnctx"
 	ttnlog "github.com/TheThingsNetwork/go-utils/log"
+	pb_manager "github.com/TheThingsNetwork/ttn/api/manager"
 	"github.com/TheThingsNetwork/ttn/utils/errors"
 	"github.com/spf13/viper"
+	"golang.org/x/net/context" // See https://github.com/grpc/grpc-go/issues/711"
 	"google.golang.org/grpc"
 )
 
-// GetHandlerManager gets a new HandlerManager for ttnctl
-func GetHandlerManager(ctx ttnlog.Interface, appID string) (*grpc.ClientConn, *handlerclient.ManagerClient) {
+// discoverHandler gets the announcement of the Handler for ttnctl
+func discoverHandler(ctx ttnlog.Interface) *discovery.Announcement {
 	ctx.WithField("Handler", viper.GetString("handler-id")).Info("Discovering Handler...")
 	dscConn, client := GetDiscovery(ctx)
 	defer dscConn.Close()
@@ -25,17 +28,39 @@ func GetHandlerManager(ctx ttnlog.Interface, appID string) (*grpc.ClientConn, *h
 	if err != nil {
 		ctx.WithError(errors.FromGRPCError(err)).Fatal("Could not find Handler")
 	}
+	return handlerAnnouncement
+}
 
-	token := TokenForScope(ctx, scope.App(appID))
-
+// dialHandler connects with the Handler for ttnctl
+func dialHandler(ctx ttnlog.Interface) *grpc.ClientConn {
+	handlerAnnouncement := discoverHandler(ctx)
 	ctx.WithField("Handler", handlerAnnouncement.NetAddress).Info("Connecting with Handler...")
 	hdlConn, err := handlerAnnouncement.Dial(nil)
 	if err != nil {
 		ctx.WithError(err).Fatal("Could not connect to Handler")
 	}
-	managerClient, err := handlerclient.NewManagerClient(hdlConn, token)
+	return hdlConn
+}
+
+// GetHandlerManager gets a new HandlerManager for ttnctl
+func GetHandlerManager(ctx ttnlog.Interface, appID string) (*grpc.ClientConn, *handlerclient.ManagerClient) {
+	hdlConn := dialHandler(ctx)
+	managerClient, err := handlerclient.NewManagerClient(hdlConn, TokenForScope(ctx, scope.App(appID)))
 	if err != nil {
 		ctx.WithError(err).Fatal("Could not create Handler Manager")
 	}
 	return hdlConn, managerClient
 }
+
+// GetApplicationManager gets a client for the management API of the Handler that is not part of the
+// HandlerManager, and a context with the token for the application
+func GetApplicationManager(ctx ttnlog.Interface, appID string) (*grpc.ClientConn, pb_manager.ApplicationManagerClient, context.Context) {
+	hdlConn := dialHandler(ctx)
+	return hdlConn, pb_manager.NewApplicationManagerClient(hdlConn), GetApplicationManagerContext(ctx, appID)
+}
+
+// GetApplicationManagerContext returns a context with the token for the application, for calls to an
+// ApplicationManager client on a connection that is already open
+func GetApplicationManagerContext(ctx ttnlog.Interface, appID string) context.Context {
+	return ttnctx.OutgoingContextWithToken(context.Background(), TokenForScope(ctx, scope.App(appID)))
+}
@@ -0,0 +1,148 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// PayloadFunctionFixtures are the test cases for the payload functions of an application
+type PayloadFunctionFixtures struct {
+	Uplink   []*PayloadFunctionCase `yaml:"uplink"`
+	Downlink []*PayloadFunctionCase `yaml:"downlink"`
+}
+
+// PayloadFunctionCase is a test case for the payload functions. For uplink, the payload is decoded and the result
+// is compared with the expected fields and validity. For downlink, the fields are encoded and the result is compared
+// with the expected payload.
+type PayloadFunctionCase struct {
+	Name    string      `yaml:"name"`
+	Port    uint8       `yaml:"port"`
+	Payload string      `yaml:"payload"` // Hex-encoded
+	Fields  interface{} `yaml:"fields"`
+	Valid   *bool       `yaml:"valid"`
+}
+
+// ReadPayloadFunctionFixtures reads the test cases from a YAML file
+func ReadPayloadFunctionFixtures(fileName string) (*PayloadFunctionFixtures, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return parsePayloadFunctionFixtures(data)
+}
+
+func parsePayloadFunctionFixtures(data []byte) (*PayloadFunctionFixtures, error) {
+	fixtures := new(PayloadFunctionFixtures)
+	if err := yaml.Unmarshal(data, fixtures); err != nil {
+		return nil, err
+	}
+	for i, c := range fixtures.Uplink {
+		if c.Name == "" {
+			c.Name = fmt.Sprintf("uplink %d", i+1)
+		}
+		if c.Payload == "" {
+			return nil, fmt.Errorf("Case %s has no payload", c.Name)
+		}
+	}
+	for i, c := range fixtures.Downlink {
+		if c.Name == "" {
+			c.Name = fmt.Sprintf("downlink %d", i+1)
+		}
+		if _, ok := jsonCompatible(c.Fields).(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("Case %s has no fields", c.Name)
+		}
+	}
+	if len(fixtures.Uplink) == 0 && len(fixtures.Downlink) == 0 {
+		return nil, fmt.Errorf("No cases")
+	}
+	return fixtures, nil
+}
+
+// PayloadBytes returns the payload of the case
+func (c *PayloadFunctionCase) PayloadBytes() ([]byte, error) {
+	return parsePayload(strings.Replace(c.Payload, " ", "", -1))
+}
+
+// FieldsMap returns the fields of the case
+func (c *PayloadFunctionCase) FieldsMap() map[string]interface{} {
+	fields, _ := jsonCompatible(c.Fields).(map[string]interface{})
+	return fields
+}
+
+// CheckUplink returns an error if the fields (as JSON) and validity do not match the expectation of the case
+func (c *PayloadFunctionCase) CheckUplink(fields string, valid bool) error {
+	if c.Valid != nil && *c.Valid != valid {
+		return fmt.Errorf("expected valid=%v, got valid=%v", *c.Valid, valid)
+	}
+	if c.Fields == nil {
+		return nil
+	}
+	var actual interface{}
+	if fields != "" {
+		if err := json.Unmarshal([]byte(fields), &actual); err != nil {
+			return err
+		}
+	}
+	expected, err := normalizeJSON(jsonCompatible(c.Fields))
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		expectedJSON, _ := json.Marshal(expected)
+		return fmt.Errorf("expected fields %s, got %s", expectedJSON, fields)
+	}
+	return nil
+}
+
+// CheckDownlink returns an error if the payload does not match the expectation of the case
+func (c *PayloadFunctionCase) CheckDownlink(payload []byte) error {
+	if c.Payload == "" {
+		return nil
+	}
+	expected, err := c.PayloadBytes()
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(expected, payload) && !(len(expected) == 0 && len(payload) == 0) {
+		return fmt.Errorf("expected payload %X, got %X", expected, payload)
+	}
+	return nil
+}
+
+// jsonCompatible converts the maps that YAML decodes to maps with string keys
+func jsonCompatible(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonCompatible(value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = jsonCompatible(value)
+		}
+		return s
+	}
+	return v
+}
+
+// normalizeJSON returns the value as it would be decoded from JSON, so that it can be compared with decoded JSON
+func normalizeJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(data, &normalized)
+	return normalized, err
+}
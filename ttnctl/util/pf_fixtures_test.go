@@ -0,0 +1,64 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestPayloadFunctionFixtures(t *testing.T) {
+	a := New(t)
+
+	_, err := parsePayloadFunctionFixtures([]byte(`uplink: []`))
+	a.So(err, ShouldNotBeNil)
+
+	_, err = parsePayloadFunctionFixtures([]byte(`uplink: [{port: 1}]`))
+	a.So(err, ShouldNotBeNil)
+
+	_, err = parsePayloadFunctionFixtures([]byte(`downlink: [{port: 1, payload: "01"}]`))
+	a.So(err, ShouldNotBeNil)
+
+	fixtures, err := parsePayloadFunctionFixtures([]byte(`
+uplink:
+  - name: temperature
+    port: 1
+    payload: 01 F4
+    fields:
+      temperature: 50
+      sensor: {type: dht22, tags: [indoor]}
+    valid: true
+  - port: 2
+    payload: "00"
+    valid: false
+downlink:
+  - port: 1
+    fields: {led: true}
+    payload: "01"
+`))
+	a.So(err, ShouldBeNil)
+	a.So(fixtures.Uplink, ShouldHaveLength, 2)
+	a.So(fixtures.Downlink, ShouldHaveLength, 1)
+
+	up := fixtures.Uplink[0]
+	payload, err := up.PayloadBytes()
+	a.So(err, ShouldBeNil)
+	a.So(payload, ShouldResemble, []byte{0x01, 0xF4})
+	a.So(up.CheckUplink(`{"sensor":{"tags":["indoor"],"type":"dht22"},"temperature":50}`, true), ShouldBeNil)
+	a.So(up.CheckUplink(`{"sensor":{"tags":["indoor"],"type":"dht22"},"temperature":50.5}`, true), ShouldNotBeNil)
+	a.So(up.CheckUplink(`{"temperature":50}`, true), ShouldNotBeNil)
+	a.So(up.CheckUplink(`{"sensor":{"tags":["indoor"],"type":"dht22"},"temperature":50}`, false), ShouldNotBeNil)
+
+	up = fixtures.Uplink[1]
+	a.So(up.Name, ShouldEqual, "uplink 2")
+	a.So(up.CheckUplink(`{"anything":1}`, false), ShouldBeNil)
+	a.So(up.CheckUplink(`{"anything":1}`, true), ShouldNotBeNil)
+
+	down := fixtures.Downlink[0]
+	a.So(down.Name, ShouldEqual, "downlink 1")
+	a.So(down.FieldsMap(), ShouldResemble, map[string]interface{}{"led": true})
+	a.So(down.CheckDownlink([]byte{0x01}), ShouldBeNil)
+	a.So(down.CheckDownlink([]byte{0x00}), ShouldNotBeNil)
+}
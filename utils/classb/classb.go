@@ -0,0 +1,124 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package classb implements the beacon and ping slot timing of LoRaWAN Class B (LoRaWAN 1.0.2 chapter 8).
+package classb
+
+import (
+	"crypto/aes"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+)
+
+const (
+	// BeaconPeriod is the time between two beacons
+	BeaconPeriod = 128 * time.Second
+	// BeaconReserved is the time after the start of a beacon period in which no ping slots are scheduled
+	BeaconReserved = 2120 * time.Millisecond
+	// SlotLength is the length of a ping slot
+	SlotLength = 30 * time.Millisecond
+	// PingSlots is the number of ping slots in a beacon period
+	PingSlots = 4096
+	// MaxPeriodicity is the maximum ping slot periodicity, which is one ping slot per beacon period
+	MaxPeriodicity = 7
+)
+
+// LeapSeconds is the number of seconds that GPS time is ahead of UTC
+var LeapSeconds = 18 * time.Second
+
+var gpsEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// GPSTime returns the GPS time of t, which is the time since the GPS epoch including leap seconds
+func GPSTime(t time.Time) time.Duration {
+	return t.Sub(gpsEpoch) + LeapSeconds
+}
+
+// BeaconStart returns the start of the beacon period that t is in
+func BeaconStart(t time.Time) time.Time {
+	return t.Add(-(GPSTime(t) % BeaconPeriod))
+}
+
+// NextBeacon returns the start of the first beacon period after t
+func NextBeacon(t time.Time) time.Time {
+	return BeaconStart(t).Add(BeaconPeriod)
+}
+
+// BeaconTime returns the time field of the beacon at the start of a beacon period, which is the GPS time in
+// seconds modulo 2^32
+func BeaconTime(beacon time.Time) uint32 {
+	return uint32(GPSTime(beacon) / time.Second)
+}
+
+// PingPeriod returns the number of slots between two ping slots of a device with the periodicity
+func PingPeriod(periodicity uint8) int {
+	return 1 << (5 + periodicity)
+}
+
+// PingOffset returns the offset (in slots) of the first ping slot of the device in the beacon period that starts
+// at beacon
+func PingOffset(beacon time.Time, devAddr types.DevAddr, pingPeriod int) int {
+	var block [aes.BlockSize]byte
+	beaconTime := BeaconTime(beacon)
+	for i := 0; i < 4; i++ {
+		block[i] = byte(beaconTime >> (8 * uint(i)))
+		block[4+i] = devAddr[3-i] // DevAddr is little endian on the air
+	}
+	cipher, _ := aes.NewCipher(make([]byte, 16))
+	cipher.Encrypt(block[:], block[:])
+	return (int(block[0]) + int(block[1])*256) % pingPeriod
+}
+
+// NextPingSlot returns the start of the first ping slot of the device with the periodicity after t
+func NextPingSlot(devAddr types.DevAddr, periodicity uint8, t time.Time) time.Time {
+	pingPeriod := PingPeriod(periodicity)
+	for beacon := BeaconStart(t); ; beacon = beacon.Add(BeaconPeriod) {
+		offset := PingOffset(beacon, devAddr, pingPeriod)
+		for slot := offset; slot < PingSlots; slot += pingPeriod {
+			start := beacon.Add(BeaconReserved + time.Duration(slot)*SlotLength)
+			if start.After(t) {
+				return start
+			}
+		}
+	}
+}
+
+// crc16 computes the CRC-16 (CCITT) of a beacon field
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc = crc << 1
+			}
+		}
+	}
+	return crc
+}
+
+// BeaconPayload builds the 17 byte beacon of the beacon period that starts at beacon, as used in the EU 863-870
+// band. The gateway specific part contains the location of the gateway.
+func BeaconPayload(beacon time.Time, latitude, longitude float32) []byte {
+	payload := make([]byte, 17)
+	beaconTime := BeaconTime(beacon)
+	for i := 0; i < 4; i++ {
+		payload[2+i] = byte(beaconTime >> (8 * uint(i)))
+	}
+	crc := crc16(payload[:6])
+	payload[6], payload[7] = byte(crc), byte(crc>>8)
+
+	// Gateway specific part: InfoDesc 0 (GPS coordinates of the antenna), latitude and longitude as 24 bit
+	// signed integers
+	lat := int32(float64(latitude) / 90 * (1 << 23))
+	lng := int32(float64(longitude) / 180 * (1 << 23))
+	for i := 0; i < 3; i++ {
+		payload[9+i] = byte(lat >> (8 * uint(i)))
+		payload[12+i] = byte(lng >> (8 * uint(i)))
+	}
+	crc = crc16(payload[8:15])
+	payload[15], payload[16] = byte(crc), byte(crc>>8)
+	return payload
+}
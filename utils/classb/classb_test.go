@@ -0,0 +1,69 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package classb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TheThingsNetwork/ttn/core/types"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestBeacon(t *testing.T) {
+	a := New(t)
+
+	a.So(GPSTime(time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)), ShouldEqual, 1167264018*time.Second)
+
+	now := time.Date(2017, time.August, 1, 12, 34, 56, 789, time.UTC)
+	beacon := BeaconStart(now)
+	a.So(beacon.After(now), ShouldBeFalse)
+	a.So(now.Sub(beacon), ShouldBeLessThan, BeaconPeriod)
+	a.So(GPSTime(beacon)%BeaconPeriod, ShouldEqual, 0)
+	a.So(NextBeacon(now), ShouldResemble, beacon.Add(BeaconPeriod))
+	a.So(BeaconStart(beacon), ShouldResemble, beacon)
+	a.So(BeaconTime(beacon)%128, ShouldEqual, 0)
+
+	payload := BeaconPayload(beacon, 52.3740, 4.8897)
+	a.So(payload, ShouldHaveLength, 17)
+	beaconTime := BeaconTime(beacon)
+	a.So(payload[2:6], ShouldResemble, []byte{byte(beaconTime), byte(beaconTime >> 8), byte(beaconTime >> 16), byte(beaconTime >> 24)})
+	crc := crc16(payload[:6])
+	a.So(payload[6:8], ShouldResemble, []byte{byte(crc), byte(crc >> 8)})
+	crc = crc16(payload[8:15])
+	a.So(payload[15:17], ShouldResemble, []byte{byte(crc), byte(crc >> 8)})
+}
+
+func TestCRC16(t *testing.T) {
+	a := New(t)
+	a.So(crc16([]byte("123456789")), ShouldEqual, 0x31C3)
+}
+
+func TestPingSlot(t *testing.T) {
+	a := New(t)
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x23, 0x45}
+	now := time.Date(2017, time.August, 1, 12, 34, 56, 789, time.UTC)
+	beacon := BeaconStart(now)
+
+	a.So(PingPeriod(0), ShouldEqual, 32)
+	a.So(PingPeriod(7), ShouldEqual, PingSlots)
+
+	offset := PingOffset(beacon, devAddr, PingPeriod(7))
+	a.So(offset, ShouldBeLessThan, PingPeriod(7))
+	a.So(PingOffset(beacon, devAddr, PingPeriod(7)), ShouldEqual, offset)
+	a.So(PingOffset(beacon, devAddr, PingPeriod(3)), ShouldEqual, offset%PingPeriod(3))
+
+	// With periodicity 7, there is one ping slot per beacon period
+	slot := NextPingSlot(devAddr, 7, beacon)
+	a.So(slot, ShouldResemble, beacon.Add(BeaconReserved+time.Duration(offset)*SlotLength))
+	next := NextPingSlot(devAddr, 7, slot)
+	a.So(next.Sub(BeaconStart(next)), ShouldEqual, BeaconReserved+time.Duration(PingOffset(BeaconStart(next), devAddr, PingPeriod(7)))*SlotLength)
+	a.So(BeaconStart(next), ShouldResemble, beacon.Add(BeaconPeriod))
+
+	// With periodicity 0, ping slots are 32 slots apart
+	slot = NextPingSlot(devAddr, 0, now)
+	a.So(slot.After(now), ShouldBeTrue)
+	a.So(NextPingSlot(devAddr, 0, slot).Sub(slot), ShouldEqual, 32*SlotLength)
+}
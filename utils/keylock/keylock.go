@@ -0,0 +1,45 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+// Package keylock provides mutexes per key, for example to serialize the changes to the state of a device
+package keylock
+
+import "sync"
+
+// Locks is a set of mutexes by key. Mutexes are created when they are needed, and removed when nobody holds or
+// waits for them. The zero value is ready to use.
+type Locks struct {
+	mu    sync.Mutex
+	locks map[string]*lock
+}
+
+type lock struct {
+	sync.Mutex
+	users int
+}
+
+// Lock the key, and return the function that unlocks it
+func (l *Locks) Lock(key string) (unlock func()) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*lock)
+	}
+	lk, ok := l.locks[key]
+	if !ok {
+		lk = new(lock)
+		l.locks[key] = lk
+	}
+	lk.users++
+	l.mu.Unlock()
+
+	lk.Lock()
+	return func() {
+		lk.Unlock()
+		l.mu.Lock()
+		lk.users--
+		if lk.users == 0 {
+			delete(l.locks, key)
+		}
+		l.mu.Unlock()
+	}
+}
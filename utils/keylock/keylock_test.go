@@ -0,0 +1,37 @@
+// Copyright © 2017 The Things Network
+// Use of this source code is governed by the MIT license that can be found in the LICENSE file.
+
+package keylock
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/TheThingsNetwork/ttn/utils/testing"
+	. "github.com/smartystreets/assertions"
+)
+
+func TestLocks(t *testing.T) {
+	a := New(t)
+	var locks Locks
+	var wg WaitGroup
+
+	unlock := locks.Lock("app1:dev1")
+	locks.Lock("app1:dev2")()
+
+	locked := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		locks.Lock("app1:dev1")()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("Key was locked twice")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+	a.So(wg.WaitFor(50*time.Millisecond), ShouldBeNil)
+	a.So(locks.locks, ShouldBeEmpty)
+}